@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	db "github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/api/admin"
+	"github.com/flatcar/nebraska/backend/pkg/bundle"
+)
+
+const bundleUsage = `usage: nebraska bundle <command> [flags]
+
+Commands:
+  export    write a signed bundle with an application's packages, channels and floors
+  import    verify and load a bundle into this Nebraska instance
+
+The database is selected through the NEBRASKA_DB_URL env var.`
+
+// runBundle runs the bundle subcommand with the arguments provided.
+func runBundle(args []string) error {
+	if len(args) == 0 {
+		return errors.New(bundleUsage)
+	}
+
+	switch args[0] {
+	case "export":
+		return runBundleExport(args[1:])
+	case "import":
+		return runBundleImport(args[1:])
+	default:
+		return fmt.Errorf("unknown bundle command %q\n%s", args[0], bundleUsage)
+	}
+}
+
+func runBundleExport(args []string) error {
+	f := flag.NewFlagSet("bundle export", flag.ContinueOnError)
+	appID := f.String("app", "", "id or product id of the application to export (required)")
+	keyPath := f.String("key", "", "path to the PEM-encoded ed25519 private key used to sign the bundle (required)")
+	output := f.String("output", "", "path of the bundle file to write (required)")
+	payloadsPath := f.String("payloads-path", "", "directory with the packages payloads to include in the bundle, e.g. the flatcar-packages-path; payloads are not included if empty")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if *appID == "" || *keyPath == "" || *output == "" {
+		f.Usage()
+		return errors.New("app, key and output are required")
+	}
+
+	privateKey, err := bundle.LoadPrivateKey(*keyPath)
+	if err != nil {
+		return err
+	}
+
+	api, err := db.New()
+	if err != nil {
+		return fmt.Errorf("failed to create a DB connection: %w", err)
+	}
+	defer api.Close()
+
+	out, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := bundle.Export(api, out, bundle.ExportOptions{
+		AppID:        *appID,
+		PrivateKey:   privateKey,
+		PayloadsPath: *payloadsPath,
+	})
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(*output)
+		return fmt.Errorf("failed to export bundle: %w", err)
+	}
+
+	l.Info().
+		Str("app", manifest.Application.Name).
+		Int("channels", len(manifest.Channels)).
+		Int("packages", len(manifest.Packages)).
+		Int("floors", len(manifest.Floors)).
+		Int("payloads", len(manifest.Payloads)).
+		Msgf("bundle exported to %s", *output)
+
+	return nil
+}
+
+func runBundleImport(args []string) error {
+	f := flag.NewFlagSet("bundle import", flag.ContinueOnError)
+	appID := f.String("app", "", "id or product id of the application to import into; defaults to the application the bundle was exported from")
+	keyPath := f.String("key", "", "path to the PEM-encoded ed25519 public key used to verify the bundle (required)")
+	input := f.String("input", "", "path of the bundle file to import (required)")
+	payloadsPath := f.String("payloads-path", "", "directory where the bundle payloads will be stored, e.g. the flatcar-packages-path; payloads are ignored if empty")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if *keyPath == "" || *input == "" {
+		f.Usage()
+		return errors.New("key and input are required")
+	}
+
+	publicKey, err := bundle.LoadPublicKey(*keyPath)
+	if err != nil {
+		return err
+	}
+
+	api, err := db.NewWithMigrations()
+	if err != nil {
+		return fmt.Errorf("failed to create a DB connection: %w", err)
+	}
+	defer api.Close()
+
+	in, err := os.Open(*input)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	result, err := bundle.Import(api, admin.NewService(api.Reads()), in, bundle.ImportOptions{
+		AppID:        *appID,
+		PublicKey:    publicKey,
+		PayloadsPath: *payloadsPath,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import bundle: %w", err)
+	}
+
+	l.Info().
+		Int("channelsCreated", result.ChannelsCreated).
+		Int("channelsUpdated", result.ChannelsUpdated).
+		Int("packagesCreated", result.PackagesCreated).
+		Int("packagesSkipped", result.PackagesSkipped).
+		Int("floorsSet", result.FloorsSet).
+		Int("payloadsWritten", result.PayloadsWritten).
+		Msgf("bundle %s imported", *input)
+
+	return nil
+}
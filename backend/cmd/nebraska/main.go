@@ -2,22 +2,35 @@ package main
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/rs/zerolog"
 
 	db "github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/api/admin"
 	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/email"
 	"github.com/flatcar/nebraska/backend/pkg/logger"
 	"github.com/flatcar/nebraska/backend/pkg/metrics"
+	"github.com/flatcar/nebraska/backend/pkg/retention"
 	"github.com/flatcar/nebraska/backend/pkg/server"
 	"github.com/flatcar/nebraska/backend/pkg/syncer"
 	"github.com/flatcar/nebraska/backend/pkg/tlsutil"
+	"github.com/flatcar/nebraska/backend/pkg/webhooks"
 )
 
 var l = logger.New("main")
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "bundle" {
+		if err := runBundle(os.Args[2:]); err != nil {
+			l.Fatal().
+				Err(err).
+				Msg("Bundle command failed")
+		}
+		return
+	}
+
 	// config parse
 	conf, err := config.Parse()
 	if err != nil {
@@ -61,7 +74,11 @@ func main() {
 	}
 
 	// create new DB
-	db, err := db.NewWithMigrations()
+	var dbOptions []func(*db.API) error
+	if conf.RefuseDuplicateInstances {
+		dbOptions = append(dbOptions, db.OptionRefuseSuspectedDuplicates)
+	}
+	db, err := db.NewWithMigrations(dbOptions...)
 	if err != nil {
 		l.Fatal().
 			Err(err).
@@ -79,26 +96,59 @@ func main() {
 	adminSvc := admin.NewService(db.Reads())
 
 	// setup syncer
+	var flatcarSyncer *syncer.Syncer
 	if conf.EnableSyncer {
-		syncer, err := syncer.Setup(conf, db, adminSvc)
+		flatcarSyncer, err = syncer.Setup(conf, db, adminSvc)
 		if err != nil {
 			l.Fatal().
 				Err(err).
 				Msg("Failed to set up syncer")
 		}
-		go syncer.Start()
-		defer syncer.Stop()
+		go flatcarSyncer.Start()
+		defer flatcarSyncer.Stop()
+	}
+
+	// setup retention pruner
+	pruner, err := retention.Setup(conf, db)
+	if err != nil {
+		l.Fatal().
+			Err(err).
+			Msg("Failed to set up retention pruner")
+	}
+	go pruner.Start()
+	defer pruner.Stop()
+
+	// setup webhooks dispatcher
+	dispatcher, err := webhooks.Setup(conf, db)
+	if err != nil {
+		l.Fatal().
+			Err(err).
+			Msg("Failed to set up webhooks dispatcher")
+	}
+	go dispatcher.Start()
+	defer dispatcher.Stop()
+
+	// setup email notifier
+	if conf.SMTPHost != "" {
+		notifier, err := email.Setup(conf, db)
+		if err != nil {
+			l.Fatal().
+				Err(err).
+				Msg("Failed to set up email notifier")
+		}
+		go notifier.Start()
+		defer notifier.Stop()
 	}
 
 	// setup and instrument metrics
-	err = metrics.RegisterAndInstrument(db)
+	err = metrics.RegisterAndInstrument(db, flatcarSyncer, pruner)
 	if err != nil {
 		l.Fatal().
 			Err(err).
 			Msg("Failed to register metrics")
 	}
 
-	server, err := server.New(conf, db, adminSvc)
+	server, err := server.New(conf, db, adminSvc, flatcarSyncer)
 	if err != nil {
 		l.Fatal().
 			Err(err).
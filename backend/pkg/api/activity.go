@@ -13,6 +13,22 @@ const (
 	activityRolloutFailed         = types.ActivityRolloutFailed
 	activityInstanceUpdateFailed  = types.ActivityInstanceUpdateFailed
 	activityChannelPackageUpdated = types.ActivityChannelPackageUpdated
+
+	activityInstanceDuplicateSuspected = types.ActivityInstanceDuplicateSuspected
+)
+
+// Activity classes recorded by the syncer when reconciling synced channels.
+const (
+	ActivityChannelFloorAdded         = types.ActivityChannelFloorAdded
+	ActivityChannelFloorRemoved       = types.ActivityChannelFloorRemoved
+	ActivityChannelPackageBlacklisted = types.ActivityChannelPackageBlacklisted
+	ActivityChannelPackageUpdated     = types.ActivityChannelPackageUpdated
+)
+
+// Activity classes of the rollout outcomes notified by email.
+const (
+	ActivityRolloutFinished = types.ActivityRolloutFinished
+	ActivityRolloutFailed   = types.ActivityRolloutFailed
 )
 
 const (
@@ -22,9 +38,14 @@ const (
 	activityError   = types.ActivityError
 )
 
+// ErrInvalidActivityAcknowledgement indicates that the acknowledgement of
+// activity entries provided is not valid.
+var ErrInvalidActivityAcknowledgement = types.ErrInvalidActivityAcknowledgement
+
 type (
-	Activity            = types.Activity
-	ActivityQueryParams = types.ActivityQueryParams
+	Activity                = types.Activity
+	ActivityQueryParams     = types.ActivityQueryParams
+	ActivityAcknowledgement = types.ActivityAcknowledgement
 )
 
 // newGroupActivityEntry creates a new activity entry related to a specific
@@ -1,7 +1,13 @@
 package admin
 
 import (
+	"database/sql"
+	"fmt"
+
 	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
 // newChannelActivityEntry creates a new admin_activity entry related to a
@@ -22,3 +28,113 @@ func (s *Service) newChannelActivityEntry(class int, severity int, version, appI
 
 	return nil
 }
+
+// AddPackageSignatureInvalidActivity records that the package version
+// provided was not imported into the channel because its payload signature
+// could not be verified.
+func (s *Service) AddPackageSignatureInvalidActivity(version, appID, channelID string) error {
+	return s.newChannelActivityEntry(types.ActivityPackageSignatureInvalid, types.ActivityError, version, appID, channelID)
+}
+
+// AddChannelFloorAddedActivity records that the package version provided was
+// marked as a floor of the channel.
+func (s *Service) AddChannelFloorAddedActivity(version, appID, channelID string) error {
+	return s.newChannelActivityEntry(types.ActivityChannelFloorAdded, types.ActivityInfo, version, appID, channelID)
+}
+
+// AddChannelFloorRemovedActivity records that the package version provided is
+// no longer a floor of the channel.
+func (s *Service) AddChannelFloorRemovedActivity(version, appID, channelID string) error {
+	return s.newChannelActivityEntry(types.ActivityChannelFloorRemoved, types.ActivityInfo, version, appID, channelID)
+}
+
+// AddChannelPackageBlacklistedActivity records that the package version
+// provided was blacklisted for the channel.
+func (s *Service) AddChannelPackageBlacklistedActivity(version, appID, channelID string) error {
+	return s.newChannelActivityEntry(types.ActivityChannelPackageBlacklisted, types.ActivityWarning, version, appID, channelID)
+}
+
+const (
+	// maxAcknowledgedActivity is the maximum number of activity entries
+	// acknowledged at once.
+	maxAcknowledgedActivity = 1000
+
+	// maxAckCommentLength is the maximum length of the comments of the
+	// acknowledgements.
+	maxAckCommentLength = 1024
+)
+
+// AcknowledgeActivity acknowledges the activity entries of the team provided
+// identified in the acknowledgement, or resolves them if requested. Only the
+// entries whose state changes are updated, so acknowledging or resolving an
+// entry twice keeps its first acknowledgement, and the number of entries
+// updated is returned.
+func (s *Service) AcknowledgeActivity(teamID string, ack types.ActivityAcknowledgement) (int64, error) {
+	if len(ack.ActivityIDs) == 0 || len(ack.ActivityIDs) > maxAcknowledgedActivity {
+		return 0, fmt.Errorf("%w: between 1 and %d activity entries must be provided", types.ErrInvalidActivityAcknowledgement, maxAcknowledgedActivity)
+	}
+	if len(ack.Comment.String) > maxAckCommentLength {
+		return 0, fmt.Errorf("%w: comment must be at most %d characters long", types.ErrInvalidActivityAcknowledgement, maxAckCommentLength)
+	}
+	for _, id := range ack.ActivityIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid activity entry id %q", types.ErrInvalidActivityAcknowledgement, id)
+		}
+	}
+
+	now := goqu.L("now()")
+	record := goqu.Record{
+		"acknowledged_ts": now,
+		"acknowledged_by": ack.Username,
+		"ack_comment":     ack.Comment,
+	}
+	state := goqu.C("acknowledged_ts")
+	if ack.Resolve {
+		record = goqu.Record{
+			"acknowledged_ts": goqu.COALESCE(goqu.C("acknowledged_ts"), now),
+			"acknowledged_by": goqu.COALESCE(goqu.C("acknowledged_by"), ack.Username),
+			"ack_comment":     goqu.COALESCE(ack.Comment, goqu.C("ack_comment")),
+			"resolved_ts":     now,
+		}
+		state = goqu.C("resolved_ts")
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("acknowledgeActivity - could not roll back")
+		}
+	}()
+
+	var updated int64
+	for _, table := range []string{"activity", "admin_activity"} {
+		query, _, err := goqu.Update(table).
+			Set(record).
+			Where(
+				goqu.C("id").In(ack.ActivityIDs),
+				state.IsNull(),
+				goqu.C("application_id").In(goqu.From("application").Select("id").Where(goqu.C("team_id").Eq(teamID))),
+			).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		result, err := tx.Exec(query)
+		if err != nil {
+			return 0, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		updated += rowsAffected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
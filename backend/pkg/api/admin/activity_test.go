@@ -47,3 +47,82 @@ func TestAdminActivityRouting(t *testing.T) {
 	require.Len(t, entries, 1)
 	assert.Equal(t, types.ActivityChannelPackageUpdated, entries[0].Class, "admin activity must be visible through GetActivity/all_activity")
 }
+
+func TestAcknowledgeActivity(t *testing.T) {
+	a, err := api.NewForTest(api.OptionInitDB)
+	require.NoError(t, err)
+	defer a.Close()
+	svc := NewService(a.Reads())
+
+	tTeam, _ := svc.AddTeam(&types.Team{Name: "test_team_ack"})
+	tOtherTeam, _ := svc.AddTeam(&types.Team{Name: "test_other_team_ack"})
+	tApp, _ := svc.AddApp(&types.Application{Name: "test_app_ack", TeamID: tTeam.ID})
+	tChannel, _ := svc.AddChannel(&types.Channel{Name: "test_channel_ack", Color: "blue", ApplicationID: tApp.ID})
+
+	require.NoError(t, svc.AddPackageSignatureInvalidActivity("12.1.0", tApp.ID, tChannel.ID))
+	var runtimeID string
+	require.NoError(t, svc.db.QueryRow("insert into activity (class, severity, version, application_id) values ($1, $2, $3, $4) returning id",
+		types.ActivityInstanceUpdateFailed, types.ActivityError, "12.1.0", tApp.ID).Scan(&runtimeID))
+
+	entries, err := a.GetActivity(tTeam.ID, api.ActivityQueryParams{AppID: tApp.ID, Unacknowledged: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	var adminID string
+	for _, entry := range entries {
+		if entry.ID != runtimeID {
+			adminID = entry.ID
+		}
+	}
+
+	_, err = svc.AcknowledgeActivity(tTeam.ID, types.ActivityAcknowledgement{})
+	assert.ErrorIs(t, err, types.ErrInvalidActivityAcknowledgement)
+	_, err = svc.AcknowledgeActivity(tTeam.ID, types.ActivityAcknowledgement{ActivityIDs: []string{"not-a-uuid"}})
+	assert.ErrorIs(t, err, types.ErrInvalidActivityAcknowledgement)
+
+	// Other teams can't acknowledge the activity entries.
+	updated, err := svc.AcknowledgeActivity(tOtherTeam.ID, types.ActivityAcknowledgement{ActivityIDs: []string{runtimeID, adminID}, Username: "mallory"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	ack := types.ActivityAcknowledgement{ActivityIDs: []string{runtimeID}, Username: "alice", Comment: null.StringFrom("looking into it")}
+	updated, err = svc.AcknowledgeActivity(tTeam.ID, ack)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+
+	// Acknowledging entries twice keeps their first acknowledgement.
+	ack.Username = "bob"
+	updated, err = svc.AcknowledgeActivity(tTeam.ID, ack)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	entries, err = a.GetActivity(tTeam.ID, api.ActivityQueryParams{AppID: tApp.ID, Unacknowledged: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, adminID, entries[0].ID)
+	count, err := a.GetActivityCount(tTeam.ID, api.ActivityQueryParams{AppID: tApp.ID, Unacknowledged: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Resolving entries acknowledges the ones not acknowledged yet.
+	updated, err = svc.AcknowledgeActivity(tTeam.ID, types.ActivityAcknowledgement{ActivityIDs: []string{runtimeID, adminID}, Username: "bob", Resolve: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+
+	runtimeEntry, err := a.GetActivityEntry(runtimeID)
+	require.NoError(t, err)
+	assert.True(t, runtimeEntry.AcknowledgedTs.Valid)
+	assert.True(t, runtimeEntry.ResolvedTs.Valid)
+	assert.Equal(t, null.StringFrom("alice"), runtimeEntry.AcknowledgedBy)
+	assert.Equal(t, null.StringFrom("looking into it"), runtimeEntry.AckComment)
+
+	adminEntry, err := a.GetActivityEntry(adminID)
+	require.NoError(t, err)
+	assert.True(t, adminEntry.AcknowledgedTs.Valid)
+	assert.True(t, adminEntry.ResolvedTs.Valid)
+	assert.Equal(t, null.StringFrom("bob"), adminEntry.AcknowledgedBy)
+	assert.False(t, adminEntry.AckComment.Valid)
+
+	entries, err = a.GetActivity(tTeam.ID, api.ActivityQueryParams{AppID: tApp.ID, Unacknowledged: true})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+	"github.com/flatcar/nebraska/backend/pkg/random"
+)
+
+const (
+	// apiTokenLength is the length of the random part of the API tokens.
+	apiTokenLength = 40
+
+	// apiTokenPrefixLength is the length of the beginning of the API tokens
+	// stored in clear to recognize them.
+	apiTokenPrefixLength = 12
+
+	maxAPITokenNameLength = 50
+)
+
+// AddAPIToken registers the provided API token, generating its secret. The
+// token is returned along with its secret, which isn't stored and can't be
+// retrieved later.
+func (s *Service) AddAPIToken(token *types.APIToken) (*types.APIToken, string, error) {
+	if err := validateAPIToken(token); err != nil {
+		return nil, "", err
+	}
+
+	secret := types.APITokenPrefix + random.String(apiTokenLength)
+	query, _, err := goqu.Insert("api_token").
+		Cols("name", "team_id", "role", "token_hash", "token_prefix", "created_by", "expires_ts").
+		Vals(goqu.Vals{
+			token.Name,
+			token.TeamID,
+			token.Role,
+			types.HashAPIToken(secret),
+			secret[:apiTokenPrefixLength],
+			token.CreatedBy,
+			token.ExpiresTs,
+		}).
+		Returning(goqu.T("api_token").All()).
+		ToSQL()
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.db.QueryRowx(query).StructScan(token); err != nil {
+		return nil, "", err
+	}
+	return token, secret, nil
+}
+
+// RevokeAPIToken revokes the API token identified by the id provided. Revoked
+// tokens are kept, so their use can still be audited.
+func (s *Service) RevokeAPIToken(tokenID string) error {
+	query, _, err := goqu.Update("api_token").
+		Set(goqu.Record{"revoked_ts": goqu.L("now()")}).
+		Where(
+			goqu.C("id").Eq(tokenID),
+			goqu.C("revoked_ts").IsNull(),
+		).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// validateAPIToken checks that the API token provided has a name, a known
+// role and, if any, an expiry in the future.
+func validateAPIToken(token *types.APIToken) error {
+	if token.Name == "" || len(token.Name) > maxAPITokenNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", types.ErrInvalidAPIToken, maxAPITokenNameLength)
+	}
+
+	switch token.Role {
+	case types.APITokenRoleViewer, types.APITokenRoleAdmin:
+	default:
+		return fmt.Errorf("%w: role must be %s or %s", types.ErrInvalidAPIToken, types.APITokenRoleViewer, types.APITokenRoleAdmin)
+	}
+
+	if token.ExpiresTs.Valid && !token.ExpiresTs.Time.After(time.Now()) {
+		return fmt.Errorf("%w: expiry must be in the future", types.ErrInvalidAPIToken)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// AddAuditEntry records the configuration change described by the audit log
+// entry provided.
+func (s *Service) AddAuditEntry(entry *types.AuditEntry) error {
+	query, _, err := goqu.Insert("audit_log").
+		Cols("team_id", "username", "action", "target_type", "target_id", "application_id", "before", "after").
+		Vals(goqu.Vals{
+			entry.TeamID,
+			entry.Username,
+			entry.Action,
+			entry.TargetType,
+			entry.TargetID,
+			entry.ApplicationID,
+			entry.Before,
+			entry.After,
+		}).
+		Returning("id", "created_ts").
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	return s.db.QueryRowx(query).Scan(&entry.ID, &entry.CreatedTs)
+}
@@ -0,0 +1,149 @@
+package admin
+
+import (
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// maxEmailRecipients is the maximum number of recipients of an email
+// notification.
+const maxEmailRecipients = 50
+
+// AddEmailNotification registers the provided email notification. Only the
+// rollouts finished or failed after its creation are notified, and its first
+// daily digest covers the day after its creation.
+func (s *Service) AddEmailNotification(notification *types.EmailNotification) (*types.EmailNotification, error) {
+	if err := s.validateEmailNotification(notification); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	query, _, err := goqu.Insert("email_notification").
+		Cols("application_id", "recipients", "rollout_finished", "rollout_failed", "daily_digest", "enabled", "last_digest_ts").
+		Vals(goqu.Vals{
+			notification.ApplicationID,
+			notification.Recipients,
+			notification.RolloutFinished,
+			notification.RolloutFailed,
+			notification.DailyDigest,
+			notification.Enabled,
+			now,
+		}).
+		Returning(goqu.T("email_notification").All()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.QueryRowx(query).StructScan(notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+// UpdateEmailNotification updates an existing email notification using the
+// content of the notification provided. Its application can't be changed. The
+// rollouts finished or failed while it was disabled are not notified once it
+// is enabled again, and neither are the failures in its daily digest.
+func (s *Service) UpdateEmailNotification(notification *types.EmailNotification) error {
+	notificationBeforeUpdate, err := s.GetEmailNotification(notification.ID)
+	if err != nil {
+		return err
+	}
+	notification.ApplicationID = notificationBeforeUpdate.ApplicationID
+	if err := s.validateEmailNotification(notification); err != nil {
+		return err
+	}
+
+	record := goqu.Record{
+		"recipients":       notification.Recipients,
+		"rollout_finished": notification.RolloutFinished,
+		"rollout_failed":   notification.RolloutFailed,
+		"daily_digest":     notification.DailyDigest,
+		"enabled":          notification.Enabled,
+	}
+	if notification.Enabled && !notificationBeforeUpdate.Enabled {
+		record["last_digest_ts"] = time.Now().UTC()
+	}
+
+	query, _, err := goqu.Update("email_notification").
+		Set(record).
+		Where(goqu.C("id").Eq(notification.ID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	// The rollouts queued and not notified yet are dropped along with the
+	// ones happening while the notification is disabled.
+	if !notification.Enabled && notificationBeforeUpdate.Enabled {
+		query, _, err := goqu.Delete("email_notification_outbox").
+			Where(goqu.C("email_notification_id").Eq(notification.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		if _, err := s.db.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteEmailNotification removes the email notification identified by the id
+// provided.
+func (s *Service) DeleteEmailNotification(notificationID string) error {
+	query, _, err := goqu.Delete("email_notification").
+		Where(goqu.C("id").Eq(notificationID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// validateEmailNotification checks that the email notification provided has
+// between one and maxEmailRecipients valid email addresses, normalizing them
+// to their bare address.
+func (s *Service) validateEmailNotification(notification *types.EmailNotification) error {
+	if len(notification.Recipients) == 0 || len(notification.Recipients) > maxEmailRecipients {
+		return fmt.Errorf("%w: recipients must have between 1 and %d addresses", types.ErrInvalidEmailNotification, maxEmailRecipients)
+	}
+	for i, recipient := range notification.Recipients {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return fmt.Errorf("%w: invalid recipient %q", types.ErrInvalidEmailNotification, recipient)
+		}
+		notification.Recipients[i] = address.Address
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package admin
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// DeleteInstance deletes the instance provided, along with its applications
+// status, events and status history. The deletion is recorded in the activity
+// of each application the instance was registered in.
+func (s *Service) DeleteInstance(instanceID string) error {
+	deleted, err := s.deleteInstances(goqu.From("instance").Select("id").Where(goqu.C("id").Eq(instanceID)))
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return types.ErrNoRowsAffected
+	}
+	return nil
+}
+
+// instancesDeleteBatchSize is the maximum number of instances deleted in a
+// single transaction when deleting instances in bulk.
+const instancesDeleteBatchSize = 1000
+
+// DeleteInstances deletes the instances of an application that match the
+// criteria provided, in the same way they would be listed by GetInstances,
+// returning the number of instances deleted. Only their status, events,
+// status history and activity in the application provided are deleted, and
+// the instances themselves once they aren't registered in any application
+// anymore, so that the instances of other applications are left untouched.
+// Instances are deleted in batches of instancesDeleteBatchSize. The deletion
+// is recorded as a single entry in the admin activity of the application,
+// even if it fails halfway.
+func (s *Service) DeleteInstances(p types.InstancesQueryParams, duration string) (int64, error) {
+	idsQuery, err := s.InstanceIDsQuery(p, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for {
+		var deleted, batch int64
+		deleted, batch, err = s.deleteAppInstancesBatch(p.ApplicationID, idsQuery.Limit(instancesDeleteBatchSize))
+		total += deleted
+		if err != nil || batch < instancesDeleteBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		activityQuery, _, qErr := goqu.Insert("admin_activity").
+			Cols("class", "severity", "version", "application_id").
+			Vals(goqu.Vals{types.ActivityInstancesDeleted, types.ActivityInfo, strconv.FormatInt(total, 10), p.ApplicationID}).
+			ToSQL()
+		if qErr == nil {
+			_, qErr = s.db.Exec(activityQuery)
+		}
+		if qErr != nil {
+			l.Error().Err(qErr).Str("appID", p.ApplicationID).Int64("instances", total).Msg("DeleteInstances - could not add activity")
+		}
+	}
+	return total, err
+}
+
+// deleteAppInstancesBatch deletes from the application provided the instances
+// whose ids are returned by the query provided, in a single transaction, and
+// the instances left without applications. It returns the number of
+// instances deleted from the application, and the number of ids returned by
+// the query.
+func (s *Service) deleteAppInstancesBatch(appID string, idsQuery *goqu.SelectDataset) (int64, int64, error) {
+	query, _, err := idsQuery.ToSQL()
+	if err != nil {
+		return 0, 0, err
+	}
+	var instanceIDs []string
+	if err := s.db.Select(&instanceIDs, query); err != nil {
+		return 0, 0, err
+	}
+	if len(instanceIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	appInstances := goqu.And(goqu.C("application_id").Eq(appID), goqu.C("instance_id").In(instanceIDs))
+	var queries []string
+	for _, table := range []string{"event", "instance_status_history", "activity", "instance_group_override"} {
+		query, _, err := goqu.Delete(table).Where(appInstances).ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+		queries = append(queries, query)
+	}
+	appQuery, _, err := goqu.Delete("instance_application").Where(appInstances).ToSQL()
+	if err != nil {
+		return 0, 0, err
+	}
+	orphansQuery, _, err := goqu.Delete("instance").
+		Where(
+			goqu.C("id").In(instanceIDs),
+			goqu.L("NOT EXISTS (SELECT 1 FROM instance_application WHERE instance_id = instance.id)"),
+		).
+		ToSQL()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("deleteAppInstancesBatch - could not roll back")
+		}
+	}()
+
+	for _, query := range queries {
+		if _, err := tx.Exec(query); err != nil {
+			return 0, 0, err
+		}
+	}
+	result, err := tx.Exec(appQuery)
+	if err != nil {
+		return 0, 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := tx.Exec(orphansQuery); err != nil {
+		return 0, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return deleted, int64(len(instanceIDs)), nil
+}
+
+// deleteInstances deletes the instances whose ids are returned by the query
+// provided, recording the deletion of each of them in the admin activity.
+func (s *Service) deleteInstances(idsQuery *goqu.SelectDataset) (int64, error) {
+	activityQuery, _, err := goqu.Insert("admin_activity").
+		Cols("class", "severity", "version", "application_id", "group_id", "instance_id").
+		FromQuery(goqu.From("instance_application").
+			Select(
+				goqu.V(types.ActivityInstanceDeleted), goqu.V(types.ActivityInfo), goqu.C("version"),
+				goqu.C("application_id"), goqu.C("group_id"), goqu.C("instance_id"),
+			).
+			Where(goqu.C("instance_id").In(idsQuery))).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	deleteQuery, _, err := goqu.Delete("instance").
+		Where(goqu.C("id").In(idsQuery)).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("deleteInstances - could not roll back")
+		}
+	}()
+
+	if _, err := tx.Exec(activityQuery); err != nil {
+		return 0, err
+	}
+	result, err := tx.Exec(deleteQuery)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// SetInstanceGroupOverride assigns the instance provided to a group of the
+// application, so it gets its updates from it regardless of the track it
+// reports. An empty group id removes the assignment, moving the instance back
+// to the group matching its track. It fails with ErrInstanceArchMismatch if
+// the channel of the group serves packages of another architecture.
+func (s *Service) SetInstanceGroupOverride(instanceID, appID, groupID string) error {
+	updated, skipped, err := s.setInstancesGroupOverride(appID, goqu.From("instance").Select("id").Where(goqu.C("id").Eq(instanceID)), groupID)
+	if err != nil {
+		return err
+	}
+	if skipped > 0 {
+		return types.ErrInstanceArchMismatch
+	}
+	if updated == 0 {
+		return types.ErrNoRowsAffected
+	}
+	return nil
+}
+
+// SetInstancesGroupOverride assigns the instances of an application that match
+// the criteria provided, in the same way they would be listed by GetInstances,
+// to a group of the application, returning the number of instances updated,
+// and of instances skipped as the channel of the group serves packages of
+// another architecture. An empty group id removes their assignment.
+func (s *Service) SetInstancesGroupOverride(p types.InstancesQueryParams, duration, groupID string) (int64, int64, error) {
+	idsQuery, err := s.InstanceIDsQuery(p, duration)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.setInstancesGroupOverride(p.ApplicationID, idsQuery, groupID)
+}
+
+// setInstancesGroupOverride assigns the instances whose ids are returned by
+// the query provided to a group of the application, or removes their
+// assignment if the group id is empty. The group of the instances is updated
+// right away, so they show up in the group they will get their updates from.
+// The instances whose architecture, the one of the channel of the group
+// matching their track, differs from the one of the channel of the group are
+// skipped, and counted.
+func (s *Service) setInstancesGroupOverride(appID string, idsQuery *goqu.SelectDataset, groupID string) (int64, int64, error) {
+	instanceApps := goqu.And(goqu.C("application_id").Eq(appID), goqu.C("instance_id").In(idsQuery))
+
+	var overrideQuery, groupQuery, skippedQuery string
+	var err error
+	if groupID == "" {
+		overrideQuery, _, err = goqu.Delete("instance_group_override").Where(instanceApps).ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+		groupQuery, _, err = goqu.Update("instance_application").
+			Set(goqu.Record{"group_id": goqu.C("requested_group_id")}).
+			Where(instanceApps, goqu.C("requested_group_id").IsNotNull()).
+			ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+	} else {
+		if _, err := uuid.Parse(groupID); err != nil {
+			return 0, 0, types.ErrInvalidApplicationOrGroup
+		}
+		group, err := s.GetGroup(groupID)
+		if err == sql.ErrNoRows || (err == nil && group.ApplicationID != appID) {
+			return 0, 0, types.ErrInvalidApplicationOrGroup
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if group.Channel != nil {
+			// the architecture of the instances is the one of the
+			// channel of the group matching their track
+			archMismatch := goqu.L(`EXISTS (
+				SELECT 1 FROM groups g INNER JOIN channel c ON (g.channel_id = c.id)
+				WHERE g.id = COALESCE(instance_application.requested_group_id, instance_application.group_id)
+				AND c.arch <> ?)`, uint(group.Channel.Arch))
+			skippedQuery, _, err = goqu.From("instance_application").
+				Select(goqu.L("count(*)")).
+				Where(instanceApps, archMismatch).
+				ToSQL()
+			if err != nil {
+				return 0, 0, err
+			}
+			instanceApps = goqu.And(instanceApps, goqu.L("NOT ?", archMismatch))
+		}
+		overrideQuery, _, err = goqu.Insert("instance_group_override").
+			Cols("instance_id", "application_id", "group_id").
+			FromQuery(goqu.From("instance_application").
+				Select(goqu.C("instance_id"), goqu.C("application_id"), goqu.L("?::uuid", groupID)).
+				Where(instanceApps)).
+			OnConflict(goqu.DoUpdate("instance_id, application_id", goqu.Record{
+				"group_id":   groupID,
+				"created_ts": goqu.L("now()"),
+			})).
+			ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+		groupQuery, _, err = goqu.Update("instance_application").
+			Set(goqu.Record{"group_id": groupID}).
+			Where(instanceApps).
+			ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("setInstancesGroupOverride - could not roll back")
+		}
+	}()
+
+	var skipped int64
+	if skippedQuery != "" {
+		if err := tx.QueryRow(skippedQuery).Scan(&skipped); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	result, err := tx.Exec(overrideQuery)
+	if err != nil {
+		return 0, 0, err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := tx.Exec(groupQuery); err != nil {
+		return 0, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return updated, skipped, nil
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"database/sql"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+func TestDeleteInstances(t *testing.T) {
+	a, err := api.NewForTest(api.OptionInitDB, api.OptionDisableUpdatesOnFailedRollout)
+	require.NoError(t, err)
+	require.NotNil(t, a)
+	defer a.Close()
+	svc := NewService(a.Reads())
+
+	tTeam, _ := svc.AddTeam(&types.Team{Name: "test_team_delete"})
+	tApp, _ := svc.AddApp(&types.Application{Name: "test_app_delete", TeamID: tTeam.ID})
+	tPkg, _ := svc.AddPackage(&types.Package{Type: types.PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := svc.AddChannel(&types.Channel{Name: "test_channel_delete", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := svc.AddGroup(&types.Group{Name: "group_delete", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	instApp := api.NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0")
+	tInstance1, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.1", OEM: "ami"}, instApp)
+	require.NoError(t, err)
+	tInstance2, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.2", OEM: "ami"}, instApp)
+	require.NoError(t, err)
+	tInstance3, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.3", OEM: "gce"}, instApp)
+	require.NoError(t, err)
+	tInstance4, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.4", OEM: "ami"}, instApp)
+	require.NoError(t, err)
+
+	// an instance also registered in an application of another team
+	tOtherTeam, _ := svc.AddTeam(&types.Team{Name: "other_team_delete"})
+	tOtherApp, _ := svc.AddApp(&types.Application{Name: "other_app_delete", TeamID: tOtherTeam.ID})
+	tOtherGroup, _ := svc.AddGroup(&types.Group{Name: "other_group_delete", ApplicationID: tOtherApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	_, err = a.RegisterInstance(api.Instance{ID: tInstance4.ID, IP: "10.0.0.4", OEM: "ami"}, api.NewInstanceApplication(tOtherApp.ID, tOtherGroup.ID, "1.0.0"))
+	require.NoError(t, err)
+
+	require.NoError(t, svc.DeleteInstance(tInstance1.ID))
+	assert.Equal(t, types.ErrNoRowsAffected, svc.DeleteInstance(tInstance1.ID))
+
+	deleted, err := svc.DeleteInstances(types.InstancesQueryParams{ApplicationID: tApp.ID, OEM: "ami"}, "1d")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	// instances are only deleted from the application, and deleted
+	// altogether once they aren't registered in any application
+	_, err = a.GetInstance(tInstance4.ID, tApp.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	instance, err := a.GetInstance(tInstance4.ID, tOtherApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tOtherApp.ID, instance.Application.ApplicationID)
+	assert.Equal(t, types.ErrNoRowsAffected, svc.DeleteInstance(tInstance2.ID))
+
+	result, err := a.GetInstances(types.InstancesQueryParams{ApplicationID: tApp.ID, GroupID: tGroup.ID, Page: 1, PerPage: 10}, "1d")
+	require.NoError(t, err)
+	require.Len(t, result.Instances, 1)
+	assert.Equal(t, tInstance3.ID, result.Instances[0].ID)
+
+	activities, err := a.GetActivity(tTeam.ID, types.ActivityQueryParams{AppID: tApp.ID, Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	var deletedIDs, bulkDeleted []string
+	for _, activity := range activities {
+		switch activity.Class {
+		case types.ActivityInstanceDeleted:
+			deletedIDs = append(deletedIDs, activity.InstanceID.String)
+		case types.ActivityInstancesDeleted:
+			bulkDeleted = append(bulkDeleted, activity.Version)
+		}
+	}
+	assert.ElementsMatch(t, []string{tInstance1.ID}, deletedIDs)
+	assert.Equal(t, []string{"2"}, bulkDeleted)
+}
+
+func TestDeleteInstancesInBatches(t *testing.T) {
+	a, err := api.NewForTest(api.OptionInitDB, api.OptionDisableUpdatesOnFailedRollout)
+	require.NoError(t, err)
+	require.NotNil(t, a)
+	defer a.Close()
+	svc := NewService(a.Reads())
+
+	tTeam, _ := svc.AddTeam(&types.Team{Name: "test_team_delete_batches"})
+	tApp, _ := svc.AddApp(&types.Application{Name: "test_app_delete_batches", TeamID: tTeam.ID})
+	tGroup, _ := svc.AddGroup(&types.Group{Name: "group_delete_batches", ApplicationID: tApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	count := instancesDeleteBatchSize + 10
+	for i := 0; i < count; i++ {
+		_, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.1"}, api.NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+		require.NoError(t, err)
+	}
+
+	deleted, err := svc.DeleteInstances(types.InstancesQueryParams{ApplicationID: tApp.ID}, "1d")
+	require.NoError(t, err)
+	assert.Equal(t, int64(count), deleted)
+
+	activities, err := a.GetActivity(tTeam.ID, types.ActivityQueryParams{AppID: tApp.ID, Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, types.ActivityInstancesDeleted, activities[0].Class)
+	assert.Equal(t, strconv.Itoa(count), activities[0].Version)
+}
+
+func TestSetInstancesGroupOverride(t *testing.T) {
+	a, err := api.NewForTest(api.OptionInitDB, api.OptionDisableUpdatesOnFailedRollout)
+	require.NoError(t, err)
+	require.NotNil(t, a)
+	defer a.Close()
+	svc := NewService(a.Reads())
+
+	tTeam, _ := svc.AddTeam(&types.Team{Name: "test_team_override"})
+	tApp, _ := svc.AddApp(&types.Application{Name: "test_app_override", TeamID: tTeam.ID})
+	tGroup, _ := svc.AddGroup(&types.Group{Name: "group_stable", ApplicationID: tApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tCanaryGroup, _ := svc.AddGroup(&types.Group{Name: "group_canary", ApplicationID: tApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	instApp := api.NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0")
+	tInstance1, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.1", OEM: "ami"}, instApp)
+	require.NoError(t, err)
+	tInstance2, err := a.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.0.0.2", OEM: "gce"}, instApp)
+	require.NoError(t, err)
+
+	updated, skipped, err := svc.SetInstancesGroupOverride(types.InstancesQueryParams{ApplicationID: tApp.ID, OEM: "ami"}, "1d", tCanaryGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+	assert.Equal(t, int64(0), skipped)
+
+	groupID, err := a.GetInstanceGroupOverride(tInstance1.ID, tApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tCanaryGroup.ID, groupID)
+	_, err = a.GetInstanceGroupOverride(tInstance2.ID, tApp.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	result, err := a.GetInstances(types.InstancesQueryParams{ApplicationID: tApp.ID, GroupID: tCanaryGroup.ID, Page: 1, PerPage: 10}, "1d")
+	require.NoError(t, err)
+	require.Len(t, result.Instances, 1)
+	assert.Equal(t, tInstance1.ID, result.Instances[0].ID)
+	assert.Equal(t, tGroup.ID, result.Instances[0].Application.RequestedGroupID.String)
+
+	updated, _, err = svc.SetInstancesGroupOverride(types.InstancesQueryParams{ApplicationID: tApp.ID, GroupID: tCanaryGroup.ID}, "1d", "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+	instance, err := a.GetInstance(tInstance1.ID, tApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tGroup.ID, instance.Application.GroupID.String)
+
+	_, _, err = svc.SetInstancesGroupOverride(types.InstancesQueryParams{ApplicationID: tApp.ID, OEM: "ami"}, "1d", "not-a-group")
+	assert.Equal(t, types.ErrInvalidApplicationOrGroup, err)
+
+	// instances aren't assigned to groups serving another architecture
+	tChannel, _ := svc.AddChannel(&types.Channel{Name: "channel_amd64", Color: "white", ApplicationID: tApp.ID, Arch: types.ArchAMD64})
+	tGroup.ChannelID = null.StringFrom(tChannel.ID)
+	require.NoError(t, svc.UpdateGroup(tGroup))
+	tArmChannel, _ := svc.AddChannel(&types.Channel{Name: "channel_arm64", Color: "white", ApplicationID: tApp.ID, Arch: types.ArchAArch64})
+	tArmGroup, _ := svc.AddGroup(&types.Group{Name: "group_arm", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tArmChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	updated, skipped, err = svc.SetInstancesGroupOverride(types.InstancesQueryParams{ApplicationID: tApp.ID}, "1d", tArmGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+	assert.Equal(t, int64(2), skipped)
+	_, err = a.GetInstanceGroupOverride(tInstance1.ID, tApp.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -54,7 +54,7 @@ func (s *Service) addPackage(pkg *types.Package, tx *sqlx.Tx) error {
 	}
 
 	query, _, err := goqu.Insert("package").
-		Cols("type", "filename", "description", "size", "hash", "url", "version", "application_id", "arch").
+		Cols("type", "filename", "description", "size", "hash", "url", "version", "application_id", "arch", "upstream_url").
 		Vals(goqu.Vals{
 			pkg.Type,
 			pkg.Filename,
@@ -65,6 +65,7 @@ func (s *Service) addPackage(pkg *types.Package, tx *sqlx.Tx) error {
 			pkg.Version,
 			pkg.ApplicationID,
 			pkg.Arch,
+			pkg.UpstreamURL,
 		}).
 		Returning(goqu.T("package").All()).
 		ToSQL()
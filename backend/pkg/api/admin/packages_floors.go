@@ -11,6 +11,17 @@ import (
 
 // AddChannelPackageFloor marks a package as a floor for a specific channel
 func (s *Service) AddChannelPackageFloor(channelID, packageID string, floorReason null.String) error {
+	return s.addChannelPackageFloor(channelID, packageID, floorReason, types.FloorSourceManual)
+}
+
+// AddSyncedChannelPackageFloor marks a package as a floor for a specific
+// channel on behalf of the syncer. Floors already set by users keep being
+// considered as such.
+func (s *Service) AddSyncedChannelPackageFloor(channelID, packageID string, floorReason null.String) error {
+	return s.addChannelPackageFloor(channelID, packageID, floorReason, types.FloorSourceSyncer)
+}
+
+func (s *Service) addChannelPackageFloor(channelID, packageID string, floorReason null.String, source string) error {
 	// Verify channel and package exist and are compatible in a single query
 	var channelArch, pkgArch types.Arch
 	var channelAppID, pkgAppID string
@@ -58,10 +69,16 @@ func (s *Service) AddChannelPackageFloor(channelID, packageID string, floorReaso
 		return types.ErrPackageBlacklisted
 	}
 
+	// Floors set again by users aren't removed by the syncer anymore, while
+	// the syncer doesn't take over the floors set by users.
+	onConflict := goqu.Record{"floor_reason": floorReason}
+	if source == types.FloorSourceManual {
+		onConflict["source"] = source
+	}
 	query, _, err = goqu.Insert("channel_package_floors").
-		Cols("channel_id", "package_id", "floor_reason").
-		Vals(goqu.Vals{channelID, packageID, floorReason}).
-		OnConflict(goqu.DoUpdate("channel_id, package_id", goqu.Record{"floor_reason": floorReason})).
+		Cols("channel_id", "package_id", "floor_reason", "source").
+		Vals(goqu.Vals{channelID, packageID, floorReason, source}).
+		OnConflict(goqu.DoUpdate("channel_id, package_id", onConflict)).
 		ToSQL()
 
 	if err != nil {
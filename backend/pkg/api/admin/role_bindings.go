@@ -0,0 +1,112 @@
+package admin
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const maxRoleBindingSubjectLength = 255
+
+// AddRoleBinding registers the provided role binding.
+func (s *Service) AddRoleBinding(binding *types.RoleBinding) (*types.RoleBinding, error) {
+	if err := s.validateRoleBinding(binding); err != nil {
+		return nil, err
+	}
+
+	query, _, err := goqu.Insert("role_binding").
+		Cols("application_id", "group_id", "channel_id", "subject_type", "subject", "role", "created_by").
+		Vals(goqu.Vals{
+			binding.ApplicationID,
+			binding.GroupID,
+			binding.ChannelID,
+			binding.SubjectType,
+			binding.Subject,
+			binding.Role,
+			binding.CreatedBy,
+		}).
+		Returning(goqu.T("role_binding").All()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.QueryRowx(query).StructScan(binding); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: the subject already has a role binding on this scope", types.ErrInvalidRoleBinding)
+		}
+		return nil, err
+	}
+	return binding, nil
+}
+
+// DeleteRoleBinding removes the role binding identified by the id provided.
+func (s *Service) DeleteRoleBinding(bindingID string) error {
+	query, _, err := goqu.Delete("role_binding").
+		Where(goqu.C("id").Eq(bindingID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// validateRoleBinding checks that the role binding provided has a known
+// subject type and role, and that the group or channel it's restricted to, if
+// any, belongs to its application.
+func (s *Service) validateRoleBinding(binding *types.RoleBinding) error {
+	switch binding.SubjectType {
+	case types.RoleBindingSubjectOIDCRole, types.RoleBindingSubjectGithubTeam:
+	default:
+		return fmt.Errorf("%w: subject type must be %s or %s", types.ErrInvalidRoleBinding, types.RoleBindingSubjectOIDCRole, types.RoleBindingSubjectGithubTeam)
+	}
+
+	if binding.Subject == "" || len(binding.Subject) > maxRoleBindingSubjectLength {
+		return fmt.Errorf("%w: subject must have between 1 and %d characters", types.ErrInvalidRoleBinding, maxRoleBindingSubjectLength)
+	}
+
+	if !types.ValidRole(binding.Role) {
+		return fmt.Errorf("%w: role must be %s, %s or %s", types.ErrInvalidRoleBinding, types.RoleViewer, types.RoleOperator, types.RoleAdmin)
+	}
+
+	if binding.GroupID.Valid && binding.ChannelID.Valid {
+		return fmt.Errorf("%w: a role binding can't be restricted to both a group and a channel", types.ErrInvalidRoleBinding)
+	}
+	if binding.GroupID.Valid {
+		appID, err := s.GetGroupAppID(binding.GroupID.String)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if appID != binding.ApplicationID {
+			return fmt.Errorf("%w: the group doesn't belong to the application", types.ErrInvalidRoleBinding)
+		}
+	}
+	if binding.ChannelID.Valid {
+		appID, err := s.GetChannelAppID(binding.ChannelID.String)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if appID != binding.ApplicationID {
+			return fmt.Errorf("%w: the channel doesn't belong to the application", types.ErrInvalidRoleBinding)
+		}
+	}
+
+	return nil
+}
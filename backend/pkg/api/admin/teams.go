@@ -1,14 +1,33 @@
 package admin
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 
 	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
+const (
+	maxTeamNameLength    = 25
+	maxTeamSubjectLength = 255
+)
+
+// UpdateTeam updates an existing team using the content of the team
+// provided.
 func (s *Service) UpdateTeam(team *types.Team) error {
+	if err := s.validateTeam(team); err != nil {
+		return err
+	}
+
 	query, _, err := goqu.Update("team").
-		Set(goqu.Record{"name": team.Name}).
+		Set(goqu.Record{
+			"name":        team.Name,
+			"oidc_claims": team.OIDCClaims,
+			"github_orgs": team.GithubOrgs,
+		}).
 		Where(goqu.C("id").Eq(team.ID)).
 		ToSQL()
 	if err != nil {
@@ -16,14 +35,14 @@ func (s *Service) UpdateTeam(team *types.Team) error {
 	}
 	result, err := s.db.Exec(query)
 	if err != nil {
-		return err
+		return teamConstraintError(err)
 	}
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 	if rowsAffected == 0 {
-		return err
+		return types.ErrNoRowsAffected
 	}
 
 	return nil
@@ -31,16 +50,20 @@ func (s *Service) UpdateTeam(team *types.Team) error {
 
 // AddTeam registers a team.
 func (s *Service) AddTeam(team *types.Team) (*types.Team, error) {
+	if err := s.validateTeam(team); err != nil {
+		return nil, err
+	}
+
 	var query *goqu.InsertDataset
 	if team.ID != "" {
 		query = goqu.Insert("team").
-			Cols("id", "name").
-			Vals(goqu.Vals{team.ID, team.Name}).
+			Cols("id", "name", "oidc_claims", "github_orgs").
+			Vals(goqu.Vals{team.ID, team.Name, team.OIDCClaims, team.GithubOrgs}).
 			Returning(goqu.T("team").All())
 	} else {
 		query = goqu.Insert("team").
-			Cols("name").
-			Vals(goqu.Vals{team.Name}).
+			Cols("name", "oidc_claims", "github_orgs").
+			Vals(goqu.Vals{team.Name, team.OIDCClaims, team.GithubOrgs}).
 			Returning(goqu.T("team").All())
 	}
 	insertQuery, _, err := query.ToSQL()
@@ -50,7 +73,95 @@ func (s *Service) AddTeam(team *types.Team) (*types.Team, error) {
 	err = s.db.QueryRowx(insertQuery).StructScan(team)
 
 	if err != nil {
-		return nil, err
+		return nil, teamConstraintError(err)
 	}
 	return team, err
 }
+
+// DeleteTeam removes the team identified by the id provided, with its users,
+// API tokens and webhooks. The default team and the teams still owning
+// applications can't be deleted.
+func (s *Service) DeleteTeam(teamID string) error {
+	defaultTeam, err := s.GetTeam()
+	if err != nil {
+		return err
+	}
+	if defaultTeam.ID == teamID {
+		return fmt.Errorf("%w: the default team can't be deleted", types.ErrInvalidTeam)
+	}
+	appsCount, err := s.GetTeamAppsCount(teamID)
+	if err != nil {
+		return err
+	}
+	if appsCount > 0 {
+		return fmt.Errorf("%w: the team still owns %d applications", types.ErrInvalidTeam, appsCount)
+	}
+
+	query, _, err := goqu.Delete("team").
+		Where(goqu.C("id").Eq(teamID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// validateTeam checks that the team provided has a name and that the OIDC
+// claims and GitHub organizations mapping the users to it don't map them to
+// another team already.
+func (s *Service) validateTeam(team *types.Team) error {
+	if team.Name == "" || len(team.Name) > maxTeamNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", types.ErrInvalidTeam, maxTeamNameLength)
+	}
+	if team.OIDCClaims == nil {
+		team.OIDCClaims = pq.StringArray{}
+	}
+	if team.GithubOrgs == nil {
+		team.GithubOrgs = pq.StringArray{}
+	}
+	for _, subject := range append(append([]string{}, team.OIDCClaims...), team.GithubOrgs...) {
+		if subject == "" || len(subject) > maxTeamSubjectLength {
+			return fmt.Errorf("%w: OIDC claims and GitHub organizations must have between 1 and %d characters", types.ErrInvalidTeam, maxTeamSubjectLength)
+		}
+	}
+
+	query := goqu.From("team").
+		Where(goqu.Or(
+			goqu.L("oidc_claims && ?::varchar[]", team.OIDCClaims),
+			goqu.L("github_orgs && ?::varchar[]", team.GithubOrgs),
+		)).
+		Select(goqu.L("count(*)"))
+	if team.ID != "" {
+		query = query.Where(goqu.C("id").Neq(team.ID))
+	}
+	count, err := s.GetCountQuery(query)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: OIDC claims or GitHub organizations already map the users to another team", types.ErrInvalidTeam)
+	}
+	return nil
+}
+
+// teamConstraintError reports the violations of the unique team name as
+// invalid teams.
+func teamConstraintError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return fmt.Errorf("%w: a team with this name already exists", types.ErrInvalidTeam)
+	}
+	return err
+}
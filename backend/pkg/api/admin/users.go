@@ -1,25 +1,28 @@
 package admin
 
 import (
-	"crypto/md5"
+	"errors"
 	"fmt"
-	"io"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 
 	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
-const (
-	// Realm used for basic authentication.
-	Realm = "nebraska"
-)
-
-// AddUser registers a user.
+// AddUser registers a user. Its secret must be the hash of its password
+// returned by GenerateUserSecret, its role defaults to viewer.
 func (s *Service) AddUser(user *types.User) (*types.User, error) {
+	if user.Role == "" {
+		user.Role = types.UserRoleViewer
+	}
+	if err := types.ValidateUser(user); err != nil {
+		return nil, err
+	}
+
 	query, _, err := goqu.Insert("users").
-		Cols("username", "team_id", "secret").
-		Vals(goqu.Vals{user.Username, user.TeamID, user.Secret}).
+		Cols("username", "team_id", "secret", "role").
+		Vals(goqu.Vals{user.Username, user.TeamID, user.Secret, user.Role}).
 		Returning(goqu.T("users").All()).
 		ToSQL()
 	if err != nil {
@@ -27,14 +30,66 @@ func (s *Service) AddUser(user *types.User) (*types.User, error) {
 	}
 	err = s.db.QueryRowx(query).StructScan(user)
 	if err != nil {
-		return nil, err
+		return nil, userConstraintError(err)
 	}
 	return user, nil
 }
 
+// UpdateUser updates the role of an existing user using the content of the
+// user provided.
+func (s *Service) UpdateUser(user *types.User) error {
+	if err := types.ValidateUser(user); err != nil {
+		return err
+	}
+
+	query, _, err := goqu.Update("users").
+		Set(goqu.Record{"role": user.Role}).
+		Where(goqu.C("id").Eq(user.ID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteUser removes the user identified by the id provided.
+func (s *Service) DeleteUser(userID string) error {
+	query, _, err := goqu.Delete("users").
+		Where(goqu.C("id").Eq(userID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
 // UpdateUserPassword updates the password of the provided user.
 func (s *Service) UpdateUserPassword(username, newPassword string) error {
-	secret, err := s.GenerateUserSecret(username, newPassword)
+	secret, err := s.GenerateUserSecret(newPassword)
 	if err != nil {
 		return err
 	}
@@ -60,13 +115,16 @@ func (s *Service) UpdateUserPassword(username, newPassword string) error {
 	return nil
 }
 
-// GenerateUserSecret generates a md5 hash from the username and password
-// provided (username:realm:password).
-func (s *Service) GenerateUserSecret(username, password string) (string, error) {
-	h := md5.New()
-	if _, err := io.WriteString(h, username+":"+Realm+":"+password); err != nil {
-		return "", err
-	}
+// GenerateUserSecret generates a bcrypt hash from the password provided,
+// failing with ErrInvalidUser if the password is too short or too long.
+func (s *Service) GenerateUserSecret(password string) (string, error) {
+	return types.HashUserPassword(password)
+}
 
-	return fmt.Sprintf("%x", h.Sum(nil)), nil
+func userConstraintError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return fmt.Errorf("%w: a user with this username already exists", types.ErrInvalidUser)
+	}
+	return err
 }
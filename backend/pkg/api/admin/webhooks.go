@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+	"github.com/flatcar/nebraska/backend/pkg/random"
+)
+
+const (
+	// webhookSecretLength is the length of the secrets generated for the
+	// webhooks created without one.
+	webhookSecretLength = 32
+
+	maxWebhookNameLength = 50
+)
+
+// AddWebhook registers the provided webhook. A secret is generated when none
+// is provided. Only activity entries created after the webhook are delivered
+// to it.
+func (s *Service) AddWebhook(webhook *types.Webhook) (*types.Webhook, error) {
+	if err := s.validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = random.String(webhookSecretLength)
+	}
+
+	query, _, err := goqu.Insert("webhook").
+		Cols("name", "url", "secret", "team_id", "application_id", "group_id", "classes", "severities", "enabled", "enabled_ts").
+		Vals(goqu.Vals{
+			webhook.Name,
+			webhook.URL,
+			webhook.Secret,
+			webhook.TeamID,
+			webhook.ApplicationID,
+			webhook.GroupID,
+			webhook.Classes,
+			webhook.Severities,
+			webhook.Enabled,
+			time.Now().UTC(),
+		}).
+		Returning(goqu.T("webhook").All()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.QueryRowx(query).StructScan(webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook updates an existing webhook using the content of the webhook
+// provided. The secret is only updated when a new one is provided. Activity
+// entries created while the webhook was disabled are not delivered once it is
+// enabled again.
+func (s *Service) UpdateWebhook(webhook *types.Webhook) error {
+	webhookBeforeUpdate, err := s.GetWebhook(webhook.ID)
+	if err != nil {
+		return err
+	}
+	webhook.TeamID = webhookBeforeUpdate.TeamID
+	if err := s.validateWebhook(webhook); err != nil {
+		return err
+	}
+
+	record := goqu.Record{
+		"name":           webhook.Name,
+		"url":            webhook.URL,
+		"application_id": webhook.ApplicationID,
+		"group_id":       webhook.GroupID,
+		"classes":        webhook.Classes,
+		"severities":     webhook.Severities,
+		"enabled":        webhook.Enabled,
+	}
+	if webhook.Secret != "" {
+		record["secret"] = webhook.Secret
+	}
+	if webhook.Enabled && !webhookBeforeUpdate.Enabled {
+		record["enabled_ts"] = time.Now().UTC()
+	}
+
+	query, _, err := goqu.Update("webhook").
+		Set(record).
+		Where(goqu.C("id").Eq(webhook.ID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteWebhook removes the webhook identified by the id provided, along with
+// its deliveries log.
+func (s *Service) DeleteWebhook(webhookID string) error {
+	query, _, err := goqu.Delete("webhook").
+		Where(goqu.C("id").Eq(webhookID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := s.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return types.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// validateWebhook checks that the webhook provided has a name, a valid http(s)
+// url and filters referring to the applications and groups of its team and to
+// known activity classes and severities. The application is set from the
+// group when only the latter is provided.
+func (s *Service) validateWebhook(webhook *types.Webhook) error {
+	if webhook.Name == "" || len(webhook.Name) > maxWebhookNameLength {
+		return fmt.Errorf("%w: name must have between 1 and %d characters", types.ErrInvalidWebhook, maxWebhookNameLength)
+	}
+
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https url", types.ErrInvalidWebhook)
+	}
+
+	if webhook.GroupID.Valid {
+		group, err := s.GetGroup(webhook.GroupID.String)
+		if err != nil {
+			return types.ErrInvalidApplicationOrGroup
+		}
+		if webhook.ApplicationID.Valid && webhook.ApplicationID.String != group.ApplicationID {
+			return types.ErrInvalidApplicationOrGroup
+		}
+		webhook.ApplicationID.SetValid(group.ApplicationID)
+	}
+	if webhook.ApplicationID.Valid {
+		app, err := s.GetApp(webhook.ApplicationID.String)
+		if err != nil || app.TeamID != webhook.TeamID {
+			return types.ErrInvalidApplicationOrGroup
+		}
+	}
+
+	for _, class := range webhook.Classes {
+		if class < int64(types.ActivityPackageNotFound) || class > int64(types.ActivityInstancesDeleted) {
+			return fmt.Errorf("%w: unknown activity class %d", types.ErrInvalidWebhook, class)
+		}
+	}
+	for _, severity := range webhook.Severities {
+		if severity < int64(types.ActivitySuccess) || severity > int64(types.ActivityError) {
+			return fmt.Errorf("%w: unknown activity severity %d", types.ErrInvalidWebhook, severity)
+		}
+	}
+	if webhook.Classes == nil {
+		webhook.Classes = pq.Int64Array{}
+	}
+	if webhook.Severities == nil {
+		webhook.Severities = pq.Int64Array{}
+	}
+
+	return nil
+}
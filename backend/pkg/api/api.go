@@ -70,6 +70,10 @@ type API struct {
 	// disableUpdatesOnFailedRollout defines wether to disable updates
 	// after a first rollout attempt failed (ResultFailed)
 	disableUpdatesOnFailedRollout bool
+
+	// refuseSuspectedDuplicates defines whether to refuse updates to
+	// instances suspected of sharing their machine id with other machines
+	refuseSuspectedDuplicates bool
 }
 
 // New creates a new API instance, creates the underlying db connection.
@@ -134,8 +138,8 @@ func New(options ...func(*API) error) (*API, error) {
 	return api, nil
 }
 
-// NewWithMigrations creates a new API instance, creates the underlying db connection and
-// applies all available db migrations.
+// NewWithMigrations creates a new API instance, creates the underlying db connection,
+// applies all available db migrations and creates the upcoming table partitions.
 func NewWithMigrations(options ...func(*API) error) (*API, error) {
 	api, err := New(options...)
 	if err != nil {
@@ -148,6 +152,9 @@ func NewWithMigrations(options ...func(*API) error) (*API, error) {
 	if _, err := migrate.Exec(api.db.DB, "postgres", migrations, migrate.Up); err != nil {
 		return nil, err
 	}
+	if err := api.CreateFuturePartitions(); err != nil {
+		return nil, err
+	}
 	api.UpdateCachedGroups()
 	api.ClearCachedAppIDs()
 
@@ -233,6 +240,14 @@ func OptionDisableUpdatesOnFailedRollout(api *API) error {
 	return nil
 }
 
+// OptionRefuseSuspectedDuplicates will modify API to refuse updates to
+// instances suspected of sharing their machine id with other machines.
+func OptionRefuseSuspectedDuplicates(api *API) error {
+	api.refuseSuspectedDuplicates = true
+
+	return nil
+}
+
 // Close releases the connections to the database.
 func (api *API) Close() {
 	_ = api.db.Close()
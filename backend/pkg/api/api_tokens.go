@@ -0,0 +1,77 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// apiTokenLastUsedPrecision is how often the last use of the API tokens is
+// recorded, so that requests don't all write to the database.
+const apiTokenLastUsedPrecision = time.Minute
+
+var (
+	// ErrInvalidAPIToken indicates that the API token provided is not valid.
+	ErrInvalidAPIToken = types.ErrInvalidAPIToken
+
+	// ErrAPITokenRejected indicates that the API token used to authenticate
+	// a request is unknown, revoked or expired.
+	ErrAPITokenRejected = types.ErrAPITokenRejected
+)
+
+// Roles of the API tokens.
+const (
+	APITokenRoleViewer = types.APITokenRoleViewer
+	APITokenRoleAdmin  = types.APITokenRoleAdmin
+)
+
+// APITokenPrefix is the prefix of the API tokens.
+const APITokenPrefix = types.APITokenPrefix
+
+type APIToken = types.APIToken
+
+// IsAPIToken reports whether the bearer token provided looks like an API
+// token, rather than a token of the authenticator.
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix)
+}
+
+// AuthenticateAPIToken returns the API token matching the secret provided, or
+// ErrAPITokenRejected if it's unknown, revoked or expired. Its last use is
+// recorded.
+func (api *API) AuthenticateAPIToken(secret string) (*APIToken, error) {
+	token, err := api.GetAPITokenByHash(types.HashAPIToken(secret))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAPITokenRejected
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if !token.Active(now) {
+		return nil, ErrAPITokenRejected
+	}
+
+	if !token.LastUsedTs.Valid || now.Sub(token.LastUsedTs.Time) >= apiTokenLastUsedPrecision {
+		query, _, err := goqu.Update("api_token").
+			Set(goqu.Record{"last_used_ts": now.UTC()}).
+			Where(goqu.C("id").Eq(token.ID)).
+			ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := api.db.Exec(query); err != nil {
+			l.Error().Err(err).Str("token", token.ID).Msg("AuthenticateAPIToken - could not record last use")
+		} else {
+			token.LastUsedTs.SetValid(now)
+		}
+	}
+
+	return token, nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestAPITokens(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+
+	_, _, err := as.AddAPIToken(&APIToken{Name: "", TeamID: tTeam.ID, Role: APITokenRoleAdmin})
+	assert.ErrorIs(t, err, ErrInvalidAPIToken)
+	_, _, err = as.AddAPIToken(&APIToken{Name: "ci", TeamID: tTeam.ID, Role: "owner"})
+	assert.ErrorIs(t, err, ErrInvalidAPIToken)
+	_, _, err = as.AddAPIToken(&APIToken{Name: "ci", TeamID: tTeam.ID, Role: APITokenRoleViewer, ExpiresTs: null.TimeFrom(time.Now().Add(-time.Hour))})
+	assert.ErrorIs(t, err, ErrInvalidAPIToken)
+
+	token, secret, err := as.AddAPIToken(&APIToken{Name: "ci", TeamID: tTeam.ID, Role: APITokenRoleViewer, CreatedBy: "admin"})
+	require.NoError(t, err)
+	assert.True(t, IsAPIToken(secret))
+	assert.True(t, len(secret) > len(token.TokenPrefix))
+	assert.Equal(t, secret[:len(token.TokenPrefix)], token.TokenPrefix)
+	assert.NotEqual(t, secret, token.TokenHash)
+
+	authenticated, err := a.AuthenticateAPIToken(secret)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, authenticated.ID)
+	assert.Equal(t, tTeam.ID, authenticated.TeamID)
+	assert.True(t, authenticated.LastUsedTs.Valid)
+
+	stored, err := a.GetAPIToken(token.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.LastUsedTs.Valid)
+
+	_, err = a.AuthenticateAPIToken(secret + "x")
+	assert.ErrorIs(t, err, ErrAPITokenRejected)
+
+	tokensCount, err := a.GetAPITokensCount(tTeam.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokensCount)
+	tokens, err := a.GetAPITokens(tTeam.ID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.ID, tokens[0].ID)
+
+	err = as.RevokeAPIToken(token.ID)
+	require.NoError(t, err)
+	err = as.RevokeAPIToken(token.ID)
+	assert.Equal(t, ErrNoRowsAffected, err)
+
+	_, err = a.AuthenticateAPIToken(secret)
+	assert.ErrorIs(t, err, ErrAPITokenRejected)
+}
+
+func TestAPIToken_Active(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, (&APIToken{}).Active(now))
+	assert.True(t, (&APIToken{ExpiresTs: null.TimeFrom(now.Add(time.Hour))}).Active(now))
+	assert.False(t, (&APIToken{ExpiresTs: null.TimeFrom(now)}).Active(now))
+	assert.False(t, (&APIToken{RevokedTs: null.TimeFrom(now.Add(-time.Hour))}).Active(now))
+}
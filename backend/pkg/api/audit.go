@@ -0,0 +1,38 @@
+package api
+
+import "github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+
+const (
+	AuditActionCreate = types.AuditActionCreate
+	AuditActionUpdate = types.AuditActionUpdate
+	AuditActionDelete = types.AuditActionDelete
+
+	AuditTargetApplication       = types.AuditTargetApplication
+	AuditTargetGroup             = types.AuditTargetGroup
+	AuditTargetChannel           = types.AuditTargetChannel
+	AuditTargetPackage           = types.AuditTargetPackage
+	AuditTargetChannelFloor      = types.AuditTargetChannelFloor
+	AuditTargetInstance          = types.AuditTargetInstance
+	AuditTargetInstances         = types.AuditTargetInstances
+	AuditTargetWebhook           = types.AuditTargetWebhook
+	AuditTargetEmailNotification = types.AuditTargetEmailNotification
+	AuditTargetAPIToken          = types.AuditTargetAPIToken
+	AuditTargetRoleBinding       = types.AuditTargetRoleBinding
+	AuditTargetTeam              = types.AuditTargetTeam
+	AuditTargetUser              = types.AuditTargetUser
+	AuditTargetSyncer            = types.AuditTargetSyncer
+	AuditTargetActivity          = types.AuditTargetActivity
+)
+
+type (
+	AuditEntry       = types.AuditEntry
+	AuditQueryParams = types.AuditQueryParams
+	AuditState       = types.AuditState
+)
+
+// NewAuditStates returns the states stored in the audit log for a change of
+// its target from before to after, keeping only the fields that changed when
+// both are provided.
+func NewAuditStates(before, after any) (AuditState, AuditState, error) {
+	return types.NewAuditStates(before, after)
+}
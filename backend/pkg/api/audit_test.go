@@ -0,0 +1,82 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestNewAuditStates(t *testing.T) {
+	before := &Channel{ID: "c1", Name: "stable", Color: "blue", PackageID: null.StringFrom("p1")}
+	after := &Channel{ID: "c1", Name: "stable", Color: "blue", PackageID: null.StringFrom("p2")}
+
+	beforeState, afterState, err := NewAuditStates(before, after)
+	require.NoError(t, err)
+	assert.Equal(t, AuditState{"package_id": "p1"}, beforeState)
+	assert.Equal(t, AuditState{"package_id": "p2"}, afterState)
+
+	// Creations and deletions hold the whole state.
+	beforeState, afterState, err = NewAuditStates(nil, after)
+	require.NoError(t, err)
+	assert.Nil(t, beforeState)
+	assert.Equal(t, "stable", afterState["name"])
+
+	var noChannel *Channel
+	beforeState, afterState, err = NewAuditStates(before, noChannel)
+	require.NoError(t, err)
+	assert.Equal(t, "p1", beforeState["package_id"])
+	assert.Nil(t, afterState)
+}
+
+func TestAuditEntries(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tOtherTeam, _ := as.AddTeam(&Team{Name: "other_team"})
+
+	before, after, err := NewAuditStates(map[string]any{"name": "old", "color": "blue"}, map[string]any{"name": "new", "color": "blue"})
+	require.NoError(t, err)
+	entries := []*AuditEntry{
+		{TeamID: tTeam.ID, Username: "alice", Action: AuditActionCreate, TargetType: AuditTargetChannel, TargetID: "c1", ApplicationID: null.StringFrom(tApp.ID), After: AuditState{"name": "old"}},
+		{TeamID: tTeam.ID, Username: "bob", Action: AuditActionUpdate, TargetType: AuditTargetChannel, TargetID: "c1", ApplicationID: null.StringFrom(tApp.ID), Before: before, After: after},
+		{TeamID: tTeam.ID, Username: "bob", Action: AuditActionDelete, TargetType: AuditTargetInstances, ApplicationID: null.StringFrom(tApp.ID), Before: AuditState{"instances": 3}},
+		{TeamID: tOtherTeam.ID, Username: "carol", Action: AuditActionCreate, TargetType: AuditTargetWebhook, TargetID: "w1"},
+	}
+	for _, entry := range entries {
+		require.NoError(t, as.AddAuditEntry(entry))
+		assert.NotEmpty(t, entry.ID)
+	}
+
+	count, err := a.GetAuditEntriesCount(tTeam.ID, AuditQueryParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	result, err := a.GetAuditEntries(tTeam.ID, AuditQueryParams{Username: "bob", TargetType: AuditTargetChannel})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, AuditActionUpdate, result[0].Action)
+	assert.Equal(t, "c1", result[0].TargetID)
+	assert.Equal(t, AuditState{"name": "old"}, result[0].Before)
+	assert.Equal(t, AuditState{"name": "new"}, result[0].After)
+
+	result, err = a.GetAuditEntries(tTeam.ID, AuditQueryParams{Action: AuditActionDelete, AppID: tApp.ID})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Nil(t, result[0].After)
+	assert.Equal(t, float64(3), result[0].Before["instances"])
+
+	result, err = a.GetAuditEntries(tTeam.ID, AuditQueryParams{End: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+	assert.Len(t, result, 0)
+
+	result, err = a.GetAuditEntries(tOtherTeam.ID, AuditQueryParams{})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "carol", result[0].Username)
+}
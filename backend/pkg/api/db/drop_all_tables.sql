@@ -9,6 +9,7 @@ drop table if exists group_local cascade;
 drop table if exists instance cascade;
 drop table if exists instance_status cascade;
 drop table if exists instance_application cascade;
+drop table if exists instance_group_override cascade;
 drop table if exists instance_status_history cascade;
 drop table if exists event_type cascade;
 drop table if exists event cascade;
@@ -16,7 +17,26 @@ drop view if exists all_activity;
 drop table if exists admin_activity cascade;
 drop table if exists activity cascade;
 drop table if exists package_channel_blacklist cascade;
+drop table if exists instance_stats_daily cascade;
+drop table if exists instance_stats_weekly cascade;
+drop table if exists webhook_outbox cascade;
+drop table if exists email_notification_outbox cascade;
+drop table if exists webhook_delivery cascade;
+drop table if exists webhook cascade;
+drop table if exists audit_log cascade;
+drop table if exists email_notification cascade;
+drop table if exists api_token cascade;
+drop table if exists role_binding cascade;
+drop table if exists github_session cascade;
+drop table if exists session cascade;
+drop table if exists user_login_failures cascade;
 drop table if exists database_migrations;
 drop function if exists create_group_local_for_group();
+drop function if exists create_monthly_partitions(text, timestamptz, timestamptz);
+drop function if exists notify_activity();
+drop function if exists notify_instance_status();
+drop function if exists queue_webhook_activity();
+drop function if exists queue_email_notification_activity();
+drop function if exists version_to_int_array(text);
 -- Legacy tables if we're dropping tables in a non-migrated DB
 drop table if exists coreos_action cascade;
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+-- URL of the upstream update server the package was synced from, if any.
+ALTER TABLE package ADD COLUMN upstream_url VARCHAR(2048);
+
+-- +migrate Down
+
+ALTER TABLE package DROP COLUMN upstream_url;
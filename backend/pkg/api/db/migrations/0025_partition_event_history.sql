@@ -0,0 +1,145 @@
+-- +migrate Up
+
+-- event and instance_status_history grow with the fleet size and are only
+-- ever queried by recency, so they are range partitioned by created_ts in
+-- monthly partitions named <table>_pYYYYMM. Old rows can then be removed by
+-- dropping whole partitions.
+
+-- create_monthly_partitions creates the monthly partitions of the table
+-- provided covering the range [from_ts, to_ts), skipping the existing ones.
+-- +migrate StatementBegin
+create function create_monthly_partitions(parent text, from_ts timestamptz, to_ts timestamptz) returns void as $$
+declare
+    month_start timestamp := date_trunc('month', from_ts at time zone 'UTC');
+    month_end   timestamp;
+begin
+    while month_start < to_ts at time zone 'UTC' loop
+        month_end := month_start + interval '1 month';
+        execute format('create table if not exists %I partition of %I for values from (%L) to (%L)',
+            parent || '_p' || to_char(month_start, 'YYYYMM'), parent,
+            month_start at time zone 'UTC', month_end at time zone 'UTC');
+        month_start := month_end;
+    end loop;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+-- event
+
+alter table event rename to event_unpartitioned;
+
+create table event (
+	id integer not null default nextval('event_id_seq'),
+	created_ts timestamptz default current_timestamp not null,
+	previous_version varchar(255),
+	error_code varchar(100),
+	instance_id varchar(50) not null references instance (id) on delete cascade,
+	application_id uuid not null references application (id) on delete cascade,
+	event_type_id integer not null references event_type (id)
+) partition by range (created_ts);
+
+select create_monthly_partitions('event',
+    coalesce((select min(created_ts) from event_unpartitioned), current_timestamp),
+    greatest((select max(created_ts) from event_unpartitioned), current_timestamp) + interval '3 months');
+
+insert into event (id, created_ts, previous_version, error_code, instance_id, application_id, event_type_id)
+select id, created_ts, previous_version, error_code, instance_id, application_id, event_type_id from event_unpartitioned;
+
+alter sequence event_id_seq owned by none;
+drop table event_unpartitioned;
+alter sequence event_id_seq owned by event.id;
+
+alter table event add primary key (id, created_ts);
+
+create index event_instance_id_idx on event (instance_id);
+
+-- instance_status_history
+
+alter table instance_status_history rename to instance_status_history_unpartitioned;
+
+create table instance_status_history (
+	id integer not null default nextval('instance_status_history_id_seq'),
+	status integer,
+	version varchar(255) check (version <> ''),
+	created_ts timestamptz default current_timestamp not null,
+	instance_id varchar(50) not null references instance (id) on delete cascade,
+	application_id uuid not null references application (id) on delete cascade,
+	group_id uuid references groups (id) on delete cascade
+) partition by range (created_ts);
+
+select create_monthly_partitions('instance_status_history',
+    coalesce((select min(created_ts) from instance_status_history_unpartitioned), current_timestamp),
+    greatest((select max(created_ts) from instance_status_history_unpartitioned), current_timestamp) + interval '3 months');
+
+insert into instance_status_history (id, status, version, created_ts, instance_id, application_id, group_id)
+select id, status, version, created_ts, instance_id, application_id, group_id from instance_status_history_unpartitioned;
+
+alter sequence instance_status_history_id_seq owned by none;
+drop table instance_status_history_unpartitioned;
+alter sequence instance_status_history_id_seq owned by instance_status_history.id;
+
+alter table instance_status_history add primary key (id, created_ts);
+
+create index instance_status_history_instance_id_idx on instance_status_history (instance_id);
+create index instance_status_history_group_id_idx on instance_status_history (group_id);
+create index instance_status_history_status_created_ts_idx on instance_status_history (status, created_ts);
+create index instance_status_history_group_id_status_created_ts_idx on instance_status_history (group_id, status, created_ts);
+create index instance_status_history_instance_id_status_created_ts_idx on instance_status_history (instance_id, status, created_ts);
+
+-- +migrate Down
+
+-- event
+
+alter table event rename to event_partitioned;
+
+create table event (
+	id integer not null default nextval('event_id_seq'),
+	created_ts timestamptz default current_timestamp not null,
+	previous_version varchar(255),
+	error_code varchar(100),
+	instance_id varchar(50) not null references instance (id) on delete cascade,
+	application_id uuid not null references application (id) on delete cascade,
+	event_type_id integer not null references event_type (id)
+);
+
+insert into event (id, created_ts, previous_version, error_code, instance_id, application_id, event_type_id)
+select id, created_ts, previous_version, error_code, instance_id, application_id, event_type_id from event_partitioned;
+
+alter sequence event_id_seq owned by none;
+drop table event_partitioned;
+alter sequence event_id_seq owned by event.id;
+
+alter table event add primary key (id);
+
+create index event_instance_id_idx on event (instance_id);
+
+-- instance_status_history
+
+alter table instance_status_history rename to instance_status_history_partitioned;
+
+create table instance_status_history (
+	id integer not null default nextval('instance_status_history_id_seq'),
+	status integer,
+	version varchar(255) check (version <> ''),
+	created_ts timestamptz default current_timestamp not null,
+	instance_id varchar(50) not null references instance (id) on delete cascade,
+	application_id uuid not null references application (id) on delete cascade,
+	group_id uuid references groups (id) on delete cascade
+);
+
+insert into instance_status_history (id, status, version, created_ts, instance_id, application_id, group_id)
+select id, status, version, created_ts, instance_id, application_id, group_id from instance_status_history_partitioned;
+
+alter sequence instance_status_history_id_seq owned by none;
+drop table instance_status_history_partitioned;
+alter sequence instance_status_history_id_seq owned by instance_status_history.id;
+
+alter table instance_status_history add primary key (id);
+
+create index instance_status_history_instance_id_idx on instance_status_history (instance_id);
+create index instance_status_history_group_id_idx on instance_status_history (group_id);
+create index instance_status_history_status_created_ts_idx on instance_status_history (status, created_ts);
+create index instance_status_history_group_id_status_created_ts_idx on instance_status_history (group_id, status, created_ts);
+create index instance_status_history_instance_id_status_created_ts_idx on instance_status_history (instance_id, status, created_ts);
+
+drop function if exists create_monthly_partitions(text, timestamptz, timestamptz);
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+create index if not exists instance_ip_idx on instance using gist (ip inet_ops);
+
+create index if not exists instance_oem_aleph_version_idx on instance (oem, aleph_version);
+
+create index if not exists instance_application_application_id_last_check_for_updat_idx on instance_application (application_id, last_check_for_updates);
+
+-- +migrate Down
+
+drop index if exists instance_ip_idx;
+
+drop index if exists instance_oem_aleph_version_idx;
+
+drop index if exists instance_application_application_id_last_check_for_updat_idx;
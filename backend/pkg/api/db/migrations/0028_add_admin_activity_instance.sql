@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- Deleting instances is recorded in admin_activity, which needs to keep the
+-- id of the instance deleted, so it can't reference the instance table.
+alter table admin_activity add column instance_id varchar(50);
+
+create index admin_activity_instance_id_idx on admin_activity (instance_id);
+
+-- The activity of deleted instances is kept, just unlinked from them.
+alter table activity drop constraint activity_instance_id_fkey;
+alter table activity add constraint activity_instance_id_fkey
+	foreign key (instance_id) references instance (id) on delete set null;
+
+create or replace view all_activity as
+	select id, created_ts, class, severity, version,
+	       application_id, group_id,
+	       null::uuid as channel_id,
+	       instance_id
+	from activity
+	union all
+	select id, created_ts, class, severity, version,
+	       application_id, group_id, channel_id,
+	       instance_id
+	from admin_activity;
+
+-- +migrate Down
+
+create or replace view all_activity as
+	select id, created_ts, class, severity, version,
+	       application_id, group_id,
+	       null::uuid as channel_id,
+	       instance_id
+	from activity
+	union all
+	select id, created_ts, class, severity, version,
+	       application_id, group_id, channel_id,
+	       null::varchar(50) as instance_id
+	from admin_activity;
+
+alter table activity drop constraint activity_instance_id_fkey;
+alter table activity add constraint activity_instance_id_fkey
+	foreign key (instance_id) references instance (id) on delete cascade;
+
+drop index if exists admin_activity_instance_id_idx;
+
+alter table admin_activity drop column instance_id;
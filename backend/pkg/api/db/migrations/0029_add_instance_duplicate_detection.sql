@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- Cloned machines sharing the same machine id show up as a single instance
+-- whose IP and version keep flapping. The previous IP and version, and when
+-- they changed, are kept to detect it.
+alter table instance add column previous_ip inet;
+alter table instance add column ip_changed_ts timestamptz;
+alter table instance add column duplicate_suspected_ts timestamptz;
+
+alter table instance_application add column previous_version varchar(255);
+alter table instance_application add column version_changed_ts timestamptz;
+
+-- +migrate Down
+
+alter table instance_application drop column version_changed_ts;
+alter table instance_application drop column previous_version;
+
+alter table instance drop column duplicate_suspected_ts;
+alter table instance drop column ip_changed_ts;
+alter table instance drop column previous_ip;
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- instance_group_override assigns an instance to a group of an application
+-- regardless of the track it reports, so machines can be moved between groups
+-- without being reconfigured.
+create table instance_group_override (
+    instance_id    varchar(50) not null references instance (id) on delete cascade,
+    application_id uuid        not null references application (id) on delete cascade,
+    group_id       uuid        not null references groups (id) on delete cascade,
+    created_ts     timestamptz not null default current_timestamp,
+    primary key (instance_id, application_id)
+);
+
+create index on instance_group_override (group_id);
+
+-- The group matching the track reported by the instance, which differs from
+-- group_id when the instance has been assigned to another group. It is set on
+-- the next check-in of existing instances.
+alter table instance_application add column requested_group_id uuid references groups (id) on delete set null;
+
+-- +migrate Down
+
+alter table instance_application drop column requested_group_id;
+
+drop table if exists instance_group_override;
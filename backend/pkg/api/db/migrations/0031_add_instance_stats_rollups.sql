@@ -0,0 +1,32 @@
+-- +migrate Up
+
+-- Daily and weekly rollups of the hourly instance_stats snapshots, holding
+-- the average number of instances per version over each day or week, so that
+-- long timelines can be charted without keeping every hourly snapshot.
+create table instance_stats_daily (
+    timestamp timestamptz not null,
+    channel_name varchar(25) not null,
+    arch varchar(7) not null,
+    version varchar(255) not null,
+    instances int not null check (instances >= 0),
+    unique(timestamp, channel_name, arch, version)
+);
+
+create table instance_stats_weekly (
+    timestamp timestamptz not null,
+    channel_name varchar(25) not null,
+    arch varchar(7) not null,
+    version varchar(255) not null,
+    instances int not null check (instances >= 0),
+    unique(timestamp, channel_name, arch, version)
+);
+
+create index if not exists instance_stats_channel_name_arch_timestamp_idx on instance_stats (channel_name, arch, timestamp);
+create index on instance_stats_daily (channel_name, arch, timestamp);
+create index on instance_stats_weekly (channel_name, arch, timestamp);
+
+-- +migrate Down
+
+drop index if exists instance_stats_channel_name_arch_timestamp_idx;
+drop table if exists instance_stats_weekly;
+drop table if exists instance_stats_daily;
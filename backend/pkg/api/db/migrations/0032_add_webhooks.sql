@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- webhook holds the subscriptions notified of the activity entries of a team
+-- matching their filters. Empty classes and severities match all of them.
+create table webhook (
+    id               uuid         primary key default uuid_generate_v4(),
+    name             varchar(50)  not null check (name <> ''),
+    url              text         not null check (url <> ''),
+    secret           varchar(255) not null,
+    team_id          uuid         not null references team (id) on delete cascade,
+    application_id   uuid         references application (id) on delete cascade,
+    group_id         uuid         references groups (id) on delete cascade,
+    classes          integer[]    not null default '{}',
+    severities       integer[]    not null default '{}',
+    enabled          boolean      not null default true,
+    -- Creation time of the last activity entry queued for delivery.
+    last_activity_ts timestamptz  not null default current_timestamp,
+    created_ts       timestamptz  not null default current_timestamp
+);
+
+create index on webhook (team_id);
+
+-- webhook_delivery is the log of the deliveries of activity entries to the
+-- webhooks, including the ones still pending.
+create table webhook_delivery (
+    id              uuid        primary key default uuid_generate_v4(),
+    webhook_id      uuid        not null references webhook (id) on delete cascade,
+    activity_id     uuid        not null,
+    payload         jsonb       not null,
+    status          varchar(20) not null default 'pending',
+    attempts        integer     not null default 0,
+    next_attempt_ts timestamptz not null default current_timestamp,
+    last_attempt_ts timestamptz,
+    response_status integer,
+    last_error      text,
+    created_ts      timestamptz not null default current_timestamp,
+    unique (webhook_id, activity_id)
+);
+
+create index on webhook_delivery (webhook_id, created_ts);
+create index on webhook_delivery (next_attempt_ts) where status = 'pending';
+
+-- +migrate Down
+
+drop table if exists webhook_delivery;
+drop table if exists webhook;
@@ -0,0 +1,58 @@
+-- +migrate Up
+
+-- New activity entries and changes of the status or group of the instances
+-- are notified to the listeners of the nebraska_activity and
+-- nebraska_instance_status channels, so that they are streamed to the clients
+-- of every Nebraska replica.
+
+-- +migrate StatementBegin
+create function notify_activity() returns trigger as $$
+begin
+    perform pg_notify('nebraska_activity', json_build_object('id', NEW.id)::text);
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+create function notify_instance_status() returns trigger as $$
+begin
+    if NEW.group_id is not null then
+        perform pg_notify('nebraska_instance_status',
+            json_build_object('application_id', NEW.application_id, 'group_id', NEW.group_id)::text);
+    end if;
+    if TG_OP = 'UPDATE' and OLD.group_id is not null and OLD.group_id is distinct from NEW.group_id then
+        perform pg_notify('nebraska_instance_status',
+            json_build_object('application_id', OLD.application_id, 'group_id', OLD.group_id)::text);
+    end if;
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger activity_notify
+    after insert on activity
+    for each row execute function notify_activity();
+
+create trigger admin_activity_notify
+    after insert on admin_activity
+    for each row execute function notify_activity();
+
+create trigger instance_application_insert_notify
+    after insert on instance_application
+    for each row execute function notify_instance_status();
+
+create trigger instance_application_update_notify
+    after update of status, group_id on instance_application
+    for each row
+    when (OLD.status is distinct from NEW.status or OLD.group_id is distinct from NEW.group_id)
+    execute function notify_instance_status();
+
+-- +migrate Down
+
+drop trigger if exists instance_application_update_notify on instance_application;
+drop trigger if exists instance_application_insert_notify on instance_application;
+drop trigger if exists admin_activity_notify on admin_activity;
+drop trigger if exists activity_notify on activity;
+drop function if exists notify_instance_status();
+drop function if exists notify_activity();
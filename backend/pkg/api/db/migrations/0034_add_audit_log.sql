@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- audit_log records the configuration changes made through the API, along
+-- with the user who made them. Entries aren't tied to the rows they refer to,
+-- so that they are kept when those rows are deleted.
+create table audit_log (
+    id             uuid         primary key default uuid_generate_v4(),
+    created_ts     timestamptz  not null default current_timestamp,
+    team_id        uuid         not null,
+    username       varchar(255) not null default '',
+    action         varchar(20)  not null,
+    target_type    varchar(50)  not null,
+    target_id      varchar(255) not null default '',
+    application_id uuid,
+    -- State of the target before and after the change. Updates only hold the
+    -- fields that changed.
+    before         jsonb,
+    after          jsonb
+);
+
+create index on audit_log (team_id, created_ts);
+create index on audit_log (target_type, target_id);
+
+-- +migrate Down
+
+drop table if exists audit_log;
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- email_notification holds the email recipients notified of the rollouts
+-- outcomes of an application, and optionally sent a daily digest of the
+-- failures reported by its instances.
+create table email_notification (
+    id               uuid        primary key default uuid_generate_v4(),
+    application_id   uuid        not null references application (id) on delete cascade,
+    recipients       text[]      not null check (cardinality(recipients) > 0),
+    rollout_finished boolean     not null default true,
+    rollout_failed   boolean     not null default true,
+    daily_digest     boolean     not null default false,
+    enabled          boolean     not null default true,
+    -- End of the last window of activity entries notified.
+    last_activity_ts timestamptz not null default current_timestamp,
+    -- End of the period covered by the last daily digest.
+    last_digest_ts   timestamptz not null default current_timestamp,
+    created_ts       timestamptz not null default current_timestamp
+);
+
+create index on email_notification (application_id);
+
+-- +migrate Down
+
+drop table if exists email_notification;
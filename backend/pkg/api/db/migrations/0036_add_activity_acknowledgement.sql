@@ -0,0 +1,60 @@
+-- +migrate Up
+
+-- Activity entries can be acknowledged by a user, optionally with a comment,
+-- and later marked as resolved, so error entries can be worked through as a
+-- queue.
+alter table activity add column acknowledged_ts timestamptz;
+alter table activity add column acknowledged_by varchar(255);
+alter table activity add column ack_comment text;
+alter table activity add column resolved_ts timestamptz;
+
+alter table admin_activity add column acknowledged_ts timestamptz;
+alter table admin_activity add column acknowledged_by varchar(255);
+alter table admin_activity add column ack_comment text;
+alter table admin_activity add column resolved_ts timestamptz;
+
+create index activity_unacknowledged_idx on activity (created_ts) where acknowledged_ts is null;
+create index admin_activity_unacknowledged_idx on admin_activity (created_ts) where acknowledged_ts is null;
+
+create or replace view all_activity as
+	select id, created_ts, class, severity, version,
+	       application_id, group_id,
+	       null::uuid as channel_id,
+	       instance_id,
+	       acknowledged_ts, acknowledged_by, ack_comment, resolved_ts
+	from activity
+	union all
+	select id, created_ts, class, severity, version,
+	       application_id, group_id, channel_id,
+	       instance_id,
+	       acknowledged_ts, acknowledged_by, ack_comment, resolved_ts
+	from admin_activity;
+
+-- +migrate Down
+
+drop view if exists all_activity;
+
+create view all_activity as
+	select id, created_ts, class, severity, version,
+	       application_id, group_id,
+	       null::uuid as channel_id,
+	       instance_id
+	from activity
+	union all
+	select id, created_ts, class, severity, version,
+	       application_id, group_id, channel_id,
+	       instance_id
+	from admin_activity;
+
+drop index if exists admin_activity_unacknowledged_idx;
+drop index if exists activity_unacknowledged_idx;
+
+alter table admin_activity drop column resolved_ts;
+alter table admin_activity drop column ack_comment;
+alter table admin_activity drop column acknowledged_by;
+alter table admin_activity drop column acknowledged_ts;
+
+alter table activity drop column resolved_ts;
+alter table activity drop column ack_comment;
+alter table activity drop column acknowledged_by;
+alter table activity drop column acknowledged_ts;
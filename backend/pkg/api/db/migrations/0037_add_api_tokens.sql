@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- api_token holds the long-lived tokens used by automation to call the API on
+-- behalf of a team, with a read-only (viewer) or admin role. Only the SHA-256
+-- hash of the tokens is stored, along with a short prefix to recognize them.
+create table api_token (
+    id           uuid         primary key default uuid_generate_v4(),
+    name         varchar(50)  not null check (name <> ''),
+    team_id      uuid         not null references team (id) on delete cascade,
+    role         varchar(20)  not null check (role in ('viewer', 'admin')),
+    token_hash   varchar(64)  not null unique,
+    token_prefix varchar(20)  not null,
+    created_by   varchar(255) not null default '',
+    expires_ts   timestamptz,
+    last_used_ts timestamptz,
+    revoked_ts   timestamptz,
+    created_ts   timestamptz  not null default current_timestamp
+);
+
+create index on api_token (team_id);
+
+-- +migrate Down
+
+drop table if exists api_token;
@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- Role bindings grant the users having an OIDC role or belonging to a GitHub
+-- team or organization a role on an application, optionally restricted to one
+-- of its groups or channels.
+create table if not exists role_binding (
+	id uuid primary key default uuid_generate_v4(),
+	application_id uuid not null references application (id) on delete cascade,
+	group_id uuid references groups (id) on delete cascade,
+	channel_id uuid references channel (id) on delete cascade,
+	subject_type varchar(20) not null check (subject_type in ('oidc_role', 'github_team')),
+	subject varchar(255) not null check (subject <> ''),
+	role varchar(20) not null check (role in ('viewer', 'operator', 'admin')),
+	created_by varchar(255) not null default '',
+	created_ts timestamptz default current_timestamp not null,
+	check (group_id is null or channel_id is null)
+);
+
+create unique index role_binding_unique_idx on role_binding (application_id, coalesce(group_id::text, ''), coalesce(channel_id::text, ''), subject_type, subject);
+create index role_binding_subject_idx on role_binding (subject_type, subject);
+
+-- +migrate Down
+
+drop table if exists role_binding;
@@ -0,0 +1,12 @@
+-- +migrate Up
+
+-- The users are mapped to the teams owning the applications they can access
+-- by the values of an OIDC claim or by the GitHub organizations they belong
+-- to. The users not mapped to any team belong to the default one.
+alter table team add column if not exists oidc_claims varchar(255)[] not null default '{}';
+alter table team add column if not exists github_orgs varchar(255)[] not null default '{}';
+
+-- +migrate Down
+
+alter table team drop column if exists oidc_claims;
+alter table team drop column if exists github_orgs;
@@ -0,0 +1,33 @@
+-- +migrate Up
+
+-- Sessions of the users logged in through GitHub, shared between the Nebraska
+-- replicas and kept across restarts. The data holds the gob-encoded session
+-- values.
+create table if not exists session (
+	id varchar(64) primary key,
+	data bytea not null,
+	created_ts timestamptz default current_timestamp not null,
+	expires_ts timestamptz not null
+);
+
+create index session_expires_ts_idx on session (expires_ts);
+
+-- GitHub users owning the sessions, along with the organization and the
+-- optional team granting them access and all of their teams and
+-- organizations, so that the webhook events can drop the sessions of the
+-- users leaving an organization or a team.
+create table if not exists github_session (
+	session_id varchar(64) primary key references session (id) on delete cascade,
+	username varchar(255) not null,
+	org varchar(255) not null default '',
+	team varchar(255),
+	subjects varchar(255)[] not null default '{}'
+);
+
+create index github_session_username_idx on github_session (username);
+create index github_session_org_team_idx on github_session (org, team);
+
+-- +migrate Down
+
+drop table if exists github_session;
+drop table if exists session;
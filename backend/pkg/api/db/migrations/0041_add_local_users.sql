@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- The local users log in with a password, hashed with bcrypt rather than
+-- digested with md5. The legacy md5 digests are replaced by bcrypt hashes the
+-- next time their users log in. The role of the users applies to all the
+-- applications of their team.
+alter table users alter column secret type varchar(255);
+alter table users add column if not exists role varchar(20) not null default 'viewer' check (role in ('viewer', 'admin'));
+
+-- +migrate Down
+
+-- the secrets are not shortened back, bcrypt hashes would not fit
+alter table users drop column if exists role;
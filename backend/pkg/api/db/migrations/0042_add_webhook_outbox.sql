@@ -0,0 +1,42 @@
+-- +migrate Up
+
+-- The new activity entries are queued in webhook_outbox by the transaction
+-- creating them, so that none is missed by the webhooks dispatcher whatever
+-- their creation time and however late their transaction commits. Entries
+-- are only queued while there are enabled webhooks.
+create table webhook_outbox (
+    id          bigserial   primary key,
+    activity_id uuid        not null,
+    created_ts  timestamptz not null default current_timestamp
+);
+
+-- +migrate StatementBegin
+create function queue_webhook_activity() returns trigger as $$
+begin
+    if exists (select 1 from webhook where enabled) then
+        insert into webhook_outbox (activity_id) values (NEW.id);
+    end if;
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger activity_webhook_outbox
+    after insert on activity
+    for each row execute function queue_webhook_activity();
+
+create trigger admin_activity_webhook_outbox
+    after insert on admin_activity
+    for each row execute function queue_webhook_activity();
+
+-- The webhooks no longer keep a cursor on the activity entries, only the time
+-- since which they are delivered the activity entries.
+alter table webhook rename column last_activity_ts to enabled_ts;
+
+-- +migrate Down
+
+alter table webhook rename column enabled_ts to last_activity_ts;
+drop trigger if exists admin_activity_webhook_outbox on admin_activity;
+drop trigger if exists activity_webhook_outbox on activity;
+drop function if exists queue_webhook_activity();
+drop table if exists webhook_outbox;
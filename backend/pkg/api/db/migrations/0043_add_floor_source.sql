@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- The floors created by the syncer are the only ones it removes when they
+-- aren't listed upstream anymore. The origin of the existing floors is not
+-- known, so they are considered to be set by users.
+alter table channel_package_floors add column if not exists source varchar(10) not null default 'manual' check (source in ('manual', 'syncer'));
+
+-- +migrate Down
+
+alter table channel_package_floors drop column if exists source;
@@ -0,0 +1,61 @@
+-- +migrate Up
+
+-- Rows falling outside of the monthly partitions, like the ones with a
+-- created_ts far in the future, go to a default partition instead of failing
+-- to be inserted. They are moved to their monthly partition when it's
+-- created, as postgres refuses to create a partition whose range holds rows
+-- of the default one.
+
+-- +migrate StatementBegin
+create or replace function create_monthly_partitions(parent text, from_ts timestamptz, to_ts timestamptz) returns void as $$
+declare
+    month_start timestamp := date_trunc('month', from_ts at time zone 'UTC');
+    month_end   timestamp;
+    partition   text;
+begin
+    while month_start < to_ts at time zone 'UTC' loop
+        month_end := month_start + interval '1 month';
+        partition := parent || '_p' || to_char(month_start, 'YYYYMM');
+        if to_regclass(quote_ident(partition)) is null then
+            execute format('create table %I (like %I including defaults including constraints)', partition, parent);
+            if to_regclass(quote_ident(parent || '_default')) is not null then
+                execute format('with moved as (delete from %I where created_ts >= %L and created_ts < %L returning *) insert into %I select * from moved',
+                    parent || '_default', month_start at time zone 'UTC', month_end at time zone 'UTC', partition);
+            end if;
+            execute format('alter table %I attach partition %I for values from (%L) to (%L)',
+                parent, partition, month_start at time zone 'UTC', month_end at time zone 'UTC');
+        end if;
+        month_start := month_end;
+    end loop;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create table if not exists event_default partition of event default;
+create table if not exists instance_status_history_default partition of instance_status_history default;
+
+-- +migrate Down
+
+-- the rows of the default partitions are moved to monthly partitions first
+select create_monthly_partitions('event', min(created_ts), max(created_ts) + interval '1 month') from event_default having count(*) > 0;
+select create_monthly_partitions('instance_status_history', min(created_ts), max(created_ts) + interval '1 month') from instance_status_history_default having count(*) > 0;
+
+drop table if exists event_default;
+drop table if exists instance_status_history_default;
+
+-- +migrate StatementBegin
+create or replace function create_monthly_partitions(parent text, from_ts timestamptz, to_ts timestamptz) returns void as $$
+declare
+    month_start timestamp := date_trunc('month', from_ts at time zone 'UTC');
+    month_end   timestamp;
+begin
+    while month_start < to_ts at time zone 'UTC' loop
+        month_end := month_start + interval '1 month';
+        execute format('create table if not exists %I partition of %I for values from (%L) to (%L)',
+            parent || '_p' || to_char(month_start, 'YYYYMM'), parent,
+            month_start at time zone 'UTC', month_end at time zone 'UTC');
+        month_start := month_end;
+    end loop;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
@@ -0,0 +1,76 @@
+-- +migrate Up
+
+-- The instance stats snapshots were only identified by the name and arch of
+-- their channel, mixing up the channels of the same name of different
+-- applications and teams. They now reference their channel. The existing
+-- snapshots are assigned to the only channel matching their name and arch,
+-- and removed when there is no such channel or more than one.
+
+alter table instance_stats add column channel_id uuid references channel (id) on delete cascade;
+alter table instance_stats_daily add column channel_id uuid references channel (id) on delete cascade;
+alter table instance_stats_weekly add column channel_id uuid references channel (id) on delete cascade;
+
+create temporary table instance_stats_channels on commit drop as
+	select name, case arch when 1 then 'AMD64' when 2 then 'ARM' else '' end as arch, min(id::text)::uuid as id
+	from channel
+	group by 1, 2
+	having count(*) = 1;
+
+update instance_stats s set channel_id = c.id from instance_stats_channels c where s.channel_name = c.name and s.arch = c.arch;
+update instance_stats_daily s set channel_id = c.id from instance_stats_channels c where s.channel_name = c.name and s.arch = c.arch;
+update instance_stats_weekly s set channel_id = c.id from instance_stats_channels c where s.channel_name = c.name and s.arch = c.arch;
+
+delete from instance_stats where channel_id is null;
+delete from instance_stats_daily where channel_id is null;
+delete from instance_stats_weekly where channel_id is null;
+
+alter table instance_stats alter column channel_id set not null;
+alter table instance_stats_daily alter column channel_id set not null;
+alter table instance_stats_weekly alter column channel_id set not null;
+
+alter table instance_stats drop constraint if exists instance_stats_timestamp_channel_name_arch_version_key;
+alter table instance_stats_daily drop constraint if exists instance_stats_daily_timestamp_channel_name_arch_version_key;
+alter table instance_stats_weekly drop constraint if exists instance_stats_weekly_timestamp_channel_name_arch_version_key;
+
+alter table instance_stats add unique (timestamp, channel_id, version);
+alter table instance_stats_daily add unique (timestamp, channel_id, version);
+alter table instance_stats_weekly add unique (timestamp, channel_id, version);
+
+drop index if exists instance_stats_channel_name_arch_timestamp_idx;
+drop index if exists instance_stats_daily_channel_name_arch_timestamp_idx;
+drop index if exists instance_stats_weekly_channel_name_arch_timestamp_idx;
+
+create index instance_stats_channel_id_timestamp_idx on instance_stats (channel_id, timestamp);
+create index instance_stats_daily_channel_id_timestamp_idx on instance_stats_daily (channel_id, timestamp);
+create index instance_stats_weekly_channel_id_timestamp_idx on instance_stats_weekly (channel_id, timestamp);
+
+-- +migrate Down
+
+drop index if exists instance_stats_weekly_channel_id_timestamp_idx;
+drop index if exists instance_stats_daily_channel_id_timestamp_idx;
+drop index if exists instance_stats_channel_id_timestamp_idx;
+
+-- the snapshots of the channels of the same name are merged back
+create temporary table instance_stats_merged on commit drop as
+	select timestamp, channel_name, arch, version, sum(instances)::int as instances from instance_stats group by 1, 2, 3, 4;
+create temporary table instance_stats_daily_merged on commit drop as
+	select timestamp, channel_name, arch, version, sum(instances)::int as instances from instance_stats_daily group by 1, 2, 3, 4;
+create temporary table instance_stats_weekly_merged on commit drop as
+	select timestamp, channel_name, arch, version, sum(instances)::int as instances from instance_stats_weekly group by 1, 2, 3, 4;
+
+alter table instance_stats drop column channel_id;
+alter table instance_stats_daily drop column channel_id;
+alter table instance_stats_weekly drop column channel_id;
+
+truncate instance_stats, instance_stats_daily, instance_stats_weekly;
+insert into instance_stats select * from instance_stats_merged;
+insert into instance_stats_daily select * from instance_stats_daily_merged;
+insert into instance_stats_weekly select * from instance_stats_weekly_merged;
+
+alter table instance_stats add unique (timestamp, channel_name, arch, version);
+alter table instance_stats_daily add unique (timestamp, channel_name, arch, version);
+alter table instance_stats_weekly add unique (timestamp, channel_name, arch, version);
+
+create index if not exists instance_stats_channel_name_arch_timestamp_idx on instance_stats (channel_name, arch, timestamp);
+create index on instance_stats_daily (channel_name, arch, timestamp);
+create index on instance_stats_weekly (channel_name, arch, timestamp);
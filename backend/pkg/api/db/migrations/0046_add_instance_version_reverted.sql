@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- Going back to the previous version once also happens on A/B rollbacks, so
+-- it only makes an instance a suspected duplicate when it happens again
+-- shortly after. When an instance last went back to its previous version is
+-- kept to detect it.
+alter table instance_application add column version_reverted_ts timestamptz;
+
+-- +migrate Down
+
+alter table instance_application drop column version_reverted_ts;
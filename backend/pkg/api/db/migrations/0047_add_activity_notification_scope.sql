@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- The activity notifications carry the team, application and group of the
+-- new entries, so that the listeners only look up the entries some of their
+-- clients are interested in.
+
+-- +migrate StatementBegin
+create or replace function notify_activity() returns trigger as $$
+begin
+    perform pg_notify('nebraska_activity', json_build_object(
+        'id', NEW.id,
+        'team_id', (select team_id from application where id = NEW.application_id),
+        'application_id', NEW.application_id,
+        'group_id', NEW.group_id)::text);
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+-- +migrate StatementBegin
+create or replace function notify_activity() returns trigger as $$
+begin
+    perform pg_notify('nebraska_activity', json_build_object('id', NEW.id)::text);
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
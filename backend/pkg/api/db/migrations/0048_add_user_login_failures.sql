@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- The consecutive failed logins of the local users, kept in the database so
+-- that the lock applies to all the Nebraska replicas and survives restarts.
+-- Only the failed logins of existing users are recorded.
+create table if not exists user_login_failures (
+	user_id uuid primary key references users (id) on delete cascade,
+	failures integer not null default 0,
+	last_failure_ts timestamptz not null,
+	locked_until timestamptz
+);
+
+-- +migrate Down
+
+drop table if exists user_login_failures;
@@ -0,0 +1,32 @@
+-- +migrate Up
+
+-- version_to_int_array converts a version like 3510.2.1 to an array of
+-- integers that can be compared and indexed, padded to three components so
+-- that 3510 and 3510.0.0 are equal. Versions that can't be converted without
+-- overflowing, like empty ones, are converted to null.
+
+-- +migrate StatementBegin
+create or replace function version_to_int_array(version text) returns int[] as $$
+declare
+    parts int[];
+begin
+    if version !~ '^\d{1,9}(\.\d{1,9})*([+-].*)?$' then
+        return null;
+    end if;
+    parts := string_to_array((regexp_split_to_array(version, '[+-]'))[1], '.')::int[];
+    return parts || array_fill(0, array[greatest(0, 3 - cardinality(parts))]);
+end;
+$$ language plpgsql immutable strict parallel safe;
+-- +migrate StatementEnd
+
+create index if not exists instance_aleph_version_int_array_idx on instance (version_to_int_array(aleph_version));
+
+create index if not exists instance_application_application_id_version_int_array_idx on instance_application (application_id, version_to_int_array(version));
+
+-- +migrate Down
+
+drop index if exists instance_application_application_id_version_int_array_idx;
+
+drop index if exists instance_aleph_version_int_array_idx;
+
+drop function if exists version_to_int_array(text);
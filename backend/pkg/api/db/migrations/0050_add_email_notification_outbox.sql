@@ -0,0 +1,44 @@
+-- +migrate Up
+
+-- The rollout outcomes are queued in email_notification_outbox for every
+-- enabled email notification interested in them by the transaction creating
+-- them, so that none is missed by the notifier however late their
+-- transaction commits. 3 and 4 are the rollout finished and rollout failed
+-- activity classes.
+create table email_notification_outbox (
+    id                    bigserial   primary key,
+    email_notification_id uuid        not null references email_notification (id) on delete cascade,
+    activity_id           uuid        not null,
+    created_ts            timestamptz not null default current_timestamp
+);
+
+create index on email_notification_outbox (email_notification_id, id);
+
+-- +migrate StatementBegin
+create function queue_email_notification_activity() returns trigger as $$
+begin
+    insert into email_notification_outbox (email_notification_id, activity_id)
+    select id, NEW.id
+    from email_notification
+    where enabled and application_id = NEW.application_id and (
+        (NEW.class = 3 and rollout_finished) or
+        (NEW.class = 4 and rollout_failed));
+    return NEW;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger activity_email_notification_outbox
+    after insert on activity
+    for each row when (NEW.class in (3, 4))
+    execute function queue_email_notification_activity();
+
+-- The email notifications no longer keep a cursor on the activity entries.
+alter table email_notification drop column last_activity_ts;
+
+-- +migrate Down
+
+alter table email_notification add column last_activity_ts timestamptz not null default current_timestamp;
+drop trigger if exists activity_email_notification_outbox on activity;
+drop function if exists queue_email_notification_activity();
+drop table if exists email_notification_outbox;
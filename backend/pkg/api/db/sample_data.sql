@@ -13,7 +13,7 @@ delete from team;
 
 -- Default team and user (admin/admin)
 insert into team (id, name) values ('d89342dc-9214-441d-a4af-bdd837a3b239', 'default');
-insert into users (username, secret, team_id) values ('admin', '8b31292d4778582c0e5fa96aee5513f1', 'd89342dc-9214-441d-a4af-bdd837a3b239');
+insert into users (username, secret, role, team_id) values ('admin', '8b31292d4778582c0e5fa96aee5513f1', 'admin', 'd89342dc-9214-441d-a4af-bdd837a3b239');
 
 -- Flatcar Container Linux application
 insert into application (id, name, description, team_id) values ('e96281a6-d1af-4bde-9a0a-97b76e56dc57', 'Flatcar Container Linux', 'Linux for massive server deployments', 'd89342dc-9214-441d-a4af-bdd837a3b239');
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// ErrInvalidEmailNotification indicates that the email notification provided
+// is not valid.
+var ErrInvalidEmailNotification = types.ErrInvalidEmailNotification
+
+type (
+	EmailNotification = types.EmailNotification
+	InstanceFailures  = types.InstanceFailures
+)
+
+// emailNotificationBatchSize is the maximum number of rollouts taken from the
+// email notifications outbox at once.
+const emailNotificationBatchSize = 100
+
+// NotifyEmailNotificationRollouts takes a batch of the rollouts queued for the
+// email notification provided by the transactions creating them, and calls
+// notify with the ones it's still interested in, oldest first. The rollouts are
+// locked until they are notified, so that they are only notified by one
+// Nebraska instance, and the ones not notified because notify failed are
+// taken again on the next call.
+func (api *API) NotifyEmailNotificationRollouts(notification *EmailNotification, notify func(*Activity) error) error {
+	tx, err := api.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("NotifyEmailNotificationRollouts - could not roll back")
+		}
+	}()
+
+	var queued []struct {
+		ID         int64  `db:"id"`
+		ActivityID string `db:"activity_id"`
+	}
+	query := `
+		SELECT id, activity_id FROM email_notification_outbox
+		WHERE email_notification_id = $1
+		ORDER BY id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+	if err := tx.Select(&queued, query, notification.ID, emailNotificationBatchSize); err != nil {
+		return err
+	}
+	if len(queued) == 0 {
+		return nil
+	}
+
+	var classes []int
+	if notification.RolloutFinished {
+		classes = append(classes, ActivityRolloutFinished)
+	}
+	if notification.RolloutFailed {
+		classes = append(classes, ActivityRolloutFailed)
+	}
+	activityIDs := make([]string, 0, len(queued))
+	for _, q := range queued {
+		activityIDs = append(activityIDs, q.ActivityID)
+	}
+	activityEntries, err := api.GetRolloutActivity(activityIDs, classes)
+	if err != nil {
+		return err
+	}
+
+	// The rollouts left pending when notify fails are taken again on the
+	// next call, the others are taken for good, including the ones the
+	// notification isn't interested in anymore.
+	pending := make(map[string]bool)
+	var notifyErr error
+	for i, activityEntry := range activityEntries {
+		if notifyErr = notify(activityEntry); notifyErr != nil {
+			for _, activityEntry := range activityEntries[i:] {
+				pending[activityEntry.ID] = true
+			}
+			break
+		}
+	}
+	taken := make([]int64, 0, len(queued))
+	for _, q := range queued {
+		if !pending[q.ActivityID] {
+			taken = append(taken, q.ID)
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM email_notification_outbox WHERE id = ANY($1)`, pq.Array(taken)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return notifyErr
+}
+
+// MoveEmailNotificationDigestCursor moves the daily digest cursor of the email
+// notification provided to the time provided, if it's still at the position
+// provided, returning whether it did. Moving the cursor forward claims the
+// period between both positions, so that its digest is only sent by one
+// Nebraska instance.
+func (api *API) MoveEmailNotificationDigestCursor(notificationID string, from, to time.Time) (bool, error) {
+	return api.moveEmailNotificationCursor(notificationID, "last_digest_ts", from, to)
+}
+
+func (api *API) moveEmailNotificationCursor(notificationID, column string, from, to time.Time) (bool, error) {
+	query, _, err := goqu.Update("email_notification").
+		Set(goqu.Record{column: to.UTC()}).
+		Where(
+			goqu.C("id").Eq(notificationID),
+			goqu.C(column).Eq(from.UTC()),
+		).
+		ToSQL()
+	if err != nil {
+		return false, err
+	}
+	result, err := api.db.Exec(query)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
@@ -85,6 +85,18 @@ type Event struct {
 	EventTypeID     string      `db:"event_type_id" json:"event_type_id"`
 }
 
+type (
+	InstanceEvent             = types.InstanceEvent
+	InstanceCheckInGap        = types.InstanceCheckInGap
+	InstanceEventsTimeline    = types.InstanceEventsTimeline
+	InstanceEventsQueryParams = types.InstanceEventsQueryParams
+)
+
+const (
+	GapNoEventsReported = types.GapNoEventsReported
+	GapNoCheckIn        = types.GapNoCheckIn
+)
+
 // RegisterEvent registers an event posted by an instance in Nebraska. The
 // event will be bound to an application/group combination.
 func (api *API) RegisterEvent(instanceID, appID, groupID string, etype, eresult int, previousVersion, errorCode string) error {
@@ -276,3 +276,66 @@ func TestRegisterEvent_GetEvent(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, errCode, null.StringFrom(""))
 }
+
+func TestGetInstanceEvents(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tInstance, _ := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.1"}, NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+
+	_, err := a.GetUpdatePackage(Instance{ID: tInstance.ID, IP: "10.0.0.1"}, NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+	assert.NoError(t, err)
+	assert.NoError(t, a.RegisterEvent(tInstance.ID, tApp.ID, tGroup.ID, EventUpdateDownloadStarted, ResultSuccess, "12.0.0", ""))
+	assert.NoError(t, a.RegisterEvent(tInstance.ID, tApp.ID, tGroup.ID, EventUpdateDownloadFinished, ResultSuccess, "12.0.0", ""))
+
+	// An event reported long before the others, followed by no check-ins.
+	oldTs := time.Now().UTC().Add(-20 * time.Minute)
+	_, err = a.db.Exec(`INSERT INTO event (event_type_id, instance_id, application_id, previous_version, error_code, created_ts)
+		SELECT id, $1, $2, '11.0.0', '', $3 FROM event_type WHERE type = $4 AND result = $5`,
+		tInstance.ID, tApp.ID, oldTs, EventUpdateComplete, ResultFailed)
+	assert.NoError(t, err)
+
+	timeline, err := a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: tInstance.ID, ApplicationID: tApp.ID, GroupID: tGroup.ID, MinGap: 10 * time.Minute})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), timeline.TotalEvents)
+	if assert.Len(t, timeline.Events, 3) {
+		assert.Equal(t, EventUpdateDownloadFinished, timeline.Events[0].Type)
+		assert.Equal(t, EventUpdateComplete, timeline.Events[2].Type)
+		assert.Equal(t, ResultFailed, timeline.Events[2].Result)
+		assert.Equal(t, null.StringFrom("11.0.0"), timeline.Events[2].PreviousVersion)
+	}
+	assert.True(t, timeline.LastCheckForUpdates.Valid)
+	if assert.Len(t, timeline.Gaps, 1) {
+		assert.WithinDuration(t, oldTs, timeline.Gaps[0].From, time.Second)
+		assert.False(t, timeline.Gaps[0].Ongoing)
+		assert.Equal(t, GapNoEventsReported, timeline.Gaps[0].Kind)
+	}
+
+	timeline, err = a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: tInstance.ID, ApplicationID: tApp.ID, GroupID: tGroup.ID, Page: 2, PerPage: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), timeline.TotalEvents)
+	assert.Len(t, timeline.Events, 1)
+
+	timeline, err = a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: tInstance.ID, ApplicationID: tApp.ID, GroupID: tGroup.ID, Start: time.Now().Add(-10 * time.Minute), MinGap: 10 * time.Minute})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), timeline.TotalEvents)
+	assert.Empty(t, timeline.Gaps)
+
+	timeline, err = a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: tInstance.ID, ApplicationID: tApp.ID, GroupID: tGroup.ID, MinGap: 30 * time.Minute})
+	assert.NoError(t, err)
+	assert.Empty(t, timeline.Gaps)
+
+	_, err = a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: uuid.New().String(), ApplicationID: tApp.ID, GroupID: tGroup.ID})
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	// the instance doesn't belong to other groups
+	tGroup2, _ := as.AddGroup(&Group{Name: "group2", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	_, err = a.GetInstanceEvents(InstanceEventsQueryParams{InstanceID: tInstance.ID, ApplicationID: tApp.ID, GroupID: tGroup2.ID})
+	assert.Equal(t, sql.ErrNoRows, err)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/doug-martin/goqu/v9"
 
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/dbreads"
 	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
@@ -27,6 +28,12 @@ type (
 	UpdatesStats                    = types.UpdatesStats
 )
 
+// IsValidDuration reports whether the duration provided is one of the
+// durations accepted by the group instances stats and timelines.
+func IsValidDuration(duration string) bool {
+	return dbreads.IsValidDuration(duration)
+}
+
 // ClearUpdatesEnabledOverride clears the local policy_updates_enabled override
 // on the group_local row so the admin default on groups takes effect again on
 // this node.
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const (
+	InstanceStatsHourly = types.InstanceStatsHourly
+	InstanceStatsDaily  = types.InstanceStatsDaily
+	InstanceStatsWeekly = types.InstanceStatsWeekly
+)
+
+// ErrInvalidInstanceStatsResolution indicates that the resolution provided to
+// query the instance stats is not valid.
+var ErrInvalidInstanceStatsResolution = types.ErrInvalidInstanceStatsResolution
+
+type (
+	InstanceStatsResolution = types.InstanceStatsResolution
+	VersionStatsQueryParams = types.VersionStatsQueryParams
+	VersionStatsTimeline    = types.VersionStatsTimeline
+)
+
+// instanceStatsRollups lists the rollups of the instance stats snapshots, in
+// the order they must be done: each resolution is rolled up from the previous
+// one, so weekly snapshots are still computed once hourly ones are pruned.
+var instanceStatsRollups = []struct {
+	from, to InstanceStatsResolution
+	// unit is the postgres date_trunc unit of the resolution rolled up to.
+	unit string
+}{
+	{InstanceStatsHourly, InstanceStatsDaily, "day"},
+	{InstanceStatsDaily, InstanceStatsWeekly, "week"},
+}
+
+// RollupInstanceStats rolls the hourly instance stats snapshots up into daily
+// ones, and the daily ones into weekly ones. Each rolled up snapshot holds the
+// average number of instances per version over the day or week, starting at
+// midnight UTC (on Monday for weeks). Only days and weeks over at the time
+// provided are rolled up, and the last one rolled up is computed again in case
+// snapshots were added to it since.
+func (api *API) RollupInstanceStats(t time.Time) error {
+	for _, rollup := range instanceStatsRollups {
+		if err := api.rollupInstanceStats(rollup.from, rollup.to, rollup.unit, t); err != nil {
+			return fmt.Errorf("rolling up %s instance stats: %w", rollup.to, err)
+		}
+	}
+	return nil
+}
+
+func (api *API) rollupInstanceStats(from, to InstanceStatsResolution, unit string, t time.Time) error {
+	fromTable, err := from.Table()
+	if err != nil {
+		return err
+	}
+	toTable, err := to.Table()
+	if err != nil {
+		return err
+	}
+
+	query, _, err := goqu.From(toTable).Select(goqu.MAX("timestamp")).ToSQL()
+	if err != nil {
+		return err
+	}
+	var lastRollup null.Time
+	if err := api.db.QueryRow(query).Scan(&lastRollup); err != nil {
+		return err
+	}
+
+	// Snapshots are averaged over all the snapshots taken in the bucket, so
+	// versions missing from some of them count as zero instances there.
+	bucket := fmt.Sprintf(`date_trunc('%s', timestamp AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'`, unit)
+	rollupQuery := fmt.Sprintf(`
+		WITH snapshots AS (
+			SELECT %[3]s AS bucket, count(DISTINCT timestamp) AS total
+			FROM %[1]s
+			WHERE timestamp >= $1::timestamptz AND timestamp < date_trunc('%[4]s', $2::timestamptz AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'
+			GROUP BY 1
+		)
+		INSERT INTO %[2]s (timestamp, channel_id, channel_name, arch, version, instances)
+		SELECT snapshots.bucket, channel_id, max(channel_name), max(arch), version, round(sum(instances)::numeric / snapshots.total)
+		FROM %[1]s JOIN snapshots ON %[3]s = snapshots.bucket
+		WHERE timestamp >= $1::timestamptz
+		GROUP BY snapshots.bucket, snapshots.total, channel_id, version
+		ON CONFLICT (timestamp, channel_id, version) DO UPDATE SET instances = EXCLUDED.instances, channel_name = EXCLUDED.channel_name, arch = EXCLUDED.arch`,
+		fromTable, toTable, bucket, unit)
+
+	since := time.Time{}
+	if lastRollup.Valid {
+		since = lastRollup.Time
+	}
+	_, err = api.db.Exec(rollupQuery, since, t)
+	return err
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupInstanceStats(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "rollup_channel", Color: "blue", ApplicationID: tApp.ID, Arch: ArchAMD64})
+	// a channel of the same name and arch in another team
+	tOtherTeam, _ := as.AddTeam(&Team{Name: "other_team"})
+	tOtherApp, _ := as.AddApp(&Application{Name: "other_app", TeamID: tOtherTeam.ID})
+	tOtherChannel, _ := as.AddChannel(&Channel{Name: "rollup_channel", Color: "blue", ApplicationID: tOtherApp.ID, Arch: ArchAMD64})
+
+	monday := time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	snapshots := []InstanceStats{
+		{Timestamp: monday, Version: "1.0.0", Instances: 10},
+		{Timestamp: monday.Add(time.Hour), Version: "1.0.0", Instances: 20},
+		{Timestamp: monday.Add(time.Hour), Version: "2.0.0", Instances: 4},
+		{Timestamp: tuesday, Version: "1.0.0", Instances: 30},
+	}
+	for _, snapshot := range snapshots {
+		for _, channel := range []*Channel{tChannel, tOtherChannel} {
+			_, err := a.db.Exec("INSERT INTO instance_stats (timestamp, channel_id, channel_name, arch, version, instances) VALUES ($1, $2, $3, $4, $5, $6)",
+				snapshot.Timestamp, channel.ID, channel.Name, "AMD64", snapshot.Version, snapshot.Instances)
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, a.RollupInstanceStats(monday.AddDate(0, 0, 7)))
+	// Rolling up again gives the same results.
+	require.NoError(t, a.RollupInstanceStats(monday.AddDate(0, 0, 7)))
+
+	p := VersionStatsQueryParams{ChannelID: tChannel.ID, TeamID: tTeam.ID, Start: monday.AddDate(0, 0, -1), End: monday.AddDate(0, 0, 7)}
+	timeline, err := a.GetVersionStatsTimeline(p)
+	require.NoError(t, err)
+	assert.Equal(t, InstanceStatsDaily, timeline.Resolution)
+	require.Len(t, timeline.Timeline, 2)
+	assert.Equal(t, map[string]uint64{"1.0.0": 15, "2.0.0": 2}, timeline.Timeline[monday])
+	assert.Equal(t, map[string]uint64{"1.0.0": 30, "2.0.0": 0}, timeline.Timeline[tuesday])
+
+	p.Resolution = InstanceStatsWeekly
+	timeline, err = a.GetVersionStatsTimeline(p)
+	require.NoError(t, err)
+	require.Len(t, timeline.Timeline, 1)
+	assert.Equal(t, map[string]uint64{"1.0.0": 23, "2.0.0": 1}, timeline.Timeline[monday])
+
+	p.Resolution = InstanceStatsHourly
+	timeline, err = a.GetVersionStatsTimeline(p)
+	require.NoError(t, err)
+	assert.Len(t, timeline.Timeline, 3)
+
+	// the channels of other teams aren't reported
+	p.TeamID = tOtherTeam.ID
+	timeline, err = a.GetVersionStatsTimeline(p)
+	require.NoError(t, err)
+	assert.Empty(t, timeline.Timeline)
+
+	p.Resolution = "monthly"
+	_, err = a.GetVersionStatsTimeline(p)
+	assert.Equal(t, ErrInvalidInstanceStatsResolution, err)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -24,15 +25,49 @@ const (
 	InstanceStatusOnHold        = types.InstanceStatusOnHold
 )
 
+// ErrInvalidInstancesFilter indicates that one of the structured filters
+// provided to query instances is not valid.
+var ErrInvalidInstancesFilter = types.ErrInvalidInstancesFilter
+
+// ErrInstanceArchMismatch indicates that an instance can't be assigned to a
+// group whose channel serves packages of another architecture.
+var ErrInstanceArchMismatch = types.ErrInstanceArchMismatch
+
+// ErrInvalidLabels indicates that the labels provided for an instance are not
+// valid.
+var ErrInvalidLabels = types.ErrInvalidLabels
+
+// MaxLabels is the maximum number of labels an instance can have.
+const MaxLabels = types.MaxLabels
+
 type (
 	Instance                   = types.Instance
 	InstancesWithTotal         = types.InstancesWithTotal
+	InstanceExport             = types.InstanceExport
 	InstanceApplication        = types.InstanceApplication
 	InstanceStatusHistoryEntry = types.InstanceStatusHistoryEntry
 	InstancesQueryParams       = types.InstancesQueryParams
 	InstanceStats              = types.InstanceStats
+	Labels                     = types.Labels
 )
 
+// duplicateDetectionWindow is the time window within which an instance going
+// back to its previous IP or version is considered to be the result of
+// several machines sharing the same machine id.
+const duplicateDetectionWindow = time.Hour
+
+// ParseLabels parses labels formatted as comma separated key=value pairs.
+func ParseLabels(s string) (Labels, error) {
+	return types.ParseLabels(s)
+}
+
+// ParseReportedLabels parses the labels reported by the instances, dropping
+// or truncating the invalid ones instead of failing, and returns the problems
+// found.
+func ParseReportedLabels(s string) (Labels, []string) {
+	return types.ParseReportedLabels(s)
+}
+
 // NewInstanceApplication creates an InstanceApplication with the fields used for registration.
 func NewInstanceApplication(appID, groupID, version string) InstanceApplication {
 	return InstanceApplication{ApplicationID: appID, GroupID: null.StringFrom(groupID), Version: version}
@@ -51,10 +86,23 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 	if appID, groupID, err = api.validateApplicationAndGroup(appID, groupID); err != nil {
 		return nil, err
 	}
+	// The group matching the track reported by the instance, when it has been
+	// assigned to another one, empty if its track doesn't match any group.
+	requestedGroupID := groupID
+	if instApp.RequestedGroupID.Valid {
+		requestedGroupID = instApp.RequestedGroupID.String
+	}
 
 	instanceAlias := inst.Alias
 	instanceOEM := inst.OEM
 	instanceAlephVersion := inst.AlephVersion
+	// The labels reported by the instances are not validated like the ones
+	// set through the API, as instances with invalid labels must still get
+	// updates: the invalid ones are dropped instead.
+	instanceLabels, problems := inst.Labels.Sanitize()
+	for _, problem := range problems {
+		l.Debug().Str("instance", inst.ID).Msgf("RegisterInstance - reported labels: %s", problem)
+	}
 
 	// We want to avoid having to create an unneeded DB transaction, so we check whether it
 	// is necessary (we need it when writing into the two tables, instance and
@@ -62,6 +110,7 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 
 	updateInstance := true
 	updateInstanceApplication := true
+	duplicateSuspected := false
 
 	instance, err := api.GetInstance(inst.ID, appID)
 	if err == nil {
@@ -76,15 +125,31 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 		if instanceAlephVersion == "" {
 			instanceAlephVersion = instance.AlephVersion
 		}
-		// The instance exists, so we just update it if its IP, Alias, OEM or AlephVersion changed
-		updateInstance = instance.IP != inst.IP || instance.Alias != instanceAlias || instance.OEM != instanceOEM || instance.AlephVersion != instanceAlephVersion
+		// Labels reported by the instance are merged into the existing ones
+		var labelsChanged bool
+		var droppedKeys []string
+		instanceLabels, labelsChanged, droppedKeys = mergeLabels(instance.Labels, instanceLabels)
+		if len(droppedKeys) > 0 {
+			l.Debug().Str("instance", inst.ID).Strs("labels", droppedKeys).Msgf("RegisterInstance - reported labels dropped, at most %d labels are allowed", MaxLabels)
+		}
+		// The instance exists, so we just update it if its IP, Alias, OEM, AlephVersion or Labels changed
+		updateInstance = instance.IP != inst.IP || instance.Alias != instanceAlias || instance.OEM != instanceOEM || instance.AlephVersion != instanceAlephVersion || labelsChanged
+
+		duplicateSuspected, err = api.isFlappingInstance(instance, inst.IP, appID, instApp.Version)
+		if err != nil {
+			return nil, err
+		}
+		if duplicateSuspected {
+			updateInstance = true
+		}
 
 		recent := nowUTC().Add(-5 * time.Minute)
 
 		// And we only update the instance_application if the latest registry is outdated or
 		// older than what we establish as recent.
 		updateInstanceApplication = instance.Application.LastCheckForUpdates.UTC().Before(recent) ||
-			instance.Application.Version != instApp.Version || instance.Application.GroupID.String != groupID
+			instance.Application.Version != instApp.Version || instance.Application.GroupID.String != groupID ||
+			instance.Application.RequestedGroupID.String != requestedGroupID
 
 		// Skip updating anything unnecessary
 		if !updateInstance && !updateInstanceApplication {
@@ -92,19 +157,42 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 		}
 	}
 
+	now := nowUTC()
+	instanceRecord := goqu.Record{
+		"id":            inst.ID,
+		"ip":            inst.IP,
+		"alias":         instanceAlias,
+		"oem":           instanceOEM,
+		"aleph_version": instanceAlephVersion,
+		"labels":        instanceLabels,
+		// Keep track of the previous IP to detect machines sharing the same id
+		"previous_ip":   goqu.L("CASE WHEN instance.ip <> EXCLUDED.ip THEN instance.ip ELSE instance.previous_ip END"),
+		"ip_changed_ts": goqu.L("CASE WHEN instance.ip <> EXCLUDED.ip THEN ?::timestamptz ELSE instance.ip_changed_ts END", now),
+	}
+	if duplicateSuspected {
+		instanceRecord["duplicate_suspected_ts"] = now
+	}
 	upsertInstance, _, err := goqu.Insert("instance").
-		Cols("id", "ip", "alias", "oem", "aleph_version").
-		Vals(goqu.Vals{inst.ID, inst.IP, instanceAlias, instanceOEM, instanceAlephVersion}).
-		OnConflict(goqu.DoUpdate("id", goqu.Record{"id": inst.ID, "ip": inst.IP, "alias": instanceAlias, "oem": instanceOEM, "aleph_version": instanceAlephVersion})).
+		Cols("id", "ip", "alias", "oem", "aleph_version", "labels").
+		Vals(goqu.Vals{inst.ID, inst.IP, instanceAlias, instanceOEM, instanceAlephVersion, instanceLabels}).
+		OnConflict(goqu.DoUpdate("id", instanceRecord)).
 		ToSQL()
 	if err != nil {
 		return nil, err
 	}
 
 	upsertInstanceApplication, _, err := goqu.Insert("instance_application").
-		Cols("instance_id", "application_id", "group_id", "version", "last_check_for_updates").
-		Vals(goqu.Vals{inst.ID, appID, groupID, instApp.Version, nowUTC()}).
-		OnConflict(goqu.DoUpdate("ON CONSTRAINT instance_application_pkey", goqu.Record{"group_id": groupID, "version": instApp.Version, "last_check_for_updates": nowUTC()})).
+		Cols("instance_id", "application_id", "group_id", "requested_group_id", "version", "last_check_for_updates").
+		Vals(goqu.Vals{inst.ID, appID, groupID, null.NewString(requestedGroupID, requestedGroupID != ""), instApp.Version, now}).
+		OnConflict(goqu.DoUpdate("ON CONSTRAINT instance_application_pkey", goqu.Record{
+			"group_id":               groupID,
+			"requested_group_id":     null.NewString(requestedGroupID, requestedGroupID != ""),
+			"version":                instApp.Version,
+			"last_check_for_updates": now,
+			"previous_version":       goqu.L("CASE WHEN instance_application.version <> EXCLUDED.version THEN instance_application.version ELSE instance_application.previous_version END"),
+			"version_changed_ts":     goqu.L("CASE WHEN instance_application.version <> EXCLUDED.version THEN ?::timestamptz ELSE instance_application.version_changed_ts END", now),
+			"version_reverted_ts":    goqu.L("CASE WHEN instance_application.version <> EXCLUDED.version AND instance_application.previous_version = EXCLUDED.version THEN ?::timestamptz ELSE instance_application.version_reverted_ts END", now),
+		})).
 		ToSQL()
 	if err != nil {
 		return nil, err
@@ -121,6 +209,11 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 		if err != nil {
 			return nil, err
 		}
+		api.recordSuspectedDuplicate(instance, duplicateSuspected, instApp.Version, appID, groupID)
+		if duplicateSuspected {
+			instance.DuplicateSuspectedTs = null.TimeFrom(now)
+			instance.SuspectedDuplicate = true
+		}
 
 		return instance, nil
 	}
@@ -162,9 +255,60 @@ func (api *API) RegisterInstance(inst Instance, instApp InstanceApplication) (*I
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	api.recordSuspectedDuplicate(instance, duplicateSuspected, instApp.Version, appID, groupID)
+
 	return api.GetInstance(inst.ID, appID)
 }
 
+// isFlappingInstance checks whether an existing instance is going back to the
+// IP it reported before its current one within a short time, which happens
+// when several machines (e.g. cloned from the same image) share the same
+// machine id. Going back to the previous version also happens on A/B
+// rollbacks, so it's only considered as flapping when the IP changes too, or
+// when the instance already went back to its previous version shortly
+// before, alternating between the two.
+func (api *API) isFlappingInstance(instance *Instance, ip, appID, version string) (bool, error) {
+	since := nowUTC().Add(-duplicateDetectionWindow)
+
+	if instance.IP != ip && instance.PreviousIP.Valid && instance.PreviousIP.String == ip &&
+		instance.IPChangedTs.Valid && instance.IPChangedTs.Time.After(since) {
+		return true, nil
+	}
+
+	app := instance.Application
+	if app.ApplicationID == "" || app.Version == version || app.UpdateInProgress {
+		return false, nil
+	}
+	change, err := api.GetInstancePreviousVersion(instance.ID, appID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	reverting := change.PreviousVersion.Valid && change.PreviousVersion.String == version &&
+		change.VersionChangedTs.Valid && change.VersionChangedTs.Time.After(since)
+	if !reverting {
+		return false, nil
+	}
+	alternating := change.VersionRevertedTs.Valid && change.VersionRevertedTs.Time.After(since)
+	return instance.IP != ip || alternating, nil
+}
+
+// recordSuspectedDuplicate adds a warning activity entry for an instance that
+// just became a suspected duplicate. Instances that were already suspected
+// don't get a new entry on every check-in.
+func (api *API) recordSuspectedDuplicate(instance *Instance, duplicateSuspected bool, version, appID, groupID string) {
+	if !duplicateSuspected || instance.SuspectedDuplicate {
+		return
+	}
+	l.Warn().Str("instance", instance.ID).Str("appID", appID).Msg("instance suspected of sharing its machine id with other machines")
+	if err := api.newInstanceActivityEntry(activityInstanceDuplicateSuspected, activityWarning, version, appID, groupID, instance.ID); err != nil {
+		l.Error().Err(err).Msg("recordSuspectedDuplicate - could not add activity entry")
+	}
+}
+
 func (api *API) UpdateInstance(instanceID string, alias string) (*Instance, error) {
 	instance := &Instance{}
 	query, _, err := goqu.Update("instance").
@@ -186,6 +330,68 @@ func (api *API) UpdateInstance(instanceID string, alias string) (*Instance, erro
 	return instance, nil
 }
 
+// UpdateInstanceLabels replaces the labels of the instance provided.
+func (api *API) UpdateInstanceLabels(instanceID string, labels Labels) (*Instance, error) {
+	if labels == nil {
+		labels = Labels{}
+	}
+	if err := labels.Validate(); err != nil {
+		return nil, err
+	}
+
+	instance := &Instance{}
+	query, _, err := goqu.Update("instance").
+		Set(
+			goqu.Record{
+				"labels": labels,
+			},
+		).
+		Where(goqu.C("id").Eq(instanceID)).
+		Returning(goqu.T("instance").All()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	err = api.db.QueryRowx(query).StructScan(instance)
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// mergeLabels returns the labels resulting from setting the reported labels
+// on top of the existing ones, and whether they differ from the existing ones.
+// New reported labels are dropped once there are MaxLabels labels, and their
+// keys are returned.
+func mergeLabels(existing, reported Labels) (Labels, bool, []string) {
+	merged := make(Labels, len(existing)+len(reported))
+	for key, value := range existing {
+		merged[key] = value
+	}
+
+	keys := make([]string, 0, len(reported))
+	for key := range reported {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changed := false
+	var dropped []string
+	for _, key := range keys {
+		value := reported[key]
+		current, ok := merged[key]
+		if !ok && len(merged) >= MaxLabels {
+			dropped = append(dropped, key)
+			continue
+		}
+		if !ok || current != value {
+			changed = true
+		}
+		merged[key] = value
+	}
+	return merged, changed, dropped
+}
+
 // validateApplicationAndGroup validates if the group provided belongs to the
 // provided application, returning the normalized uuid version of the appID and
 // groupID provided if both are valid and the group belongs to the given
@@ -285,7 +491,7 @@ func (api *API) updateInstanceObjStatus(instance *Instance, newStatus int) error
 // in during a given duration from a given time.
 func (api *API) UpdateInstanceStats(t *time.Time, duration *time.Duration) error {
 	insertQuery, _, err := goqu.Insert(goqu.T("instance_stats")).
-		Cols("timestamp", "channel_name", "arch", "version", "instances").
+		Cols("timestamp", "channel_id", "channel_name", "arch", "version", "instances").
 		FromQuery(api.InstanceStatsQuery(t, duration)).
 		ToSQL()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -262,6 +263,208 @@ func TestGetInstancesFiltered(t *testing.T) {
 	}
 }
 
+func TestGetInstancesStructuredFilters(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tInstance1, _ := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.1", OEM: "ami", AlephVersion: "3510.2.0"}, NewInstanceApplication(tApp.ID, tGroup.ID, "3510.2.1"))
+	tInstance2, _ := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.1.1", OEM: "ami", AlephVersion: "3602.2.0"}, NewInstanceApplication(tApp.ID, tGroup.ID, "3602.2.1"))
+	tInstance3, _ := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "192.168.0.1", OEM: "gce"}, NewInstanceApplication(tApp.ID, tGroup.ID, "3602.2.1"))
+
+	getIDs := func(p InstancesQueryParams) []string {
+		t.Helper()
+		p.ApplicationID, p.GroupID, p.Page, p.PerPage = tApp.ID, tGroup.ID, 1, 10
+		result, err := a.GetInstances(p, testDuration)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(result.Instances))
+		for _, instance := range result.Instances {
+			ids = append(ids, instance.ID)
+		}
+		return ids
+	}
+
+	assert.ElementsMatch(t, []string{tInstance1.ID, tInstance2.ID}, getIDs(InstancesQueryParams{IPCIDR: "10.0.0.0/16"}))
+	assert.ElementsMatch(t, []string{tInstance3.ID}, getIDs(InstancesQueryParams{IPCIDR: "192.168.0.1"}))
+	assert.ElementsMatch(t, []string{tInstance1.ID, tInstance2.ID}, getIDs(InstancesQueryParams{OEM: "ami"}))
+	assert.ElementsMatch(t, []string{tInstance2.ID}, getIDs(InstancesQueryParams{AlephVersionRange: ">=3600"}))
+	assert.ElementsMatch(t, []string{tInstance1.ID}, getIDs(InstancesQueryParams{AlephVersionRange: ">=3500.0.0 <3600.0.0"}))
+	assert.ElementsMatch(t, []string{tInstance2.ID, tInstance3.ID}, getIDs(InstancesQueryParams{VersionRange: ">3510.2.1"}))
+	assert.ElementsMatch(t, []string{tInstance2.ID}, getIDs(InstancesQueryParams{VersionRange: "3602.2.1", OEM: "ami", IPCIDR: "10.0.0.0/8"}))
+	// versions are padded to three components
+	assert.ElementsMatch(t, []string{tInstance1.ID}, getIDs(InstancesQueryParams{AlephVersionRange: "=3510.2"}))
+	assert.ElementsMatch(t, []string{tInstance1.ID}, getIDs(InstancesQueryParams{VersionRange: "<3602"}))
+	assert.ElementsMatch(t, []string{tInstance1.ID, tInstance2.ID, tInstance3.ID}, getIDs(InstancesQueryParams{LastCheckAfter: time.Now().Add(-time.Hour)}))
+	assert.Empty(t, getIDs(InstancesQueryParams{LastCheckBefore: time.Now().Add(-time.Hour)}))
+
+	for _, p := range []InstancesQueryParams{
+		{IPCIDR: "10.0.0.0/33"},
+		{AlephVersionRange: ">=abc"},
+		{VersionRange: "~>1.0"},
+		{LastCheckAfter: time.Now(), LastCheckBefore: time.Now().Add(-time.Hour)},
+	} {
+		p.ApplicationID, p.GroupID = tApp.ID, tGroup.ID
+		_, err := a.GetInstances(p, testDuration)
+		assert.ErrorIs(t, err, ErrInvalidInstancesFilter)
+	}
+}
+
+func TestInstanceLabels(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	instanceID := uuid.New().String()
+	instApp := NewInstanceApplication(tApp.ID, tGroup.ID, "12.1.0")
+	_, err := a.RegisterInstance(Instance{ID: instanceID, IP: "10.0.0.1", Labels: Labels{"env": "prod"}}, instApp)
+	require.NoError(t, err)
+	tInstance2, err := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.2"}, instApp)
+	require.NoError(t, err)
+
+	instance, err := a.GetInstance(instanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Labels{"env": "prod"}, instance.Labels)
+	assert.Equal(t, Labels{}, tInstance2.Labels)
+
+	// Reported labels are merged into the existing ones.
+	_, err = a.RegisterInstance(Instance{ID: instanceID, IP: "10.0.0.1", Labels: Labels{"rack": "r12"}}, instApp)
+	require.NoError(t, err)
+	instance, err = a.GetInstance(instanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Labels{"env": "prod", "rack": "r12"}, instance.Labels)
+
+	getIDs := func(labels string) []string {
+		t.Helper()
+		p := InstancesQueryParams{ApplicationID: tApp.ID, GroupID: tGroup.ID, Labels: labels, Page: 1, PerPage: 10}
+		result, err := a.GetInstances(p, testDuration)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(result.Instances))
+		for _, instance := range result.Instances {
+			ids = append(ids, instance.ID)
+		}
+		return ids
+	}
+	assert.ElementsMatch(t, []string{instanceID}, getIDs("env=prod"))
+	assert.ElementsMatch(t, []string{instanceID}, getIDs("env=prod,rack=r12"))
+	assert.Empty(t, getIDs("env=staging"))
+	assert.ElementsMatch(t, []string{instanceID, tInstance2.ID}, getIDs(""))
+
+	_, err = a.GetInstances(InstancesQueryParams{ApplicationID: tApp.ID, GroupID: tGroup.ID, Labels: "env"}, testDuration)
+	assert.ErrorIs(t, err, ErrInvalidInstancesFilter)
+
+	// Labels set through the API replace the existing ones.
+	instance, err = a.UpdateInstanceLabels(instanceID, Labels{"env": "staging"})
+	require.NoError(t, err)
+	assert.Equal(t, Labels{"env": "staging"}, instance.Labels)
+
+	_, err = a.UpdateInstanceLabels(instanceID, Labels{"invalid key": "value"})
+	assert.ErrorIs(t, err, ErrInvalidLabels)
+
+	// Invalid or too many reported labels are dropped instead of failing the
+	// registration, keeping the existing labels.
+	reported := Labels{"invalid key": "value"}
+	for i := 0; i < MaxLabels; i++ {
+		reported[fmt.Sprintf("key%02d", i)] = "value"
+	}
+	instance, err = a.RegisterInstance(Instance{ID: instanceID, IP: "10.0.0.1", Labels: reported}, instApp)
+	require.NoError(t, err)
+	instance, err = a.GetInstance(instanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.Len(t, instance.Labels, MaxLabels)
+	assert.Equal(t, "staging", instance.Labels["env"])
+	assert.NotContains(t, instance.Labels, "invalid key")
+	assert.NotContains(t, instance.Labels, fmt.Sprintf("key%02d", MaxLabels-1))
+
+	metrics, err := a.GetAppInstancesPerLabelMetrics([]string{"env"})
+	require.NoError(t, err)
+	assert.Contains(t, metrics, AppInstancesPerLabelMetric{ApplicationName: tApp.Name, LabelKey: "env", LabelValue: "staging", InstancesCount: 1})
+}
+
+func TestSuspectedDuplicateInstances(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: false, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 10, PolicyUpdateTimeout: "60 minutes"})
+
+	// An instance moving to a new IP is not suspected.
+	ipInstanceID := uuid.New().String()
+	instApp := NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0")
+	_, err := a.RegisterInstance(Instance{ID: ipInstanceID, IP: "10.0.0.1"}, instApp)
+	require.NoError(t, err)
+	_, err = a.RegisterInstance(Instance{ID: ipInstanceID, IP: "10.0.0.2"}, instApp)
+	require.NoError(t, err)
+	instance, err := a.GetInstance(ipInstanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.False(t, instance.SuspectedDuplicate)
+
+	// Going back to the previous IP right after is.
+	_, err = a.RegisterInstance(Instance{ID: ipInstanceID, IP: "10.0.0.1"}, instApp)
+	require.NoError(t, err)
+	instance, err = a.GetInstance(ipInstanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.True(t, instance.SuspectedDuplicate)
+	assert.True(t, instance.DuplicateSuspectedTs.Valid)
+
+	// Going back to the previous version once, like on A/B rollbacks, is not
+	// suspected, but alternating between two versions is.
+	versionInstanceID := uuid.New().String()
+	for i, version := range []string{"12.0.0", "11.0.0", "12.0.0", "11.0.0"} {
+		_, err = a.RegisterInstance(Instance{ID: versionInstanceID, IP: "10.0.0.3"}, NewInstanceApplication(tApp.ID, tGroup.ID, version))
+		require.NoError(t, err)
+		instance, err = a.GetInstance(versionInstanceID, tApp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, i == 3, instance.SuspectedDuplicate, "version %d", i)
+	}
+
+	// Going back to the previous version from another IP is suspected.
+	otherIPInstanceID := uuid.New().String()
+	_, err = a.RegisterInstance(Instance{ID: otherIPInstanceID, IP: "10.0.0.4"}, NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+	require.NoError(t, err)
+	_, err = a.RegisterInstance(Instance{ID: otherIPInstanceID, IP: "10.0.0.4"}, NewInstanceApplication(tApp.ID, tGroup.ID, "11.0.0"))
+	require.NoError(t, err)
+	_, err = a.RegisterInstance(Instance{ID: otherIPInstanceID, IP: "10.0.0.5"}, NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+	require.NoError(t, err)
+	instance, err = a.GetInstance(otherIPInstanceID, tApp.ID)
+	require.NoError(t, err)
+	assert.True(t, instance.SuspectedDuplicate)
+
+	// A single warning is recorded per instance, even if it keeps flapping.
+	_, err = a.RegisterInstance(Instance{ID: ipInstanceID, IP: "10.0.0.2"}, instApp)
+	require.NoError(t, err)
+	activityEntries, err := a.GetActivity(tTeam.ID, ActivityQueryParams{AppID: tApp.ID, Severity: activityWarning})
+	require.NoError(t, err)
+	suspectedInstances := []string{}
+	for _, entry := range activityEntries {
+		if entry.Class == activityInstanceDuplicateSuspected {
+			suspectedInstances = append(suspectedInstances, entry.InstanceID.String)
+		}
+	}
+	assert.ElementsMatch(t, []string{ipInstanceID, versionInstanceID, otherIPInstanceID}, suspectedInstances)
+
+	// Suspected duplicates only get updates when they are not refused.
+	_, err = a.GetUpdatePackage(Instance{ID: ipInstanceID, IP: "10.0.0.2"}, instApp)
+	assert.NoError(t, err)
+	a.refuseSuspectedDuplicates = true
+	_, err = a.GetUpdatePackage(Instance{ID: versionInstanceID, IP: "10.0.0.3"}, NewInstanceApplication(tApp.ID, tGroup.ID, "12.0.0"))
+	assert.Equal(t, ErrSuspectedDuplicateInstance, err)
+}
+
 func TestGetInstanceStatusHistory(t *testing.T) {
 	// Update instance status several times and see if the history matches.
 
@@ -43,6 +43,34 @@ func (q *Queries) GetActivity(teamID string, p types.ActivityQueryParams) ([]*ty
 	return activityEntries, nil
 }
 
+// GetActivityEntry returns the activity entry identified by the id provided,
+// along with the team of its application.
+func (q *Queries) GetActivityEntry(activityID string) (*types.Activity, error) {
+	query, _, err := goqu.From(goqu.L(`
+		all_activity AS a
+		INNER JOIN application AS app ON (a.application_id = app.id)
+		LEFT JOIN groups AS g ON (a.group_id = g.id)
+		LEFT JOIN channel AS c ON (a.channel_id = c.id)
+	`)).
+		Select(
+			"a.id", "a.application_id", "a.group_id", "a.created_ts", "a.class",
+			"a.severity", "a.version", "a.instance_id", "app.team_id",
+			"a.acknowledged_ts", "a.acknowledged_by", "a.ack_comment", "a.resolved_ts",
+			goqu.I("app.name").As("application_name"), goqu.I("g.name").
+				As("group_name"), goqu.I("c.name").As("channel_name")).
+		Where(goqu.I("a.id").Eq(activityID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	activityEntry := &types.Activity{}
+	if err := q.db.QueryRowx(query).StructScan(activityEntry); err != nil {
+		return nil, err
+	}
+	return activityEntry, nil
+}
+
 // activityQuery returns a SelectDataset prepared to return all activity
 // entries that match the criteria provided in ActivityQueryParams.
 // countSelect true returns a count without pagination (Page and PerPage are ignored)
@@ -73,6 +101,7 @@ func (q *Queries) activityQuery(teamID string, p types.ActivityQueryParams, coun
 		query = query.Select(
 			"a.id", "a.application_id", "a.group_id", "a.created_ts", "a.class",
 			"a.severity", "a.version", "a.instance_id",
+			"a.acknowledged_ts", "a.acknowledged_by", "a.ack_comment", "a.resolved_ts",
 			goqu.I("app.name").As("application_name"), goqu.I("g.name").
 				As("group_name"), goqu.I("c.name").As("channel_name"))
 	}
@@ -107,6 +136,10 @@ func (q *Queries) activityQuery(teamID string, p types.ActivityQueryParams, coun
 		query = query.Where(goqu.I("a.severity").Eq(p.Severity))
 	}
 
+	if p.Unacknowledged {
+		query = query.Where(goqu.I("a.acknowledged_ts").IsNull())
+	}
+
 	if !countSelect {
 		limit, offset := sqlPaginate(p.Page, p.PerPage)
 		query = query.Limit(limit).
@@ -0,0 +1,74 @@
+package dbreads
+
+import (
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// GetAPIToken returns the API token identified by the id provided.
+func (q *Queries) GetAPIToken(tokenID string) (*types.APIToken, error) {
+	return q.getAPIToken(goqu.C("id").Eq(tokenID))
+}
+
+// GetAPITokenByHash returns the API token whose hash is the one provided.
+func (q *Queries) GetAPITokenByHash(tokenHash string) (*types.APIToken, error) {
+	return q.getAPIToken(goqu.C("token_hash").Eq(tokenHash))
+}
+
+func (q *Queries) getAPIToken(condition goqu.Expression) (*types.APIToken, error) {
+	var token types.APIToken
+
+	query, _, err := goqu.From("api_token").
+		Where(condition).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRowx(query).StructScan(&token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetAPITokensCount returns the total number of API tokens of the team
+// provided, including the revoked and expired ones.
+func (q *Queries) GetAPITokensCount(teamID string) (int, error) {
+	query := goqu.From("api_token").
+		Where(goqu.C("team_id").Eq(teamID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetAPITokens returns the API tokens of the team provided, newest first.
+func (q *Queries) GetAPITokens(teamID string, page, perPage uint64) ([]*types.APIToken, error) {
+	page, perPage = validatePaginationParams(page, perPage)
+	limit, offset := sqlPaginate(page, perPage)
+	query, _, err := goqu.From("api_token").
+		Where(goqu.C("team_id").Eq(teamID)).
+		Order(goqu.C("created_ts").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []*types.APIToken
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		token := types.APIToken{}
+		if err := rows.StructScan(&token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
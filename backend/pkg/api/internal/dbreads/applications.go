@@ -57,6 +57,22 @@ func (q *Queries) GetApp(appID string) (*types.Application, error) {
 	return &app, nil
 }
 
+// GetAppTeamID returns the id of the team owning the application provided.
+func (q *Queries) GetAppTeamID(appID string) (string, error) {
+	query, _, err := goqu.From("application").
+		Select("team_id").
+		Where(goqu.C("id").Eq(appID)).
+		ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var teamID string
+	if err := q.db.QueryRow(query).Scan(&teamID); err != nil {
+		return "", err
+	}
+	return teamID, nil
+}
+
 func (q *Queries) GetAppsCount(teamID string) (int, error) {
 	query := goqu.From("application").Where(goqu.C("team_id").Eq(teamID)).Select(goqu.L("count(*)"))
 	return q.GetCountQuery(query)
@@ -64,11 +80,36 @@ func (q *Queries) GetAppsCount(teamID string) (int, error) {
 
 // GetApps returns all applications that belong to the team id provided.
 func (q *Queries) GetApps(teamID string, page, perPage uint64) ([]*types.Application, error) {
+	return q.getApps(goqu.C("team_id").Eq(teamID), page, perPage)
+}
+
+// GetAppsByIDsCount returns the number of applications among the ones
+// provided that belong to the team id provided.
+func (q *Queries) GetAppsByIDsCount(teamID string, appIDs []string) (int, error) {
+	if len(appIDs) == 0 {
+		return 0, nil
+	}
+	query := goqu.From("application").
+		Where(goqu.C("team_id").Eq(teamID), goqu.C("id").In(appIDs)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetAppsByIDs returns the applications among the ones provided that belong
+// to the team id provided.
+func (q *Queries) GetAppsByIDs(teamID string, appIDs []string, page, perPage uint64) ([]*types.Application, error) {
+	if len(appIDs) == 0 {
+		return nil, nil
+	}
+	return q.getApps(goqu.And(goqu.C("team_id").Eq(teamID), goqu.C("id").In(appIDs)), page, perPage)
+}
+
+func (q *Queries) getApps(condition goqu.Expression, page, perPage uint64) ([]*types.Application, error) {
 	page, perPage = validatePaginationParams(page, perPage)
 	var apps []*types.Application
 	limit, offset := sqlPaginate(page, perPage)
 	query, _, err := q.appsQuery().
-		Where(goqu.C("team_id").Eq(teamID)).
+		Where(condition).
 		Limit(limit).
 		Offset(offset).
 		ToSQL()
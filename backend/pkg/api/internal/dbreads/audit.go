@@ -0,0 +1,76 @@
+package dbreads
+
+import (
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// GetAuditEntries returns the audit log entries of the team provided matching
+// the criteria provided in AuditQueryParams, newest first.
+func (q *Queries) GetAuditEntries(teamID string, p types.AuditQueryParams) ([]*types.AuditEntry, error) {
+	p.Page, p.PerPage = validatePaginationParams(p.Page, p.PerPage)
+	limit, offset := sqlPaginate(p.Page, p.PerPage)
+	query, _, err := auditQuery(teamID, p).
+		Order(goqu.C("created_ts").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*types.AuditEntry
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		entry := &types.AuditEntry{}
+		if err := rows.StructScan(entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetAuditEntriesCount returns the number of audit log entries of the team
+// provided matching the criteria provided in AuditQueryParams.
+func (q *Queries) GetAuditEntriesCount(teamID string, p types.AuditQueryParams) (int, error) {
+	return q.GetCountQuery(auditQuery(teamID, p).Select(goqu.L("count(*)")))
+}
+
+// auditQuery returns a SelectDataset prepared to return the audit log entries
+// of the team provided that match the criteria provided in AuditQueryParams.
+func auditQuery(teamID string, p types.AuditQueryParams) *goqu.SelectDataset {
+	query := goqu.From("audit_log").
+		Where(goqu.C("team_id").Eq(teamID))
+
+	if p.AppID != "" {
+		query = query.Where(goqu.C("application_id").Eq(p.AppID))
+	}
+	if p.Username != "" {
+		query = query.Where(goqu.C("username").Eq(p.Username))
+	}
+	if p.Action != "" {
+		query = query.Where(goqu.C("action").Eq(p.Action))
+	}
+	if p.TargetType != "" {
+		query = query.Where(goqu.C("target_type").Eq(p.TargetType))
+	}
+	if p.TargetID != "" {
+		query = query.Where(goqu.C("target_id").Eq(p.TargetID))
+	}
+	if !p.Start.IsZero() {
+		query = query.Where(goqu.C("created_ts").Gte(p.Start.UTC()))
+	}
+	if !p.End.IsZero() {
+		query = query.Where(goqu.C("created_ts").Lt(p.End.UTC()))
+	}
+	return query
+}
@@ -104,3 +104,19 @@ func (q *Queries) channelsQuery() *goqu.SelectDataset {
 	query := goqu.From("channel").Order(goqu.I("name").Asc())
 	return query
 }
+
+// GetChannelAppID returns the id of the application the channel provided belongs to.
+func (q *Queries) GetChannelAppID(channelID string) (string, error) {
+	query, _, err := goqu.From("channel").
+		Select("application_id").
+		Where(goqu.C("id").Eq(channelID)).
+		ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var appID string
+	if err := q.db.QueryRow(query).Scan(&appID); err != nil {
+		return "", err
+	}
+	return appID, nil
+}
@@ -0,0 +1,183 @@
+package dbreads
+
+import (
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// Type and result of the events reported by the instances that failed to
+// update.
+const (
+	eventUpdateComplete = 3
+	resultFailed        = 0
+)
+
+// GetEmailNotification returns the email notification identified by the id
+// provided.
+func (q *Queries) GetEmailNotification(notificationID string) (*types.EmailNotification, error) {
+	var notification types.EmailNotification
+
+	query, _, err := goqu.From("email_notification").
+		Where(goqu.C("id").Eq(notificationID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRowx(query).StructScan(&notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// GetEmailNotificationsCount returns the total number of email notifications
+// of the application provided.
+func (q *Queries) GetEmailNotificationsCount(appID string) (int, error) {
+	query := goqu.From("email_notification").
+		Where(goqu.C("application_id").Eq(appID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetEmailNotifications returns the email notifications of the application
+// provided.
+func (q *Queries) GetEmailNotifications(appID string, page, perPage uint64) ([]*types.EmailNotification, error) {
+	page, perPage = validatePaginationParams(page, perPage)
+	limit, offset := sqlPaginate(page, perPage)
+	query, _, err := goqu.From("email_notification").
+		Where(goqu.C("application_id").Eq(appID)).
+		Order(goqu.C("created_ts").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.getEmailNotificationsFromQuery(query)
+}
+
+// GetEnabledEmailNotifications returns the enabled email notifications of all
+// applications.
+func (q *Queries) GetEnabledEmailNotifications() ([]*types.EmailNotification, error) {
+	query, _, err := goqu.From("email_notification").
+		Where(goqu.C("enabled").IsTrue()).
+		Order(goqu.C("created_ts").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.getEmailNotificationsFromQuery(query)
+}
+
+func (q *Queries) getEmailNotificationsFromQuery(query string) ([]*types.EmailNotification, error) {
+	var notifications []*types.EmailNotification
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		notification := types.EmailNotification{}
+		if err := rows.StructScan(&notification); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// GetRolloutActivity returns the activity entries of the classes provided
+// among the ones provided, oldest first.
+func (q *Queries) GetRolloutActivity(activityIDs []string, classes []int) ([]*types.Activity, error) {
+	if len(activityIDs) == 0 || len(classes) == 0 {
+		return nil, nil
+	}
+	query, _, err := goqu.From(goqu.L(`
+		all_activity AS a
+		INNER JOIN application AS app ON (a.application_id = app.id)
+		LEFT JOIN groups AS g ON (a.group_id = g.id)
+		LEFT JOIN channel AS c ON (a.channel_id = c.id)
+	`)).
+		Select(
+			"a.id", "a.application_id", "a.group_id", "a.created_ts", "a.class",
+			"a.severity", "a.version", "a.instance_id", "app.team_id",
+			goqu.I("app.name").As("application_name"), goqu.I("g.name").
+				As("group_name"), goqu.I("c.name").As("channel_name")).
+		Where(
+			goqu.I("a.id").In(activityIDs),
+			goqu.I("a.class").In(classes),
+		).
+		Order(goqu.I("a.created_ts").Asc(), goqu.I("a.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var activityEntries []*types.Activity
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		activityEntry := &types.Activity{}
+		if err := rows.StructScan(activityEntry); err != nil {
+			return nil, err
+		}
+		activityEntries = append(activityEntries, activityEntry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return activityEntries, nil
+}
+
+// GetInstanceFailures returns the update failures reported by the instances of
+// the application provided between from (inclusive) and until (exclusive),
+// grouped by error code, most frequent first.
+func (q *Queries) GetInstanceFailures(appID string, from, until time.Time) ([]*types.InstanceFailures, error) {
+	query, _, err := goqu.From(goqu.T("event").As("e")).
+		Join(goqu.T("event_type").As("et"), goqu.On(goqu.I("e.event_type_id").Eq(goqu.I("et.id")))).
+		Select(
+			goqu.I("e.error_code"),
+			goqu.COUNT("*").As("events"),
+			goqu.COUNT(goqu.DISTINCT("e.instance_id")).As("instances"),
+		).
+		Where(
+			goqu.I("e.application_id").Eq(appID),
+			goqu.I("et.type").Eq(eventUpdateComplete),
+			goqu.I("et.result").Eq(resultFailed),
+			goqu.I("e.created_ts").Gte(from.UTC()),
+			goqu.I("e.created_ts").Lt(until.UTC()),
+			goqu.L(ignoreFakeInstanceCondition("e.instance_id")),
+		).
+		GroupBy(goqu.I("e.error_code")).
+		Order(goqu.I("events").Desc(), goqu.I("e.error_code").Asc().NullsLast()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []*types.InstanceFailures
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		failure := &types.InstanceFailures{}
+		if err := rows.StructScan(failure); err != nil {
+			return nil, err
+		}
+		failures = append(failures, failure)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return failures, nil
+}
@@ -401,6 +401,13 @@ func durationCodeToPostgresTimings(code durationCode) (postgresDuration, postgre
 	}
 }
 
+// IsValidDuration reports whether the duration provided is one of the
+// durations accepted by the instances stats and timelines.
+func IsValidDuration(duration string) bool {
+	_, ok := durationParamToCode[durationParam(duration)]
+	return ok
+}
+
 func durationParamToPostgresTimings(duration durationParam) (postgresDuration, postgresInterval, error) {
 	code, ok := durationParamToCode[duration]
 	if !ok {
@@ -723,3 +730,19 @@ func (q *Queries) GetGroupStatusCountTimeline(groupID string, duration string) (
 
 	return timelineCount, nil
 }
+
+// GetGroupAppID returns the id of the application the group provided belongs to.
+func (q *Queries) GetGroupAppID(groupID string) (string, error) {
+	query, _, err := goqu.From("groups").
+		Select("application_id").
+		Where(goqu.C("id").Eq(groupID)).
+		ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var appID string
+	if err := q.db.QueryRow(query).Scan(&appID); err != nil {
+		return "", err
+	}
+	return appID, nil
+}
@@ -0,0 +1,173 @@
+package dbreads
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const (
+	// defaultInstanceEventsWindow is how far back the events of an instance
+	// are returned when no start is provided.
+	defaultInstanceEventsWindow = 30 * 24 * time.Hour
+
+	// defaultInstanceCheckInGap is the shortest period without contacting
+	// Nebraska reported as a gap when none is provided. Instances check for
+	// updates roughly every hour.
+	defaultInstanceCheckInGap = 2 * time.Hour
+
+	// maxInstanceCheckInGaps is the maximum number of gaps returned.
+	maxInstanceCheckInGaps = 1000
+)
+
+// GetInstanceEvents returns the events reported by an instance in the context
+// of an application that match the criteria provided, newest first, along
+// with the gaps between the instance check-ins. It returns sql.ErrNoRows if
+// the instance doesn't belong to the group provided.
+//
+// Nebraska only keeps the time of the last check for updates of an instance,
+// so check-ins are derived from it and from the times at which the instance
+// reported events or changed status. A gap without events reported is
+// returned whenever two consecutive check-ins are more than MinGap apart,
+// which healthy instances without anything to report also have, and an
+// ongoing gap without check-ins when the instance hasn't contacted Nebraska
+// for longer than MinGap.
+func (q *Queries) GetInstanceEvents(p types.InstanceEventsQueryParams) (*types.InstanceEventsTimeline, error) {
+	p.Page, p.PerPage = validatePaginationParams(p.Page, p.PerPage)
+	now := time.Now().UTC()
+	if p.End.IsZero() {
+		p.End = now
+	}
+	if p.Start.IsZero() {
+		p.Start = p.End.Add(-defaultInstanceEventsWindow)
+	}
+	if p.MinGap <= 0 {
+		p.MinGap = defaultInstanceCheckInGap
+	}
+
+	timeline := &types.InstanceEventsTimeline{
+		Events: []*types.InstanceEvent{},
+		Gaps:   []*types.InstanceCheckInGap{},
+	}
+
+	query, _, err := goqu.From("instance_application").
+		Select("last_check_for_updates").
+		Where(goqu.C("instance_id").Eq(p.InstanceID), goqu.C("application_id").Eq(p.ApplicationID), goqu.C("group_id").Eq(p.GroupID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRow(query).Scan(&timeline.LastCheckForUpdates); err != nil {
+		return nil, err
+	}
+
+	count, err := q.GetCountQuery(instanceEventsQuery(p).Select(goqu.COUNT("*")))
+	if err != nil {
+		return nil, err
+	}
+	timeline.TotalEvents = uint64(count)
+
+	limit, offset := sqlPaginate(p.Page, p.PerPage)
+	query, _, err = instanceEventsQuery(p).
+		Select(
+			goqu.I("e.id"), goqu.I("e.created_ts"), goqu.I("et.type"), goqu.I("et.result"),
+			goqu.I("et.description"), goqu.I("e.previous_version"), goqu.I("e.error_code"),
+		).
+		Order(goqu.I("e.created_ts").Desc(), goqu.I("e.id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.Select(&timeline.Events, query); err != nil {
+		return nil, err
+	}
+
+	query, _, err = instanceCheckInGapsQuery(p).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.Select(&timeline.Gaps, query); err != nil {
+		return nil, err
+	}
+
+	if !p.End.Before(now) {
+		query, _, err = goqu.From(instanceCheckInsQuery(p).As("c")).Select(goqu.MAX("ts")).ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		var lastCheckIn null.Time
+		if err := q.db.QueryRow(query).Scan(&lastCheckIn); err != nil {
+			return nil, err
+		}
+		if !lastCheckIn.Valid {
+			lastCheckIn = timeline.LastCheckForUpdates
+		}
+		if lastCheckIn.Valid && now.Sub(lastCheckIn.Time) > p.MinGap {
+			timeline.Gaps = append(timeline.Gaps, &types.InstanceCheckInGap{From: lastCheckIn.Time, To: now, Ongoing: true, Kind: types.GapNoCheckIn})
+		}
+	}
+
+	return timeline, nil
+}
+
+// instanceEventsQuery returns a SelectDataset prepared to return the events
+// of an instance matching the criteria provided.
+func instanceEventsQuery(p types.InstanceEventsQueryParams) *goqu.SelectDataset {
+	return goqu.From(goqu.T("event").As("e")).
+		InnerJoin(goqu.T("event_type").As("et"), goqu.On(goqu.I("et.id").Eq(goqu.I("e.event_type_id")))).
+		Where(
+			goqu.I("e.instance_id").Eq(p.InstanceID),
+			goqu.I("e.application_id").Eq(p.ApplicationID),
+			goqu.I("e.created_ts").Gte(p.Start),
+			goqu.I("e.created_ts").Lt(p.End),
+		)
+}
+
+// instanceCheckInsQuery returns a SelectDataset prepared to return the times
+// at which an instance contacted Nebraska between Start and End. Events
+// aren't recorded with the group of the instance, while its status changes in
+// other groups are left out.
+func instanceCheckInsQuery(p types.InstanceEventsQueryParams) *goqu.SelectDataset {
+	checkIns := func(table, column string, inGroup bool) *goqu.SelectDataset {
+		query := goqu.From(table).
+			Select(goqu.C(column).As("ts")).
+			Where(
+				goqu.C("instance_id").Eq(p.InstanceID),
+				goqu.C("application_id").Eq(p.ApplicationID),
+				goqu.C(column).Gte(p.Start),
+				goqu.C(column).Lt(p.End),
+			)
+		if inGroup {
+			query = query.Where(goqu.C("group_id").Eq(p.GroupID))
+		}
+		return query
+	}
+	return checkIns("event", "created_ts", false).
+		UnionAll(checkIns("instance_status_history", "created_ts", true)).
+		UnionAll(checkIns("instance_application", "last_check_for_updates", true))
+}
+
+// instanceCheckInGapsQuery returns a SelectDataset prepared to return the
+// gaps longer than MinGap between the check-ins of an instance, oldest first.
+func instanceCheckInGapsQuery(p types.InstanceEventsQueryParams) *goqu.SelectDataset {
+	orderedCheckIns := goqu.From(instanceCheckInsQuery(p).As("c")).
+		Select(goqu.C("ts"), goqu.L("lag(ts) OVER (ORDER BY ts)").As("prev_ts"))
+
+	return goqu.From(orderedCheckIns.As("o")).
+		Select(goqu.C("prev_ts").As("from_ts"), goqu.C("ts").As("to_ts"), goqu.V(types.GapNoEventsReported).As("kind")).
+		Where(goqu.L("ts - prev_ts > ?::interval", durationToPostgresInterval(p.MinGap))).
+		Order(goqu.C("from_ts").Asc()).
+		Limit(maxInstanceCheckInGaps)
+}
+
+// durationToPostgresInterval returns the duration provided as a postgres
+// interval.
+func durationToPostgresInterval(d time.Duration) postgresInterval {
+	return postgresInterval(fmt.Sprintf("%d milliseconds", d.Milliseconds()))
+}
@@ -0,0 +1,113 @@
+package dbreads
+
+import (
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const (
+	// defaultVersionStatsWindow is how far back the instance stats timeline
+	// goes when no start is provided.
+	defaultVersionStatsWindow = 30 * 24 * time.Hour
+
+	// maxHourlyStatsWindow and maxDailyStatsWindow are the longest time
+	// ranges charted with hourly and daily snapshots respectively when no
+	// resolution is provided. Longer ones use weekly snapshots.
+	maxHourlyStatsWindow = 7 * 24 * time.Hour
+	maxDailyStatsWindow  = 180 * 24 * time.Hour
+)
+
+// instanceStatsResolutionFor returns the resolution used to chart the time
+// range provided when none is requested.
+func instanceStatsResolutionFor(start, end time.Time) types.InstanceStatsResolution {
+	switch window := end.Sub(start); {
+	case window <= maxHourlyStatsWindow:
+		return types.InstanceStatsHourly
+	case window <= maxDailyStatsWindow:
+		return types.InstanceStatsDaily
+	default:
+		return types.InstanceStatsWeekly
+	}
+}
+
+// GetVersionStatsTimeline returns the number of instances running each version
+// of a channel over the time range provided, read from the instance stats
+// snapshots of the resolution requested. All versions are reported for every
+// snapshot, so versions without instances at some point are counted as zero.
+func (q *Queries) GetVersionStatsTimeline(p types.VersionStatsQueryParams) (*types.VersionStatsTimeline, error) {
+	if p.End.IsZero() {
+		p.End = time.Now().UTC()
+	}
+	if p.Start.IsZero() {
+		p.Start = p.End.Add(-defaultVersionStatsWindow)
+	}
+	if p.Resolution == "" {
+		p.Resolution = instanceStatsResolutionFor(p.Start, p.End)
+	}
+	table, err := p.Resolution.Table()
+	if err != nil {
+		return nil, err
+	}
+
+	teamChannels := goqu.From(goqu.T("channel").As("c")).
+		Join(goqu.T("application").As("a"), goqu.On(goqu.I("a.id").Eq(goqu.I("c.application_id")))).
+		Select("c.id").
+		Where(goqu.I("a.team_id").Eq(p.TeamID))
+	query, _, err := goqu.From(table).
+		Select("timestamp", "version", "instances").
+		Where(
+			goqu.C("channel_id").Eq(p.ChannelID),
+			goqu.C("channel_id").In(teamChannels),
+			goqu.C("timestamp").Gte(p.Start),
+			goqu.C("timestamp").Lt(p.End),
+		).
+		Order(goqu.C("timestamp").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	timeline := &types.VersionStatsTimeline{
+		Resolution: p.Resolution,
+		Timeline:   make(map[time.Time]types.VersionCountMap),
+	}
+	allVersions := make(map[string]struct{})
+	for rows.Next() {
+		var timestamp time.Time
+		var version string
+		var instances uint64
+		if err := rows.Scan(&timestamp, &version, &instances); err != nil {
+			return nil, err
+		}
+		timestamp = timestamp.UTC()
+		counts, ok := timeline.Timeline[timestamp]
+		if !ok {
+			counts = make(types.VersionCountMap)
+			timeline.Timeline[timestamp] = counts
+		}
+		counts[version] += instances
+		allVersions[version] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for version := range allVersions {
+		for _, counts := range timeline.Timeline {
+			if _, ok := counts[version]; !ok {
+				counts[version] = 0
+			}
+		}
+	}
+
+	return timeline, nil
+}
@@ -73,6 +73,7 @@ func (q *Queries) GetInstance(instanceID, appID string) (*types.Instance, error)
 	if err != nil {
 		return nil, err
 	}
+	instance.SuspectedDuplicate = types.IsSuspectedDuplicate(instance.DuplicateSuspectedTs)
 	/* passing "" to sortFilter while invoking getInstanceApp signifies we are not interested
 	in a sort
 	*/
@@ -88,6 +89,42 @@ func (q *Queries) GetInstance(instanceID, appID string) (*types.Instance, error)
 
 	return &instance, nil
 }
+
+// GetInstancePreviousVersion returns the version an instance was running for
+// the application provided before its current one, when it changed, and when
+// the instance last went back to its previous version.
+func (q *Queries) GetInstancePreviousVersion(instanceID, appID string) (*types.InstanceVersionChange, error) {
+	var change types.InstanceVersionChange
+	query, _, err := goqu.From("instance_application").
+		Select("previous_version", "version_changed_ts", "version_reverted_ts").
+		Where(goqu.C("instance_id").Eq(instanceID), goqu.C("application_id").Eq(appID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRowx(query).StructScan(&change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// GetInstanceGroupOverride returns the id of the group the instance has been
+// assigned to for the application provided, regardless of the track it
+// reports. It returns sql.ErrNoRows if the instance has not been assigned to
+// any group.
+func (q *Queries) GetInstanceGroupOverride(instanceID, appID string) (string, error) {
+	var groupID string
+	query, _, err := goqu.From("instance_group_override").
+		Select("group_id").
+		Where(goqu.C("instance_id").Eq(instanceID), goqu.C("application_id").Eq(appID)).
+		ToSQL()
+	if err != nil {
+		return "", err
+	}
+	err = q.db.QueryRow(query).Scan(&groupID)
+	return groupID, err
+}
+
 func (q *Queries) getInstanceApp(appID, instanceID string, duration postgresDuration, sortFilter string, orderOfSort sortOrder) (*types.InstanceApplication, error) {
 	var instanceApp types.InstanceApplication
 	query, _, err := q.instanceAppQuery(appID, instanceID, duration, sortFilter, orderOfSort).ToSQL()
@@ -182,9 +219,12 @@ func (q *Queries) GetInstances(p types.InstancesQueryParams, duration string) (t
 	limit, offset := sqlPaginate(p.Page, p.PerPage)
 	sortFilter := sanitizeSortFilterParams(p.SortFilter)
 	sortOrder := sortOrderFromString(p.SortOrder)
-	instancesQuery := q.instancesQuery(p, dbDuration)
+	instancesQuery, err := q.instancesQuery(p, dbDuration)
+	if err != nil {
+		return types.InstancesWithTotal{}, err
+	}
 	instancesQuery = instancesQuery.Select("id", "ip", "created_ts", goqu.Case().
-		When(goqu.C("alias").Neq(""), goqu.C("alias")).Else(goqu.C("id")).As("alias"))
+		When(goqu.C("alias").Neq(""), goqu.C("alias")).Else(goqu.C("id")).As("alias"), "labels", "duplicate_suspected_ts")
 
 	instanceAppQuery := prepareInstanceAppQuery()
 	finalQuery := prepareGetInstancesQuery(instancesQuery, instanceAppQuery)
@@ -210,13 +250,15 @@ func (q *Queries) GetInstances(p types.InstancesQueryParams, duration string) (t
 	defer rows.Close()
 	for rows.Next() {
 		var instance types.Instance
-		err = rows.Scan(&instance.ID, &instance.IP, &instance.CreatedTs, &instance.Alias,
+		err = rows.Scan(&instance.ID, &instance.IP, &instance.CreatedTs, &instance.Alias, &instance.Labels, &instance.DuplicateSuspectedTs,
 			&instance.Application.Version, &instance.Application.Status, &instance.Application.LastCheckForUpdates,
 			&instance.Application.LastUpdateVersion, &instance.Application.UpdateInProgress,
-			&instance.Application.ApplicationID, &instance.Application.GroupID, &instance.Application.InstanceID)
+			&instance.Application.ApplicationID, &instance.Application.GroupID, &instance.Application.RequestedGroupID,
+			&instance.Application.InstanceID)
 		if err != nil {
 			return types.InstancesWithTotal{}, err
 		}
+		instance.SuspectedDuplicate = types.IsSuspectedDuplicate(instance.DuplicateSuspectedTs)
 		instances = append(instances, &instance)
 	}
 	if err := rows.Err(); err != nil {
@@ -230,7 +272,7 @@ func (q *Queries) GetInstances(p types.InstancesQueryParams, duration string) (t
 }
 func prepareInstanceAppQuery() *goqu.SelectDataset {
 	return goqu.From("instance_application").
-		Select("version", "status", "last_check_for_updates", "last_update_version", "update_in_progress", "application_id", "group_id", "instance_id")
+		Select("version", "status", "last_check_for_updates", "last_update_version", "update_in_progress", "application_id", "group_id", "requested_group_id", "instance_id")
 }
 func (q *Queries) GetInstancesCount(p types.InstancesQueryParams, duration string) (int, error) {
 	var err error
@@ -240,9 +282,12 @@ func (q *Queries) GetInstancesCount(p types.InstancesQueryParams, duration strin
 	if err != nil {
 		return 0, err
 	}
-	instancesQuery := q.instancesQuery(p, dbDuration)
+	instancesQuery, err := q.instancesQuery(p, dbDuration)
+	if err != nil {
+		return 0, err
+	}
 	instancesQuery = instancesQuery.Select("id", "ip", "created_ts", goqu.Case().
-		When(goqu.C("alias").Neq(""), goqu.C("alias")).Else(goqu.C("id")).As("alias"))
+		When(goqu.C("alias").Neq(""), goqu.C("alias")).Else(goqu.C("id")).As("alias"), "labels", "duplicate_suspected_ts")
 
 	instanceAppQuery := prepareInstanceAppQuery()
 	finalQuery := prepareGetInstancesQuery(instancesQuery, instanceAppQuery)
@@ -276,13 +321,17 @@ func ignoreFakeInstanceCondition(instanceIDField string) string {
 	return fmt.Sprintf(`(%[1]s IS NULL OR %[1]s NOT LIKE '{________-____-____-____-____________}')`, instanceIDField)
 }
 
-func (q *Queries) getFilterInstancesQuery(selectPart exp.LiteralExpression, p types.InstancesQueryParams, duration postgresDuration) *goqu.SelectDataset {
+func (q *Queries) getFilterInstancesQuery(selectPart exp.LiteralExpression, p types.InstancesQueryParams, duration postgresDuration) (*goqu.SelectDataset, error) {
 	query := goqu.From("instance_application").
 		Select(selectPart).
-		Where(goqu.C("application_id").Eq(p.ApplicationID), goqu.C("group_id").Eq(p.GroupID)).
+		Where(goqu.C("application_id").Eq(p.ApplicationID)).
 		Where(goqu.L("last_check_for_updates > now() at time zone 'utc' - interval ?", duration),
 			goqu.L(ignoreFakeInstanceCondition("instance_id")))
 
+	if p.GroupID != "" {
+		query = query.Where(goqu.C("group_id").Eq(p.GroupID))
+	}
+
 	if p.Status == types.InstanceStatusUndefined {
 		query = query.Where(goqu.L("status IS NULL"))
 	} else if p.Status != 0 {
@@ -291,16 +340,44 @@ func (q *Queries) getFilterInstancesQuery(selectPart exp.LiteralExpression, p ty
 	if p.Version != "" {
 		query = query.Where(goqu.C("version").Eq(p.Version))
 	}
-	return query
+
+	conditions, err := instanceAppFilterConditions(p)
+	if err != nil {
+		return nil, err
+	}
+	return query.Where(conditions...), nil
 }
 
 // instancesQuery returns a SelectDataset prepared to return all instances
 // that match the criteria provided in InstancesQueryParams.
-func (q *Queries) instancesQuery(p types.InstancesQueryParams, duration postgresDuration) *goqu.SelectDataset {
-	instancesSubquery := q.getFilterInstancesQuery(goqu.L("instance_id"), p, duration)
+func (q *Queries) instancesQuery(p types.InstancesQueryParams, duration postgresDuration) (*goqu.SelectDataset, error) {
+	instancesSubquery, err := q.getFilterInstancesQuery(goqu.L("instance_id"), p, duration)
+	if err != nil {
+		return nil, err
+	}
+	conditions, err := instanceFilterConditions(p)
+	if err != nil {
+		return nil, err
+	}
 
 	return goqu.From("instance").
-		Where(goqu.L("id IN ?", instancesSubquery))
+		Where(goqu.L("id IN ?", instancesSubquery)).
+		Where(conditions...), nil
+}
+
+// InstanceIDsQuery returns a SelectDataset prepared to return the ids of the
+// instances that match the criteria provided in InstancesQueryParams, in the
+// same way GetInstances does.
+func (q *Queries) InstanceIDsQuery(p types.InstancesQueryParams, duration string) (*goqu.SelectDataset, error) {
+	dbDuration, _, err := durationParamToPostgresTimings(durationParam(duration))
+	if err != nil {
+		return nil, err
+	}
+	query, err := q.instancesQuery(p, dbDuration)
+	if err != nil {
+		return nil, err
+	}
+	return prepareSearchQuery(query, p).Select("id"), nil
 }
 
 // instanceStatusHistoryQuery returns a SelectDataset prepared to return the
@@ -381,6 +458,7 @@ func (q *Queries) InstanceStatsQuery(t *time.Time, duration *time.Duration) *goq
 	query := goqu.From(goqu.T("instance_application")).
 		Select(
 			timestamp,
+			goqu.T("channel").Col("id").As("channel_id"),
 			goqu.T("channel").Col("name").As("channel_name"),
 			goqu.Case().
 				When(goqu.T("channel").Col("arch").Eq(1), "AMD64").
@@ -397,6 +475,7 @@ func (q *Queries) InstanceStatsQuery(t *time.Time, duration *time.Duration) *goq
 			goqu.L(ignoreFakeInstanceCondition("instance_id")),
 			goqu.T("instance").Col("created_ts").Lte(timestamp)).
 		GroupBy(timestamp,
+			goqu.T("channel").Col("id"),
 			goqu.T("channel").Col("name"),
 			goqu.T("channel").Col("arch"),
 			goqu.C("version")).
@@ -463,3 +542,20 @@ func (q *Queries) GetInstanceStatsByTimestamp(t time.Time) ([]types.InstanceStat
 
 	return instances, nil
 }
+
+// GetInstanceAppIDs returns the ids of the applications the instance provided
+// reports to.
+func (q *Queries) GetInstanceAppIDs(instanceID string) ([]string, error) {
+	query, _, err := goqu.From("instance_application").
+		Select("application_id").
+		Where(goqu.C("instance_id").Eq(instanceID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var appIDs []string
+	if err := q.db.Select(&appIDs, query); err != nil {
+		return nil, err
+	}
+	return appIDs, nil
+}
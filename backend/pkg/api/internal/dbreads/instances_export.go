@@ -0,0 +1,116 @@
+package dbreads
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const (
+	// instancesExportCursor is the name of the cursor used to export instances.
+	instancesExportCursor = "instances_export"
+
+	// instancesExportBatchSize is the number of rows fetched at once from the
+	// cursor used to export instances.
+	instancesExportBatchSize = 1000
+)
+
+// instancesExportQuery returns a SelectDataset prepared to return the
+// instances of an application that match the criteria provided in
+// InstancesQueryParams, along with the details of the application on each of
+// them. The group is optional.
+func (q *Queries) instancesExportQuery(p types.InstancesQueryParams, duration postgresDuration) (*goqu.SelectDataset, error) {
+	instanceAppQuery, err := q.getFilterInstancesQuery(goqu.L("*"), p, duration)
+	if err != nil {
+		return nil, err
+	}
+	conditions, err := instanceFilterConditions(p)
+	if err != nil {
+		return nil, err
+	}
+	instanceQuery := prepareSearchQuery(goqu.From("instance").Where(conditions...), p)
+
+	return goqu.From(instanceAppQuery.As("ia")).
+		InnerJoin(instanceQuery.As("i"), goqu.On(goqu.I("i.id").Eq(goqu.I("ia.instance_id")))).
+		LeftJoin(goqu.T("groups").As("g"), goqu.On(goqu.I("g.id").Eq(goqu.I("ia.group_id")))).
+		Select(
+			goqu.I("i.id"), goqu.I("i.ip"), goqu.I("i.alias"), goqu.I("i.oem"), goqu.I("i.aleph_version"),
+			goqu.I("i.created_ts"), goqu.I("ia.application_id"), goqu.I("ia.group_id"), goqu.I("g.name").As("group_name"),
+			goqu.I("ia.version"), goqu.I("ia.status"), goqu.I("ia.last_check_for_updates"),
+			goqu.I("ia.last_update_granted_ts"), goqu.I("ia.last_update_version"), goqu.I("ia.update_in_progress"),
+			goqu.I("i.labels"),
+		), nil
+}
+
+// ExportInstances calls fn for each instance of an application that matches
+// the criteria provided. Instances are read in batches from a server side
+// cursor, so memory usage stays constant however many instances match. The
+// export stops at the first error returned by fn or when ctx is done.
+func (q *Queries) ExportInstances(ctx context.Context, p types.InstancesQueryParams, duration string, fn func(*types.InstanceExport) error) error {
+	dbDuration, _, err := durationParamToPostgresTimings(durationParam(duration))
+	if err != nil {
+		return err
+	}
+	exportQuery, err := q.instancesExportQuery(p, dbDuration)
+	if err != nil {
+		return err
+	}
+	query, _, err := exportQuery.ToSQL()
+	if err != nil {
+		return err
+	}
+
+	tx, err := q.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("ExportInstances - could not roll back")
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", instancesExportCursor, query)); err != nil {
+		return err
+	}
+
+	fetchQuery := fmt.Sprintf("FETCH FORWARD %d FROM %s", instancesExportBatchSize, instancesExportCursor)
+	for {
+		fetched, err := q.fetchInstancesExport(ctx, tx, fetchQuery, fn)
+		if err != nil {
+			return err
+		}
+		if fetched < instancesExportBatchSize {
+			return nil
+		}
+	}
+}
+
+// fetchInstancesExport fetches the next batch of instances from the export
+// cursor, calling fn for each of them, and returns the number of instances
+// fetched.
+func (q *Queries) fetchInstancesExport(ctx context.Context, tx *sqlx.Tx, fetchQuery string, fn func(*types.InstanceExport) error) (int, error) {
+	rows, err := tx.QueryxContext(ctx, fetchQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	fetched := 0
+	for rows.Next() {
+		var instance types.InstanceExport
+		if err := rows.StructScan(&instance); err != nil {
+			return fetched, err
+		}
+		fetched++
+		if err := fn(&instance); err != nil {
+			return fetched, err
+		}
+	}
+	return fetched, rows.Err()
+}
@@ -0,0 +1,129 @@
+package dbreads
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// versionConstraintRegexp matches a constraint of a version range, like
+// ">=3510.2.0", capturing the operator and the version.
+var versionConstraintRegexp = regexp.MustCompile(`^(>=|<=|!=|>|<|=)?(\d{1,9}(?:\.\d{1,9})*)$`)
+
+// versionConstraint represents a single constraint of a version range.
+type versionConstraint struct {
+	operator string
+	version  string
+}
+
+// parseVersionRange parses a version range made of space separated
+// constraints, all of which must be satisfied, like ">=3510.0.0 <3600.0.0".
+// A constraint without operator matches the version exactly.
+func parseVersionRange(versionRange string) ([]versionConstraint, error) {
+	var constraints []versionConstraint
+	for _, field := range strings.Fields(versionRange) {
+		matches := versionConstraintRegexp.FindStringSubmatch(field)
+		if matches == nil {
+			return nil, fmt.Errorf("%w: invalid version constraint %q", types.ErrInvalidInstancesFilter, field)
+		}
+		operator := matches[1]
+		if operator == "" {
+			operator = "="
+		}
+		constraints = append(constraints, versionConstraint{operator: operator, version: matches[2]})
+	}
+	return constraints, nil
+}
+
+// versionRangeConditions returns the conditions matching the rows whose
+// version column satisfies the version range provided. Versions are compared
+// with the version_to_int_array function, which the version columns are
+// indexed on, padding them to three components so that =3510 matches
+// 3510.0.0. Versions that can't be compared, like empty ones, never match.
+func versionRangeConditions(column, versionRange string) ([]exp.Expression, error) {
+	constraints, err := parseVersionRange(versionRange)
+	if err != nil {
+		return nil, err
+	}
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+
+	conditions := make([]exp.Expression, 0, len(constraints))
+	for _, c := range constraints {
+		conditions = append(conditions, goqu.L(fmt.Sprintf("version_to_int_array(%s) %s version_to_int_array(?)", column, c.operator), c.version))
+	}
+	return conditions, nil
+}
+
+// instanceFilterConditions returns the conditions matching the instances that
+// satisfy the structured filters of the instance table provided in
+// InstancesQueryParams.
+func instanceFilterConditions(p types.InstancesQueryParams) ([]exp.Expression, error) {
+	var conditions []exp.Expression
+
+	if p.IPCIDR != "" {
+		cidr := p.IPCIDR
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			if net.ParseIP(cidr) == nil {
+				return nil, fmt.Errorf("%w: invalid CIDR %q", types.ErrInvalidInstancesFilter, cidr)
+			}
+		}
+		conditions = append(conditions, goqu.L("ip <<= ?::inet", cidr))
+	}
+
+	if p.OEM != "" {
+		conditions = append(conditions, goqu.C("oem").Eq(p.OEM))
+	}
+
+	alephConditions, err := versionRangeConditions("aleph_version", p.AlephVersionRange)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, alephConditions...)
+
+	if p.Labels != "" {
+		labels, err := types.ParseLabels(p.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", types.ErrInvalidInstancesFilter, err)
+		}
+		value, err := labels.Value()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, goqu.L("labels @> ?::jsonb", value))
+	}
+
+	return conditions, nil
+}
+
+// instanceAppFilterConditions returns the conditions matching the instance
+// applications that satisfy the structured filters of the instance_application
+// table provided in InstancesQueryParams.
+func instanceAppFilterConditions(p types.InstancesQueryParams) ([]exp.Expression, error) {
+	var conditions []exp.Expression
+
+	if !p.LastCheckAfter.IsZero() {
+		conditions = append(conditions, goqu.C("last_check_for_updates").Gte(p.LastCheckAfter))
+	}
+	if !p.LastCheckBefore.IsZero() {
+		conditions = append(conditions, goqu.C("last_check_for_updates").Lt(p.LastCheckBefore))
+	}
+	if !p.LastCheckAfter.IsZero() && !p.LastCheckBefore.IsZero() && !p.LastCheckAfter.Before(p.LastCheckBefore) {
+		return nil, fmt.Errorf("%w: last check-in range is empty", types.ErrInvalidInstancesFilter)
+	}
+
+	versionConditions, err := versionRangeConditions("version", p.VersionRange)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, versionConditions...)
+
+	return conditions, nil
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
@@ -23,6 +25,14 @@ WHERE a.id = e.application_id AND e.event_type_id = et.id AND et.result = 0 AND
 GROUP BY app_name
 ORDER BY app_name
 `, ignoreFakeInstanceCondition("e.instance_id"))
+
+	appInstancesPerLabelMetricSQL = fmt.Sprintf(`
+SELECT a.name AS app_name, l.key AS label_key, l.value AS label_value, count(*) AS instances_count
+FROM instance_application ia, application a, instance i, jsonb_each_text(i.labels) l
+WHERE a.id = ia.application_id AND i.id = ia.instance_id AND l.key = ANY($1) AND %s
+GROUP BY app_name, label_key, label_value
+ORDER BY app_name, label_key, label_value
+`, ignoreFakeInstanceCondition("ia.instance_id"))
 )
 
 func (q *Queries) GetAppInstancesPerChannelMetrics() ([]types.AppInstancesPerChannelMetric, error) {
@@ -67,6 +77,32 @@ func (q *Queries) GetFailedUpdatesMetrics() ([]types.FailedUpdatesMetric, error)
 	return metrics, nil
 }
 
+// GetAppInstancesPerLabelMetrics returns the number of instances of each
+// application per value of the label keys provided.
+func (q *Queries) GetAppInstancesPerLabelMetrics(keys []string) ([]types.AppInstancesPerLabelMetric, error) {
+	var metrics []types.AppInstancesPerLabelMetric
+	if len(keys) == 0 {
+		return metrics, nil
+	}
+	rows, err := q.db.Queryx(appInstancesPerLabelMetricSQL, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var metric types.AppInstancesPerLabelMetric
+		err := rows.StructScan(&metric)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
 func (q *Queries) DbStats() sql.DBStats {
 	return q.db.Stats()
 }
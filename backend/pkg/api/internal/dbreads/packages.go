@@ -270,3 +270,19 @@ func (q *Queries) getPackage(packageID null.String) (*types.Package, error) {
 
 	return &packageEntity, nil
 }
+
+// GetPackageAppID returns the id of the application the package provided belongs to.
+func (q *Queries) GetPackageAppID(packageID string) (string, error) {
+	query, _, err := goqu.From("package").
+		Select("application_id").
+		Where(goqu.C("id").Eq(packageID)).
+		ToSQL()
+	if err != nil {
+		return "", err
+	}
+	var appID string
+	if err := q.db.QueryRow(query).Scan(&appID); err != nil {
+		return "", err
+	}
+	return appID, nil
+}
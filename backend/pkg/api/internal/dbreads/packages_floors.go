@@ -47,6 +47,19 @@ func versionCompareExpr(column, operator, value string) (goqu.Expression, error)
 
 // GetChannelFloorPackages returns all floor packages for a specific channel
 func (q *Queries) GetChannelFloorPackages(channelID string) ([]*types.Package, error) {
+	return q.getChannelFloorPackages(goqu.C("channel_id").Table("cpf").Eq(channelID))
+}
+
+// GetChannelSyncedFloorPackages returns the floor packages set by the syncer
+// for a specific channel.
+func (q *Queries) GetChannelSyncedFloorPackages(channelID string) ([]*types.Package, error) {
+	return q.getChannelFloorPackages(goqu.And(
+		goqu.C("channel_id").Table("cpf").Eq(channelID),
+		goqu.C("source").Table("cpf").Eq(types.FloorSourceSyncer),
+	))
+}
+
+func (q *Queries) getChannelFloorPackages(filter goqu.Expression) ([]*types.Package, error) {
 	// No blacklist check needed for floors
 	semverExpr, err := semverToIntArray("p.version")
 	if err != nil {
@@ -62,7 +75,7 @@ func (q *Queries) GetChannelFloorPackages(channelID string) ([]*types.Package, e
 			true as is_floor,
 			cpf.floor_reason
 		`)).
-		Where(goqu.C("channel_id").Table("cpf").Eq(channelID)).
+		Where(filter).
 		Order(goqu.L(semverExpr).Asc()).
 		ToSQL()
 
@@ -0,0 +1,106 @@
+package dbreads
+
+import (
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// GetRoleBinding returns the role binding identified by the id provided.
+func (q *Queries) GetRoleBinding(bindingID string) (*types.RoleBinding, error) {
+	var binding types.RoleBinding
+
+	query, _, err := goqu.From("role_binding").
+		Where(goqu.C("id").Eq(bindingID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRowx(query).StructScan(&binding); err != nil {
+		return nil, err
+	}
+	return &binding, nil
+}
+
+// GetRoleBindingsCount returns the number of role bindings of the application
+// provided.
+func (q *Queries) GetRoleBindingsCount(appID string) (int, error) {
+	query := goqu.From("role_binding").
+		Where(goqu.C("application_id").Eq(appID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetRoleBindings returns the role bindings of the application provided.
+func (q *Queries) GetRoleBindings(appID string, page, perPage uint64) ([]*types.RoleBinding, error) {
+	page, perPage = validatePaginationParams(page, perPage)
+	limit, offset := sqlPaginate(page, perPage)
+	query := goqu.From("role_binding").
+		Where(goqu.C("application_id").Eq(appID)).
+		Order(goqu.C("subject_type").Asc(), goqu.C("subject").Asc(), goqu.C("created_ts").Asc()).
+		Limit(limit).
+		Offset(offset)
+	return q.getRoleBindings(query)
+}
+
+// GetSubjectsRoleBindings returns the role bindings granted to any of the
+// subjects provided, on the application provided or on every application
+// when empty.
+func (q *Queries) GetSubjectsRoleBindings(subjectType string, subjects []string, appID string) ([]*types.RoleBinding, error) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+	query := goqu.From("role_binding").
+		Where(
+			goqu.C("subject_type").Eq(subjectType),
+			goqu.C("subject").In(subjects),
+		)
+	if appID != "" {
+		query = query.Where(goqu.C("application_id").Eq(appID))
+	}
+	return q.getRoleBindings(query)
+}
+
+// HasRoleBindings reports whether any role binding is granted to the subjects
+// provided.
+func (q *Queries) HasRoleBindings(subjectType string, subjects []string) (bool, error) {
+	if len(subjects) == 0 {
+		return false, nil
+	}
+	query := goqu.From("role_binding").
+		Where(
+			goqu.C("subject_type").Eq(subjectType),
+			goqu.C("subject").In(subjects),
+		).
+		Select(goqu.L("count(*)"))
+	count, err := q.GetCountQuery(query)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (q *Queries) getRoleBindings(ds *goqu.SelectDataset) ([]*types.RoleBinding, error) {
+	query, _, err := ds.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []*types.RoleBinding
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		binding := types.RoleBinding{}
+		if err := rows.StructScan(&binding); err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, &binding)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
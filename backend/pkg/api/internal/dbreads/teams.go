@@ -1,15 +1,17 @@
 package dbreads
 
 import (
+	"fmt"
+
 	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 
 	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 )
 
 func (q *Queries) GetTeams() ([]*types.Team, error) {
 	var teams []*types.Team
-	query, _, err := goqu.From("team").
-		Select("id", "name", "created_ts").
+	query, _, err := teamsQuery().
 		Order(goqu.C("name").Asc()).
 		ToSQL()
 	if err != nil {
@@ -34,10 +36,12 @@ func (q *Queries) GetTeams() ([]*types.Team, error) {
 	return teams, nil
 }
 
+// GetTeam returns the default team, the first one created. It owns the
+// applications of the users not mapped to any team.
 func (q *Queries) GetTeam() (*types.Team, error) {
 	var team = &types.Team{}
-	query, _, err := goqu.From("team").
-		Select("id", "name", "created_ts").
+	query, _, err := teamsQuery().
+		Order(goqu.C("created_ts").Asc(), goqu.C("id").Asc()).
 		Limit(1).
 		ToSQL()
 	if err != nil {
@@ -49,3 +53,82 @@ func (q *Queries) GetTeam() (*types.Team, error) {
 	}
 	return team, nil
 }
+
+// GetTeamByID returns the team identified by the id provided.
+func (q *Queries) GetTeamByID(teamID string) (*types.Team, error) {
+	var team = &types.Team{}
+	query, _, err := teamsQuery().
+		Where(goqu.C("id").Eq(teamID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	err = q.db.QueryRowx(query).StructScan(team)
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// ResolveTeams returns the ids of the teams the users identified by the
+// subjects provided are mapped to, oldest first.
+func (q *Queries) ResolveTeams(subjectType string, subjects []string) ([]string, error) {
+	column, err := teamSubjectColumn(subjectType)
+	if err != nil || len(subjects) == 0 {
+		return nil, err
+	}
+	query, _, err := goqu.From("team").
+		Select("id").
+		Where(goqu.L("? && ?::varchar[]", goqu.C(column), pq.StringArray(subjects))).
+		Order(goqu.C("created_ts").Asc(), goqu.C("id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var teamIDs []string
+	if err := q.db.Select(&teamIDs, query); err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+// HasTeamMappings reports whether some team maps the users by subjects of the
+// type provided.
+func (q *Queries) HasTeamMappings(subjectType string) (bool, error) {
+	column, err := teamSubjectColumn(subjectType)
+	if err != nil {
+		return false, err
+	}
+	query := goqu.From("team").
+		Where(goqu.L("cardinality(?) > 0", goqu.C(column))).
+		Select(goqu.L("count(*)"))
+	count, err := q.GetCountQuery(query)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetTeamAppsCount returns the number of applications owned by the team
+// provided.
+func (q *Queries) GetTeamAppsCount(teamID string) (int, error) {
+	query := goqu.From("application").
+		Where(goqu.C("team_id").Eq(teamID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+func teamsQuery() *goqu.SelectDataset {
+	return goqu.From("team").
+		Select("id", "name", "created_ts", "oidc_claims", "github_orgs")
+}
+
+func teamSubjectColumn(subjectType string) (string, error) {
+	switch subjectType {
+	case types.TeamSubjectOIDCClaim:
+		return "oidc_claims", nil
+	case types.TeamSubjectGithubOrg:
+		return "github_orgs", nil
+	}
+	return "", fmt.Errorf("unknown team subject type %q", subjectType)
+}
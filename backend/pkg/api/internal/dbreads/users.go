@@ -23,10 +23,29 @@ func (q *Queries) GetUser(username string) (*types.User, error) {
 	return &user, nil
 }
 
+// GetUserByID returns the user identified by the id provided.
+func (q *Queries) GetUserByID(userID string) (*types.User, error) {
+	var user types.User
+	query, _, err := goqu.From("users").
+		Where(goqu.C("id").Eq(userID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	err = q.db.QueryRowx(query).StructScan(&user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// GetUsersInTeam returns the users of the team provided, by username.
 func (q *Queries) GetUsersInTeam(teamID string) ([]*types.User, error) {
 	var users []*types.User
 	query, _, err := goqu.From("users").
 		Where(goqu.C("team_id").Eq(teamID)).
+		Order(goqu.C("username").Asc()).
 		ToSQL()
 	if err != nil {
 		return nil, err
@@ -49,3 +68,11 @@ func (q *Queries) GetUsersInTeam(teamID string) ([]*types.User, error) {
 	}
 	return users, nil
 }
+
+// GetTeamAdminsCount returns the number of admin users of the team provided.
+func (q *Queries) GetTeamAdminsCount(teamID string) (int, error) {
+	query := goqu.From("users").
+		Where(goqu.C("team_id").Eq(teamID), goqu.C("role").Eq(types.UserRoleAdmin)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
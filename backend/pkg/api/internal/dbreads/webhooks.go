@@ -0,0 +1,186 @@
+package dbreads
+
+import (
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+// GetWebhook returns the webhook identified by the id provided.
+func (q *Queries) GetWebhook(webhookID string) (*types.Webhook, error) {
+	var webhook types.Webhook
+
+	query, _, err := goqu.From("webhook").
+		Where(goqu.C("id").Eq(webhookID)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	if err := q.db.QueryRowx(query).StructScan(&webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetWebhooksCount returns the total number of webhooks of the team provided.
+func (q *Queries) GetWebhooksCount(teamID string) (int, error) {
+	query := goqu.From("webhook").
+		Where(goqu.C("team_id").Eq(teamID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetWebhooks returns the webhooks of the team provided.
+func (q *Queries) GetWebhooks(teamID string, page, perPage uint64) ([]*types.Webhook, error) {
+	page, perPage = validatePaginationParams(page, perPage)
+	limit, offset := sqlPaginate(page, perPage)
+	query, _, err := goqu.From("webhook").
+		Where(goqu.C("team_id").Eq(teamID)).
+		Order(goqu.C("created_ts").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.getWebhooksFromQuery(query)
+}
+
+// GetEnabledWebhooks returns the enabled webhooks of all teams.
+func (q *Queries) GetEnabledWebhooks() ([]*types.Webhook, error) {
+	query, _, err := goqu.From("webhook").
+		Where(goqu.C("enabled").IsTrue()).
+		Order(goqu.C("created_ts").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return q.getWebhooksFromQuery(query)
+}
+
+func (q *Queries) getWebhooksFromQuery(query string) ([]*types.Webhook, error) {
+	var webhooks []*types.Webhook
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		webhook := types.Webhook{}
+		if err := rows.StructScan(&webhook); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// GetWebhookActivity returns the activity entries among the ones identified
+// by the ids provided that match the filters of the webhook provided and were
+// created since it was enabled, oldest first.
+func (q *Queries) GetWebhookActivity(webhook *types.Webhook, activityIDs []string) ([]*types.Activity, error) {
+	if len(activityIDs) == 0 {
+		return nil, nil
+	}
+	query := goqu.From(goqu.L(`
+		all_activity AS a
+		INNER JOIN application AS app ON (a.application_id = app.id)
+		LEFT JOIN groups AS g ON (a.group_id = g.id)
+		LEFT JOIN channel AS c ON (a.channel_id = c.id)
+	`)).
+		Select(
+			"a.id", "a.application_id", "a.group_id", "a.created_ts", "a.class",
+			"a.severity", "a.version", "a.instance_id",
+			goqu.I("app.name").As("application_name"), goqu.I("g.name").
+				As("group_name"), goqu.I("c.name").As("channel_name")).
+		Where(
+			goqu.I("a.id").In(activityIDs),
+			goqu.I("app.team_id").Eq(webhook.TeamID),
+			goqu.I("a.created_ts").Gte(webhook.EnabledTs.UTC()),
+			goqu.L(ignoreFakeInstanceCondition("a.instance_id")),
+		)
+
+	if webhook.ApplicationID.Valid {
+		query = query.Where(goqu.I("a.application_id").Eq(webhook.ApplicationID.String))
+	}
+	if webhook.GroupID.Valid {
+		query = query.Where(goqu.I("a.group_id").Eq(webhook.GroupID.String))
+	}
+	if len(webhook.Classes) > 0 {
+		query = query.Where(goqu.L("a.class = ANY(?::integer[])", webhook.Classes))
+	}
+	if len(webhook.Severities) > 0 {
+		query = query.Where(goqu.L("a.severity = ANY(?::integer[])", webhook.Severities))
+	}
+
+	sql, _, err := query.
+		Order(goqu.I("a.created_ts").Asc(), goqu.I("a.id").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var activityEntries []*types.Activity
+	rows, err := q.db.Queryx(sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		activityEntry := &types.Activity{}
+		if err := rows.StructScan(activityEntry); err != nil {
+			return nil, err
+		}
+		activityEntries = append(activityEntries, activityEntry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return activityEntries, nil
+}
+
+// GetWebhookDeliveriesCount returns the total number of deliveries logged for
+// the webhook provided.
+func (q *Queries) GetWebhookDeliveriesCount(webhookID string) (int, error) {
+	query := goqu.From("webhook_delivery").
+		Where(goqu.C("webhook_id").Eq(webhookID)).
+		Select(goqu.L("count(*)"))
+	return q.GetCountQuery(query)
+}
+
+// GetWebhookDeliveries returns the deliveries logged for the webhook provided,
+// newest first.
+func (q *Queries) GetWebhookDeliveries(webhookID string, page, perPage uint64) ([]*types.WebhookDelivery, error) {
+	page, perPage = validatePaginationParams(page, perPage)
+	limit, offset := sqlPaginate(page, perPage)
+	query, _, err := goqu.From("webhook_delivery").
+		Where(goqu.C("webhook_id").Eq(webhookID)).
+		Order(goqu.C("created_ts").Desc(), goqu.C("id").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*types.WebhookDelivery
+	rows, err := q.db.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		delivery := types.WebhookDelivery{}
+		if err := rows.StructScan(&delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -1,11 +1,16 @@
 package types
 
 import (
+	"errors"
 	"time"
 
 	"gopkg.in/guregu/null.v4"
 )
 
+// ErrInvalidActivityAcknowledgement indicates that the acknowledgement of
+// activity entries provided is not valid.
+var ErrInvalidActivityAcknowledgement = errors.New("nebraska: invalid activity acknowledgement")
+
 const (
 	ActivityPackageNotFound int = 1 + iota
 	ActivityRolloutStarted
@@ -13,6 +18,15 @@ const (
 	ActivityRolloutFailed
 	ActivityInstanceUpdateFailed
 	ActivityChannelPackageUpdated
+	ActivityPackageSignatureInvalid
+	ActivityChannelFloorAdded
+	ActivityChannelFloorRemoved
+	ActivityChannelPackageBlacklisted
+	ActivityInstanceDeleted
+	ActivityInstanceDuplicateSuspected
+	// ActivityInstancesDeleted records the deletion of instances in bulk,
+	// with the number of instances deleted as its version.
+	ActivityInstancesDeleted
 )
 
 const (
@@ -35,6 +49,11 @@ type Activity struct {
 	GroupName       null.String `db:"group_name" json:"group_name"`
 	ChannelName     null.String `db:"channel_name" json:"channel_name"`
 	InstanceID      null.String `db:"instance_id" json:"instance_id"`
+	AcknowledgedTs  null.Time   `db:"acknowledged_ts" json:"acknowledged_ts"`
+	AcknowledgedBy  null.String `db:"acknowledged_by" json:"acknowledged_by"`
+	AckComment      null.String `db:"ack_comment" json:"ack_comment"`
+	ResolvedTs      null.Time   `db:"resolved_ts" json:"resolved_ts"`
+	TeamID          string      `db:"team_id" json:"-"`
 }
 
 // ActivityQueryParams represents a helper structure used to pass a set of
@@ -50,4 +69,18 @@ type ActivityQueryParams struct {
 	End        time.Time `db:"end"`
 	Page       uint64    `json:"page"`
 	PerPage    uint64    `json:"perpage"`
+
+	// Unacknowledged only returns the entries not acknowledged yet.
+	Unacknowledged bool
+}
+
+// ActivityAcknowledgement represents the acknowledgement of some activity
+// entries by a user.
+type ActivityAcknowledgement struct {
+	ActivityIDs []string
+	Username    string
+	Comment     null.String
+	// Resolve also marks the entries as resolved, acknowledging them if they
+	// weren't yet.
+	Resolve bool
 }
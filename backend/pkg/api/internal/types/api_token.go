@@ -0,0 +1,61 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	// ErrInvalidAPIToken indicates that the API token provided is not valid.
+	ErrInvalidAPIToken = errors.New("nebraska: invalid api token")
+
+	// ErrAPITokenRejected indicates that the API token used to authenticate
+	// a request is unknown, revoked or expired.
+	ErrAPITokenRejected = errors.New("nebraska: api token rejected")
+)
+
+// Roles of the API tokens.
+const (
+	APITokenRoleViewer = "viewer"
+	APITokenRoleAdmin  = "admin"
+)
+
+// APITokenPrefix is the prefix of the API tokens, telling them apart from the
+// bearer tokens of the authenticators.
+const APITokenPrefix = "nbr_"
+
+// APIToken represents a long-lived token used by automation to call the API
+// on behalf of a team. The token itself is only known when it's created, its
+// SHA-256 hash is stored instead.
+type APIToken struct {
+	ID          string    `db:"id" json:"id"`
+	Name        string    `db:"name" json:"name"`
+	TeamID      string    `db:"team_id" json:"-"`
+	Role        string    `db:"role" json:"role"`
+	TokenHash   string    `db:"token_hash" json:"-"`
+	TokenPrefix string    `db:"token_prefix" json:"token_prefix"`
+	CreatedBy   string    `db:"created_by" json:"created_by"`
+	ExpiresTs   null.Time `db:"expires_ts" json:"expires_ts"`
+	LastUsedTs  null.Time `db:"last_used_ts" json:"last_used_ts"`
+	RevokedTs   null.Time `db:"revoked_ts" json:"revoked_ts"`
+	CreatedTs   time.Time `db:"created_ts" json:"created_ts"`
+}
+
+// Active reports whether the API token can be used at the time provided.
+func (t *APIToken) Active(now time.Time) bool {
+	if t.RevokedTs.Valid {
+		return false
+	}
+	return !t.ExpiresTs.Valid || now.Before(t.ExpiresTs.Time)
+}
+
+// HashAPIToken returns the hex encoded SHA-256 hash of the API token provided.
+// The tokens are random enough for their hash not to need a salt.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// Audit log actions.
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// Audit log target types.
+const (
+	AuditTargetApplication       = "application"
+	AuditTargetGroup             = "group"
+	AuditTargetChannel           = "channel"
+	AuditTargetPackage           = "package"
+	AuditTargetChannelFloor      = "channel_floor"
+	AuditTargetInstance          = "instance"
+	AuditTargetInstances         = "instances"
+	AuditTargetWebhook           = "webhook"
+	AuditTargetEmailNotification = "email_notification"
+	AuditTargetAPIToken          = "api_token"
+	AuditTargetRoleBinding       = "role_binding"
+	AuditTargetTeam              = "team"
+	AuditTargetUser              = "user"
+	AuditTargetSyncer            = "syncer"
+	AuditTargetActivity          = "activity"
+)
+
+// AuditEntry represents a configuration change made through the API, along
+// with the user who made it.
+type AuditEntry struct {
+	ID            string      `db:"id" json:"id"`
+	CreatedTs     time.Time   `db:"created_ts" json:"created_ts"`
+	TeamID        string      `db:"team_id" json:"-"`
+	Username      string      `db:"username" json:"username"`
+	Action        string      `db:"action" json:"action"`
+	TargetType    string      `db:"target_type" json:"target_type"`
+	TargetID      string      `db:"target_id" json:"target_id"`
+	ApplicationID null.String `db:"application_id" json:"application_id"`
+	Before        AuditState  `db:"before" json:"before"`
+	After         AuditState  `db:"after" json:"after"`
+}
+
+// AuditQueryParams represents a helper structure used to pass a set of
+// parameters when querying audit log entries.
+type AuditQueryParams struct {
+	AppID      string
+	Username   string
+	Action     string
+	TargetType string
+	TargetID   string
+	Start      time.Time
+	End        time.Time
+	Page       uint64
+	PerPage    uint64
+}
+
+// AuditState represents the JSON state of the target of an audit log entry.
+// A nil state is stored as NULL.
+type AuditState map[string]any
+
+// Value implements the driver.Valuer interface.
+func (s AuditState) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *AuditState) Scan(src interface{}) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("cannot convert %T to AuditState", src)
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// NewAuditStates returns the states stored in the audit log for a change of
+// its target from before to after, using their JSON representations. Nil
+// values, like the state before a creation, have a nil state. When both are
+// provided, only the fields that changed are kept.
+func NewAuditStates(before, after any) (AuditState, AuditState, error) {
+	beforeState, err := newAuditState(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterState, err := newAuditState(after)
+	if err != nil {
+		return nil, nil, err
+	}
+	if beforeState == nil || afterState == nil {
+		return beforeState, afterState, nil
+	}
+
+	beforeDiff, afterDiff := AuditState{}, AuditState{}
+	for key, value := range beforeState {
+		if afterValue, ok := afterState[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			beforeDiff[key] = value
+		}
+	}
+	for key, value := range afterState {
+		if beforeValue, ok := beforeState[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			afterDiff[key] = value
+		}
+	}
+	return beforeDiff, afterDiff, nil
+}
+
+func newAuditState(value any) (AuditState, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var state AuditState
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrInvalidEmailNotification indicates that the email notification provided
+// is not valid.
+var ErrInvalidEmailNotification = errors.New("nebraska: invalid email notification")
+
+// EmailNotification represents the email recipients notified of the rollouts
+// outcomes of an application. They can also get a daily digest of the
+// failures reported by the instances of the application.
+type EmailNotification struct {
+	ID              string         `db:"id" json:"id"`
+	ApplicationID   string         `db:"application_id" json:"application_id"`
+	Recipients      pq.StringArray `db:"recipients" json:"recipients"`
+	RolloutFinished bool           `db:"rollout_finished" json:"rollout_finished"`
+	RolloutFailed   bool           `db:"rollout_failed" json:"rollout_failed"`
+	DailyDigest     bool           `db:"daily_digest" json:"daily_digest"`
+	Enabled         bool           `db:"enabled" json:"enabled"`
+	LastDigestTs    time.Time      `db:"last_digest_ts" json:"last_digest_ts"`
+	CreatedTs       time.Time      `db:"created_ts" json:"created_ts"`
+}
+
+// InstanceFailures represents the failures reported by the instances of an
+// application with the same error code during some period.
+type InstanceFailures struct {
+	ErrorCode null.String `db:"error_code" json:"error_code"`
+	Events    int         `db:"events" json:"events"`
+	Instances int         `db:"instances" json:"instances"`
+}
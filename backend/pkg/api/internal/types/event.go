@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// InstanceEvent represents an Omaha event reported by an instance.
+type InstanceEvent struct {
+	ID              int         `db:"id" json:"id"`
+	CreatedTs       time.Time   `db:"created_ts" json:"created_ts"`
+	Type            int         `db:"type" json:"type"`
+	Result          int         `db:"result" json:"result"`
+	Description     string      `db:"description" json:"description"`
+	PreviousVersion null.String `db:"previous_version" json:"previous_version"`
+	ErrorCode       null.String `db:"error_code" json:"error_code"`
+}
+
+// InstanceCheckInGap represents a period of time in which an instance didn't
+// contact Nebraska. Ongoing gaps are the ones of instances that haven't
+// checked for updates since From.
+type InstanceCheckInGap struct {
+	From    time.Time `db:"from_ts" json:"from"`
+	To      time.Time `db:"to_ts" json:"to"`
+	Ongoing bool      `db:"-" json:"ongoing"`
+	Kind    string    `db:"kind" json:"kind"`
+}
+
+const (
+	// GapNoEventsReported identifies the gaps between the events and status
+	// changes of an instance. Instances checking for updates without anything
+	// to report don't leave any trace but their last check, so they are not
+	// necessarily down during such gaps.
+	GapNoEventsReported = "no_events_reported"
+	// GapNoCheckIn identifies the ongoing gap of an instance that hasn't
+	// checked for updates since its start.
+	GapNoCheckIn = "no_check_in"
+)
+
+// InstanceEventsTimeline represents the events reported by an instance in
+// the context of an application, along with the gaps between its check-ins.
+type InstanceEventsTimeline struct {
+	TotalEvents         uint64                `json:"total_events"`
+	Events              []*InstanceEvent      `json:"events"`
+	LastCheckForUpdates null.Time             `json:"last_check_for_updates"`
+	Gaps                []*InstanceCheckInGap `json:"gaps"`
+}
+
+// InstanceEventsQueryParams represents a helper structure used to pass a set
+// of parameters when querying the events of an instance.
+type InstanceEventsQueryParams struct {
+	InstanceID    string        `json:"instance_id"`
+	ApplicationID string        `json:"application_id"`
+	GroupID       string        `json:"group_id"`
+	Start         time.Time     `json:"start"`
+	End           time.Time     `json:"end"`
+	MinGap        time.Duration `json:"min_gap"`
+	Page          uint64        `json:"page"`
+	PerPage       uint64        `json:"perpage"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"time"
 
 	"gopkg.in/guregu/null.v4"
@@ -50,6 +51,43 @@ type Instance struct {
 	CreatedTs    time.Time           `db:"created_ts" json:"created_ts"`
 	Application  InstanceApplication `db:"application" json:"application,omitempty"`
 	Alias        string              `db:"alias" json:"alias,omitempty"`
+	Labels       Labels              `db:"labels" json:"labels,omitempty"`
+
+	PreviousIP           null.String `db:"previous_ip" json:"-"`
+	IPChangedTs          null.Time   `db:"ip_changed_ts" json:"-"`
+	DuplicateSuspectedTs null.Time   `db:"duplicate_suspected_ts" json:"duplicate_suspected_ts"`
+	SuspectedDuplicate   bool        `db:"-" json:"suspected_duplicate"`
+}
+
+// DuplicateSuspicionExpiry is how long an instance remains a suspected
+// duplicate after its IP or version last flapped.
+const DuplicateSuspicionExpiry = 24 * time.Hour
+
+// IsSuspectedDuplicate reports whether an instance whose IP or version last
+// flapped at the time provided is still a suspected duplicate.
+func IsSuspectedDuplicate(suspectedTs null.Time) bool {
+	return suspectedTs.Valid && time.Since(suspectedTs.Time) < DuplicateSuspicionExpiry
+}
+
+// InstanceExport represents an instance running an application, as exported
+// to external inventories.
+type InstanceExport struct {
+	ID                  string      `db:"id" json:"id"`
+	IP                  string      `db:"ip" json:"ip"`
+	Alias               string      `db:"alias" json:"alias"`
+	OEM                 string      `db:"oem" json:"oem"`
+	AlephVersion        string      `db:"aleph_version" json:"aleph_version"`
+	CreatedTs           time.Time   `db:"created_ts" json:"created_ts"`
+	ApplicationID       string      `db:"application_id" json:"application_id"`
+	GroupID             null.String `db:"group_id" json:"group_id"`
+	GroupName           null.String `db:"group_name" json:"group_name"`
+	Version             string      `db:"version" json:"version"`
+	Status              null.Int    `db:"status" json:"status"`
+	LastCheckForUpdates time.Time   `db:"last_check_for_updates" json:"last_check_for_updates"`
+	LastUpdateGrantedTs null.Time   `db:"last_update_granted_ts" json:"last_update_granted_ts"`
+	LastUpdateVersion   null.String `db:"last_update_version" json:"last_update_version"`
+	UpdateInProgress    bool        `db:"update_in_progress" json:"update_in_progress"`
+	Labels              Labels      `db:"labels" json:"labels"`
 }
 
 type InstancesWithTotal struct {
@@ -64,6 +102,7 @@ type InstanceApplication struct {
 	InstanceID          string      `db:"instance_id" json:"instance_id,omitempty"`
 	ApplicationID       string      `db:"application_id" json:"application_id"`
 	GroupID             null.String `db:"group_id" json:"group_id"`
+	RequestedGroupID    null.String `db:"requested_group_id" json:"requested_group_id"`
 	Version             string      `db:"version" json:"version"`
 	CreatedTs           time.Time   `db:"created_ts" json:"created_ts"`
 	Status              null.Int    `db:"status" json:"status"`
@@ -86,6 +125,14 @@ type InstanceStatusHistoryEntry struct {
 	ErrorCode     null.String `db:"error_code" json:"error_code"`
 }
 
+// ErrInvalidInstancesFilter indicates that one of the structured filters
+// provided to query instances is not valid.
+var ErrInvalidInstancesFilter = errors.New("nebraska: invalid instances filter")
+
+// ErrInstanceArchMismatch indicates that an instance can't be assigned to a
+// group whose channel serves packages of another architecture.
+var ErrInstanceArchMismatch = errors.New("nebraska: instance architecture doesn't match the group's channel")
+
 // InstancesQueryParams represents a helper structure used to pass a set of
 // parameters when querying instances.
 type InstancesQueryParams struct {
@@ -99,10 +146,30 @@ type InstancesQueryParams struct {
 	SortOrder     string `json:"sort_order"`
 	SearchFilter  string `json:"search_filter"`
 	SearchValue   string `json:"search_value"`
+
+	// Structured filters, combined with AND logic. Version ranges are made
+	// of space separated constraints such as ">=3510.0.0 <3600.0.0".
+	IPCIDR            string    `json:"ip_cidr"`
+	OEM               string    `json:"oem"`
+	AlephVersionRange string    `json:"aleph_version_range"`
+	VersionRange      string    `json:"version_range"`
+	LastCheckAfter    time.Time `json:"last_check_after"`
+	LastCheckBefore   time.Time `json:"last_check_before"`
+	Labels            string    `json:"labels"`
+}
+
+// InstanceVersionChange represents the last change of the version an instance
+// runs for an application, used to detect instances flapping between two
+// versions.
+type InstanceVersionChange struct {
+	PreviousVersion   null.String `db:"previous_version"`
+	VersionChangedTs  null.Time   `db:"version_changed_ts"`
+	VersionRevertedTs null.Time   `db:"version_reverted_ts"`
 }
 
 type InstanceStats struct {
 	Timestamp   time.Time `db:"timestamp" json:"timestamp"`
+	ChannelID   string    `db:"channel_id" json:"channel_id"`
 	ChannelName string    `db:"channel_name" json:"channel_name"`
 	Arch        string    `db:"arch" json:"arch"`
 	Version     string    `db:"version" json:"version"`
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// InstanceStatsResolution represents the resolution of the instance stats
+// snapshots. Hourly snapshots are rolled up into daily and weekly ones.
+type InstanceStatsResolution string
+
+const (
+	InstanceStatsHourly InstanceStatsResolution = "hourly"
+	InstanceStatsDaily  InstanceStatsResolution = "daily"
+	InstanceStatsWeekly InstanceStatsResolution = "weekly"
+)
+
+// ErrInvalidInstanceStatsResolution indicates that the resolution provided to
+// query the instance stats is not valid.
+var ErrInvalidInstanceStatsResolution = errors.New("nebraska: invalid instance stats resolution")
+
+// Table returns the table holding the instance stats snapshots of the
+// resolution.
+func (r InstanceStatsResolution) Table() (string, error) {
+	switch r {
+	case InstanceStatsHourly:
+		return "instance_stats", nil
+	case InstanceStatsDaily:
+		return "instance_stats_daily", nil
+	case InstanceStatsWeekly:
+		return "instance_stats_weekly", nil
+	}
+	return "", ErrInvalidInstanceStatsResolution
+}
+
+// VersionStatsQueryParams represents a helper structure used to pass a set of
+// parameters when querying the instance stats timeline of a channel. When no
+// resolution is provided, it is picked from the time range.
+type VersionStatsQueryParams struct {
+	ChannelID  string                  `json:"channel_id"`
+	TeamID     string                  `json:"team_id"`
+	Start      time.Time               `json:"start"`
+	End        time.Time               `json:"end"`
+	Resolution InstanceStatsResolution `json:"resolution"`
+}
+
+// VersionStatsTimeline represents the number of instances running each
+// version of a channel over time, at the resolution used to compute it.
+type VersionStatsTimeline struct {
+	Resolution InstanceStatsResolution       `json:"resolution"`
+	Timeline   map[time.Time]VersionCountMap `json:"timeline"`
+}
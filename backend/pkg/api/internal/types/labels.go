@@ -0,0 +1,181 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxLabels is the maximum number of labels an instance can have.
+	MaxLabels = 32
+
+	// MaxLabelValueLength is the maximum length of the value of a label.
+	MaxLabelValueLength = 255
+)
+
+// ErrInvalidLabels indicates that the labels provided are not valid.
+var ErrInvalidLabels = errors.New("nebraska: invalid labels")
+
+// labelKeyRegexp matches the valid label keys, like "env" or "example.com/rack".
+var labelKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]{0,62})$`)
+
+// Labels represents free-form key/value labels attached to an instance, like
+// env=prod or rack=r12.
+type Labels map[string]string
+
+// Validate checks that the labels have valid keys and values.
+func (l Labels) Validate() error {
+	if len(l) > MaxLabels {
+		return fmt.Errorf("%w: at most %d labels are allowed", ErrInvalidLabels, MaxLabels)
+	}
+	for key, value := range l {
+		if !labelKeyRegexp.MatchString(key) {
+			return fmt.Errorf("%w: invalid key %q", ErrInvalidLabels, key)
+		}
+		if len(value) > MaxLabelValueLength {
+			return fmt.Errorf("%w: value of %q is longer than %d characters", ErrInvalidLabels, key, MaxLabelValueLength)
+		}
+	}
+	return nil
+}
+
+// String returns the labels as comma separated key=value pairs, sorted by key.
+func (l Labels) String() string {
+	keys := make([]string, 0, len(l))
+	for key := range l {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+l[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseLabels parses labels formatted as comma separated key=value pairs, like
+// "env=prod,rack=r12".
+func ParseLabels(s string) (Labels, error) {
+	labels := make(Labels)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: expected key=value, got %q", ErrInvalidLabels, pair)
+		}
+		labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := labels.Validate(); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// ParseReportedLabels parses the labels reported by the instances, formatted
+// like for ParseLabels. Instead of failing, malformed pairs are skipped and the
+// labels are sanitized, see Sanitize. The problems found are returned so that
+// they can be logged.
+func ParseReportedLabels(s string) (Labels, []string) {
+	labels := make(Labels)
+	var problems []string
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			problems = append(problems, fmt.Sprintf("expected key=value, got %q", pair))
+			continue
+		}
+		labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	labels, sanitizeProblems := labels.Sanitize()
+	return labels, append(problems, sanitizeProblems...)
+}
+
+// Sanitize returns the labels with the invalid keys dropped, the values too
+// long truncated, and only the first MaxLabels keys in alphabetical order
+// kept, along with the problems found.
+func (l Labels) Sanitize() (Labels, []string) {
+	keys := make([]string, 0, len(l))
+	for key := range l {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sanitized := make(Labels, len(l))
+	var problems []string
+	for _, key := range keys {
+		value := l[key]
+		if !labelKeyRegexp.MatchString(key) {
+			problems = append(problems, fmt.Sprintf("invalid key %q dropped", key))
+			continue
+		}
+		if len(sanitized) == MaxLabels {
+			problems = append(problems, fmt.Sprintf("label %q dropped, at most %d labels are allowed", key, MaxLabels))
+			continue
+		}
+		if len(value) > MaxLabelValueLength {
+			problems = append(problems, fmt.Sprintf("value of %q truncated to %d characters", key, MaxLabelValueLength))
+			value = truncateLabelValue(value)
+		}
+		sanitized[key] = value
+	}
+	return sanitized, problems
+}
+
+// truncateLabelValue truncates the value provided to MaxLabelValueLength
+// bytes, without splitting a multi-byte character.
+func truncateLabelValue(value string) string {
+	n := MaxLabelValueLength
+	for n > 0 && !utf8.RuneStart(value[n]) {
+		n--
+	}
+	return value[:n]
+}
+
+// Value implements the driver.Valuer interface.
+func (l Labels) Value() (driver.Value, error) {
+	if l == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(map[string]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *Labels) Scan(src interface{}) error {
+	var data []byte
+	switch src := src.(type) {
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	case nil:
+		*l = Labels{}
+		return nil
+	default:
+		return fmt.Errorf("cannot convert %T to Labels", src)
+	}
+
+	labels := make(Labels)
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return err
+	}
+	*l = labels
+	return nil
+}
@@ -11,3 +11,10 @@ type FailedUpdatesMetric struct {
 	ApplicationName string `db:"app_name" json:"app_name"`
 	FailureCount    int    `db:"fail_count" json:"fail_count"`
 }
+
+type AppInstancesPerLabelMetric struct {
+	ApplicationName string `db:"app_name" json:"app_name"`
+	LabelKey        string `db:"label_key" json:"label_key"`
+	LabelValue      string `db:"label_value" json:"label_value"`
+	InstancesCount  int    `db:"instances_count" json:"instances_count"`
+}
@@ -70,12 +70,22 @@ type Package struct {
 	FlatcarAction     *FlatcarAction `db:"flatcar_action" json:"flatcar_action"`
 	Arch              Arch           `db:"arch" json:"arch"`
 	ExtraFiles        []File         `db:"extra_files" json:"extra_files"`
+	UpstreamURL       null.String    `db:"upstream_url" json:"upstream_url"`
 
 	// Floor metadata (populated when querying floor packages)
 	IsFloor     bool        `db:"is_floor" json:"is_floor,omitempty"`
 	FloorReason null.String `db:"floor_reason" json:"floor_reason"`
 }
 
+const (
+	// FloorSourceManual identifies the floors set by users, through the API
+	// or when importing bundles.
+	FloorSourceManual = "manual"
+	// FloorSourceSyncer identifies the floors set by the syncer, which
+	// removes them once they aren't listed upstream anymore.
+	FloorSourceSyncer = "syncer"
+)
+
 // ChannelPackageFloor represents a floor package for a specific channel
 type ChannelPackageFloor struct {
 	ChannelID   string      `db:"channel_id" json:"channel_id"`
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrInvalidRoleBinding indicates that the role binding provided is not valid.
+var ErrInvalidRoleBinding = errors.New("nebraska: invalid role binding")
+
+// Roles granted on the applications, from the least to the most privileged.
+// Operators can view an application and toggle the policies of its groups,
+// admins can change anything.
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// Types of the subjects of the role bindings.
+const (
+	RoleBindingSubjectOIDCRole   = "oidc_role"
+	RoleBindingSubjectGithubTeam = "github_team"
+)
+
+// RoleBinding grants a role on an application, or only on one of its groups
+// or channels, to the users identified by the subject: the users having an
+// OIDC role, or belonging to a GitHub team ("org/team") or organization.
+type RoleBinding struct {
+	ID            string      `db:"id" json:"id"`
+	ApplicationID string      `db:"application_id" json:"application_id"`
+	GroupID       null.String `db:"group_id" json:"group_id"`
+	ChannelID     null.String `db:"channel_id" json:"channel_id"`
+	SubjectType   string      `db:"subject_type" json:"subject_type"`
+	Subject       string      `db:"subject" json:"subject"`
+	Role          string      `db:"role" json:"role"`
+	CreatedBy     string      `db:"created_by" json:"created_by"`
+	CreatedTs     time.Time   `db:"created_ts" json:"created_ts"`
+}
+
+// Applies reports whether the role binding applies to the group and channel
+// provided, empty meaning the application as a whole.
+func (b *RoleBinding) Applies(groupID, channelID string) bool {
+	if b.GroupID.Valid && b.GroupID.String != groupID {
+		return false
+	}
+	if b.ChannelID.Valid && b.ChannelID.String != channelID {
+		return false
+	}
+	return true
+}
+
+var roleRanks = map[string]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// RoleIncludes reports whether the role provided grants at least the
+// permissions of the required one. Unknown roles grant nothing.
+func RoleIncludes(role, required string) bool {
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[required]
+}
+
+// ValidRole reports whether the role provided is a known one.
+func ValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
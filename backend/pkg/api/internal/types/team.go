@@ -1,10 +1,30 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ErrInvalidTeam indicates that the team provided is not valid.
+var ErrInvalidTeam = errors.New("nebraska: invalid team")
+
+// Types of the subjects mapping the users to the teams.
+const (
+	TeamSubjectOIDCClaim = "oidc_claim"
+	TeamSubjectGithubOrg = "github_org"
+)
 
 // Team represents a Nebraska team.
 type Team struct {
-	ID        string    `db:"id"`
-	Name      string    `db:"name"`
-	CreatedTs time.Time `db:"created_ts"`
+	ID        string    `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	CreatedTs time.Time `db:"created_ts" json:"created_ts"`
+	// OIDCClaims are the values of the OIDC teams claim mapping the users
+	// to the team.
+	OIDCClaims pq.StringArray `db:"oidc_claims" json:"oidc_claims"`
+	// GithubOrgs are the GitHub organizations whose members are mapped to
+	// the team.
+	GithubOrgs pq.StringArray `db:"github_orgs" json:"github_orgs"`
 }
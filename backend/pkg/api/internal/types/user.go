@@ -1,19 +1,95 @@
 package types
 
 import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
-// ErrUpdatingPassword indicates that something went wrong while updating
-// the user's password.
-var ErrUpdatingPassword = errors.New("nebraska: error updating password")
+var (
+	// ErrUpdatingPassword indicates that something went wrong while updating
+	// the user's password.
+	ErrUpdatingPassword = errors.New("nebraska: error updating password")
+
+	// ErrInvalidUser indicates that the user provided is not valid.
+	ErrInvalidUser = errors.New("nebraska: invalid user")
+
+	// ErrInvalidCredentials indicates that the username or the password
+	// used to log in are wrong.
+	ErrInvalidCredentials = errors.New("nebraska: invalid credentials")
+)
+
+// Roles of the local users on the applications of their team.
+const (
+	UserRoleViewer = "viewer"
+	UserRoleAdmin  = "admin"
+)
+
+const (
+	// MinUserPasswordLength is the minimum length of the passwords of the
+	// local users.
+	MinUserPasswordLength = 8
+
+	// maxUserPasswordLength is the maximum length of the passwords of the
+	// local users, as bcrypt ignores what follows.
+	maxUserPasswordLength = 72
+
+	// legacyUserSecretRealm is the realm of the md5 digests the secrets of
+	// the users used to be.
+	legacyUserSecretRealm = "nebraska"
+)
+
+var validUsername = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._@-]{0,24}$`)
 
 // User represents a Nebraska user.
 type User struct {
-	ID        string    `db:"id" json:"id"`             // UUID v4 unique, created automatically
-	Username  string    `db:"username" json:"username"` // unique username
-	Secret    string    `db:"secret" json:"secret"`     // md5 hash from (username:realm:password)
-	CreatedTs time.Time `db:"created_ts" json:"-"`      // Created automatically
-	TeamID    string    `db:"team_id" json:"team_id"`   // User can be in single team
+	ID        string    `db:"id" json:"id"`                 // UUID v4 unique, created automatically
+	Username  string    `db:"username" json:"username"`     // unique username
+	Secret    string    `db:"secret" json:"-"`              // bcrypt hash of the password, see HashUserPassword
+	Role      string    `db:"role" json:"role"`             // viewer or admin
+	CreatedTs time.Time `db:"created_ts" json:"created_ts"` // Created automatically
+	TeamID    string    `db:"team_id" json:"team_id"`       // User can be in single team
+}
+
+// ValidateUser checks the username and the role of the user provided.
+func ValidateUser(user *User) error {
+	if !validUsername.MatchString(user.Username) {
+		return fmt.Errorf("%w: the username must be 1 to 25 letters, digits or . _ @ - characters", ErrInvalidUser)
+	}
+	if user.Role != UserRoleViewer && user.Role != UserRoleAdmin {
+		return fmt.Errorf("%w: the role must be viewer or admin", ErrInvalidUser)
+	}
+	return nil
+}
+
+// HashUserPassword returns the bcrypt hash of the password provided, to be
+// stored as the secret of a user.
+func HashUserPassword(password string) (string, error) {
+	if len(password) < MinUserPasswordLength || len(password) > maxUserPasswordLength {
+		return "", fmt.Errorf("%w: the password must be %d to %d bytes long", ErrInvalidUser, MinUserPasswordLength, maxUserPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether the password provided is the one of the
+// user, and whether its secret is a legacy md5 digest to be replaced by a
+// bcrypt hash.
+func (u *User) CheckPassword(password string) (ok, legacy bool) {
+	if strings.HasPrefix(u.Secret, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(u.Secret), []byte(password)) == nil, false
+	}
+	sum := md5.Sum([]byte(u.Username + ":" + legacyUserSecretRealm + ":" + password))
+	digest := hex.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(digest), []byte(u.Secret)) == 1, true
 }
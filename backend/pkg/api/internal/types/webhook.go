@@ -0,0 +1,106 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrInvalidWebhook indicates that the webhook provided is not valid.
+var ErrInvalidWebhook = errors.New("nebraska: invalid webhook")
+
+// Webhook delivery statuses.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookEventActivity is the event sent to the webhooks when a new activity
+// entry matches their filters.
+const WebhookEventActivity = "activity"
+
+// Webhook represents a subscription to the activity entries of a team. The
+// activity entries matching its filters are posted to its url, signed with
+// its secret. Empty filters match all activity entries.
+type Webhook struct {
+	ID            string        `db:"id" json:"id"`
+	Name          string        `db:"name" json:"name"`
+	URL           string        `db:"url" json:"url"`
+	Secret        string        `db:"secret" json:"-"`
+	TeamID        string        `db:"team_id" json:"-"`
+	ApplicationID null.String   `db:"application_id" json:"application_id"`
+	GroupID       null.String   `db:"group_id" json:"group_id"`
+	Classes       pq.Int64Array `db:"classes" json:"classes"`
+	Severities    pq.Int64Array `db:"severities" json:"severities"`
+	Enabled       bool          `db:"enabled" json:"enabled"`
+	EnabledTs     time.Time     `db:"enabled_ts" json:"-"`
+	CreatedTs     time.Time     `db:"created_ts" json:"created_ts"`
+}
+
+// WebhookDelivery represents a delivery of an activity entry to a webhook.
+// Pending deliveries are retried until they succeed or run out of attempts.
+type WebhookDelivery struct {
+	ID             string         `db:"id" json:"id"`
+	WebhookID      string         `db:"webhook_id" json:"webhook_id"`
+	ActivityID     string         `db:"activity_id" json:"activity_id"`
+	Payload        WebhookPayload `db:"payload" json:"payload"`
+	Status         string         `db:"status" json:"status"`
+	Attempts       int            `db:"attempts" json:"attempts"`
+	NextAttemptTs  time.Time      `db:"next_attempt_ts" json:"next_attempt_ts"`
+	LastAttemptTs  null.Time      `db:"last_attempt_ts" json:"last_attempt_ts"`
+	ResponseStatus null.Int       `db:"response_status" json:"response_status"`
+	LastError      null.String    `db:"last_error" json:"last_error"`
+	CreatedTs      time.Time      `db:"created_ts" json:"created_ts"`
+
+	// URL and Secret are the ones of the webhook when the delivery was
+	// claimed for an attempt.
+	URL    string `db:"url" json:"-"`
+	Secret string `db:"secret" json:"-"`
+}
+
+// WebhookPayload represents the body posted to a webhook.
+type WebhookPayload struct {
+	Event     string    `json:"event"`
+	WebhookID string    `json:"webhook_id"`
+	Activity  *Activity `json:"activity"`
+}
+
+// Value implements the driver.Valuer interface.
+func (p WebhookPayload) Value() (driver.Value, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *WebhookPayload) Scan(src interface{}) error {
+	var data []byte
+	switch src := src.(type) {
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("cannot convert %T to WebhookPayload", src)
+	}
+
+	return json.Unmarshal(data, p)
+}
+
+// WebhookDeliveryAttempt represents the outcome of an attempt to deliver an
+// activity entry to a webhook.
+type WebhookDeliveryAttempt struct {
+	Status         string
+	ResponseStatus null.Int
+	Error          null.String
+	AttemptTs      time.Time
+	NextAttemptTs  time.Time
+}
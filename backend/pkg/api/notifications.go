@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Channels the database notifies of the changes streamed to the clients.
+const (
+	// NotificationChannelActivity is notified of the new activity entries,
+	// with their id, team, application and group as payload.
+	NotificationChannelActivity = "nebraska_activity"
+
+	// NotificationChannelInstanceStatus is notified when an instance changes
+	// its status or group, with the application and group as payload.
+	NotificationChannelInstanceStatus = "nebraska_instance_status"
+)
+
+// ActivityNotification represents the payload of the notifications of the
+// activity channel.
+type ActivityNotification struct {
+	ID            string `json:"id"`
+	TeamID        string `json:"team_id"`
+	ApplicationID string `json:"application_id"`
+	GroupID       string `json:"group_id"`
+}
+
+// InstanceStatusNotification represents the payload of the notifications of
+// the instance status channel.
+type InstanceStatusNotification struct {
+	ApplicationID string `json:"application_id"`
+	GroupID       string `json:"group_id"`
+}
+
+// ListenNotifications listens to the database notifications of the channels
+// provided on a dedicated connection, calling notify for each of them until
+// the context is done or the connection fails. It returns nil once the context
+// is done.
+func (api *API) ListenNotifications(ctx context.Context, channels []string, notify func(channel, payload string)) error {
+	conn, err := pgx.Connect(ctx, api.dbURL)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		notify(notification.Channel, notification.Payload)
+	}
+}
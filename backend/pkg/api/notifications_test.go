@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenNotifications(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notifications := make(chan string, 10)
+	done := make(chan error)
+	go func() {
+		done <- a.ListenNotifications(ctx, []string{NotificationChannelActivity}, func(channel, payload string) {
+			assert.Equal(t, NotificationChannelActivity, channel)
+			notifications <- payload
+		})
+	}()
+
+	// Activity entries are added until the listener is ready and notified.
+	var payload string
+	timeout := time.After(10 * time.Second)
+	for payload == "" {
+		require.NoError(t, a.newGroupActivityEntry(activityRolloutStarted, activityInfo, "1.0.0", tApp.ID, tGroup.ID))
+		select {
+		case payload = <-notifications:
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("timed out waiting for a notification")
+		}
+	}
+
+	var notification ActivityNotification
+	require.NoError(t, json.Unmarshal([]byte(payload), &notification))
+	activityEntry, err := a.GetActivityEntry(notification.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tTeam.ID, activityEntry.TeamID)
+	assert.Equal(t, tGroup.ID, activityEntry.GroupID.String)
+	assert.Equal(t, activityRolloutStarted, activityEntry.Class)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+)
+
+// partitionsAhead is the number of months ahead for which partitions of the
+// partitioned tables are kept created.
+const partitionsAhead = 3
+
+// partitionedTables lists the tables range partitioned by created_ts in
+// monthly partitions.
+var partitionedTables = []RetentionTable{
+	RetentionTableEvent,
+	RetentionTableInstanceStatusHistory,
+}
+
+// defaultPartitionSuffix is the suffix of the name of the default partition
+// of the partitioned tables, holding the rows outside of the monthly ones.
+const defaultPartitionSuffix = "_default"
+
+// partitionNameRegexp matches the names of the monthly partitions, capturing
+// the year and month they hold.
+var partitionNameRegexp = regexp.MustCompile(`_p(\d{4})(\d{2})$`)
+
+// CreateFuturePartitions makes sure the partitions of the partitioned tables
+// exist for the current month and the following ones, so that new rows always
+// have a partition to go to. The rows that ended up in the default partition
+// up to then are moved to their monthly partition, so that they are pruned
+// with it.
+func (api *API) CreateFuturePartitions() error {
+	for _, table := range partitionedTables {
+		_, err := api.db.Exec(
+			fmt.Sprintf("SELECT create_monthly_partitions($1, least(current_timestamp, (SELECT min(created_ts) FROM %s)), current_timestamp + interval '%d months')",
+				pq.QuoteIdentifier(string(table)+defaultPartitionSuffix), partitionsAhead),
+			string(table),
+		)
+		if err != nil {
+			return fmt.Errorf("creating partitions of %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// DropPartitionsBefore drops the partitions of the table provided that only
+// hold rows older than the cutoff, returning the number of rows removed. It
+// does nothing for tables that aren't partitioned.
+func (api *API) DropPartitionsBefore(table RetentionTable, cutoff time.Time) (int64, error) {
+	partitions, err := api.getPartitions(table)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, partition := range partitions {
+		matches := partitionNameRegexp.FindStringSubmatch(partition)
+		if matches == nil {
+			continue
+		}
+		monthStart, err := time.Parse("200601", matches[1]+matches[2])
+		if err != nil {
+			continue
+		}
+		if monthStart.AddDate(0, 1, 0).After(cutoff) {
+			continue
+		}
+
+		rows, err := api.dropPartition(partition)
+		if err != nil {
+			return total, fmt.Errorf("dropping partition %s: %w", partition, err)
+		}
+		total += rows
+	}
+
+	return total, nil
+}
+
+// getPartitions returns the names of the partitions of the table provided.
+func (api *API) getPartitions(table RetentionTable) ([]string, error) {
+	query, _, err := goqu.From(goqu.T("pg_inherits").As("i")).
+		Join(goqu.T("pg_class").As("c"), goqu.On(goqu.I("c.oid").Eq(goqu.I("i.inhrelid")))).
+		Join(goqu.T("pg_class").As("p"), goqu.On(goqu.I("p.oid").Eq(goqu.I("i.inhparent")))).
+		Select("c.relname").
+		Where(goqu.I("p.relname").Eq(string(table))).
+		Order(goqu.I("c.relname").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var partitions []string
+	if err := api.db.Select(&partitions, query); err != nil {
+		return nil, err
+	}
+	return partitions, nil
+}
+
+// dropPartition drops the partition provided, returning the number of rows
+// it held.
+func (api *API) dropPartition(partition string) (int64, error) {
+	tx, err := api.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("dropPartition - could not roll back")
+		}
+	}()
+
+	query, _, err := goqu.From(partition).Select(goqu.COUNT("*")).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var rows int64
+	if err := tx.QueryRow(query).Scan(&rows); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec("DROP TABLE " + pq.QuoteIdentifier(partition)); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
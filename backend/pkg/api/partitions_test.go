@@ -0,0 +1,108 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestCreateFuturePartitions(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	require.NoError(t, a.CreateFuturePartitions())
+	require.NoError(t, a.CreateFuturePartitions())
+
+	now := time.Now().UTC()
+	for _, table := range partitionedTables {
+		partitions, err := a.getPartitions(table)
+		require.NoError(t, err)
+		for i := 0; i <= partitionsAhead; i++ {
+			month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, i, 0)
+			assert.Contains(t, partitions, string(table)+"_p"+month.Format("200601"))
+		}
+	}
+
+	partitions, err := a.getPartitions(RetentionTableInstanceStats)
+	require.NoError(t, err)
+	assert.Empty(t, partitions)
+}
+
+func TestDefaultPartition(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tInstance, err := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.1"}, NewInstanceApplication(tApp.ID, tGroup.ID, "1.0.0"))
+	require.NoError(t, err)
+
+	// rows outside of the monthly partitions go to the default one
+	oldTs := time.Date(2019, time.June, 10, 0, 0, 0, 0, time.UTC)
+	futureTs := time.Now().UTC().AddDate(0, partitionsAhead+2, 0)
+	_, err = a.db.Exec("INSERT INTO event (created_ts, instance_id, application_id, event_type_id) VALUES ($1, $3, $4, (SELECT id FROM event_type LIMIT 1)), ($2, $3, $4, (SELECT id FROM event_type LIMIT 1))", oldTs, futureTs, tInstance.ID, tApp.ID)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, a.db.Get(&count, "SELECT count(*) FROM event_default"))
+	assert.Equal(t, 2, count)
+
+	// the old row is moved to its monthly partition, which can then be dropped
+	require.NoError(t, a.CreateFuturePartitions())
+	require.NoError(t, a.db.Get(&count, "SELECT count(*) FROM event_default"))
+	assert.Equal(t, 1, count)
+	require.NoError(t, a.db.Get(&count, "SELECT count(*) FROM event_p201906"))
+	assert.Equal(t, 1, count)
+
+	dropped, err := a.DropPartitionsBefore(RetentionTableEvent, time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), dropped)
+	partitions, err := a.getPartitions(RetentionTableEvent)
+	require.NoError(t, err)
+	assert.Contains(t, partitions, "event_default")
+}
+
+func TestDropPartitionsBefore(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tInstance, err := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.1"}, NewInstanceApplication(tApp.ID, tGroup.ID, "1.0.0"))
+	require.NoError(t, err)
+
+	oldTs := time.Date(2020, time.March, 10, 0, 0, 0, 0, time.UTC)
+	_, err = a.db.Exec("SELECT create_monthly_partitions('event', $1, $2)", oldTs, oldTs.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	_, err = a.db.Exec("INSERT INTO event (created_ts, instance_id, application_id, event_type_id) VALUES ($1, $2, $3, (SELECT id FROM event_type LIMIT 1)), (now(), $2, $3, (SELECT id FROM event_type LIMIT 1))", oldTs, tInstance.ID, tApp.ID)
+	require.NoError(t, err)
+
+	// The cutoff falls in the middle of the month, so the partition is kept.
+	dropped, err := a.DropPartitionsBefore(RetentionTableEvent, oldTs)
+	require.NoError(t, err)
+	assert.Zero(t, dropped)
+
+	dropped, err = a.DropPartitionsBefore(RetentionTableEvent, time.Now().AddDate(0, -1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), dropped)
+
+	partitions, err := a.getPartitions(RetentionTableEvent)
+	require.NoError(t, err)
+	assert.NotContains(t, partitions, "event_p202003")
+
+	_, err = a.GetEvent(tInstance.ID, tApp.ID, time.Now())
+	assert.NoError(t, err)
+
+	dropped, err = a.DropPartitionsBefore(RetentionTableInstanceStats, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, dropped)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// RetentionTable represents a table whose old rows can be pruned.
+type RetentionTable string
+
+// Tables that can be pruned, in the order they should be pruned.
+const (
+	RetentionTableInstanceApplication   RetentionTable = "instance_application"
+	RetentionTableInstance              RetentionTable = "instance"
+	RetentionTableEvent                 RetentionTable = "event"
+	RetentionTableInstanceStatusHistory RetentionTable = "instance_status_history"
+	RetentionTableInstanceStats         RetentionTable = "instance_stats"
+	RetentionTableInstanceStatsDaily    RetentionTable = "instance_stats_daily"
+	RetentionTableInstanceStatsWeekly   RetentionTable = "instance_stats_weekly"
+	RetentionTableWebhookDelivery       RetentionTable = "webhook_delivery"
+)
+
+// RetentionTables lists all the tables that can be pruned, in the order
+// they should be pruned.
+var RetentionTables = []RetentionTable{
+	RetentionTableInstanceApplication,
+	RetentionTableInstance,
+	RetentionTableEvent,
+	RetentionTableInstanceStatusHistory,
+	RetentionTableInstanceStats,
+	RetentionTableInstanceStatsDaily,
+	RetentionTableInstanceStatsWeekly,
+	RetentionTableWebhookDelivery,
+}
+
+// prunableCondition returns the condition matching the rows of the table
+// provided that are older than the cutoff. Instances are prunable once none
+// of their applications has checked for updates since the cutoff.
+func prunableCondition(table RetentionTable, cutoff time.Time) (exp.Expression, error) {
+	switch table {
+	case RetentionTableInstanceApplication:
+		return goqu.C("last_check_for_updates").Lt(cutoff), nil
+	case RetentionTableInstance:
+		return goqu.And(
+			goqu.C("created_ts").Lt(cutoff),
+			goqu.L(`NOT EXISTS (SELECT 1 FROM instance_application ia WHERE ia.instance_id = instance.id AND ia.last_check_for_updates >= ?)`, cutoff),
+		), nil
+	case RetentionTableEvent, RetentionTableInstanceStatusHistory:
+		return goqu.C("created_ts").Lt(cutoff), nil
+	case RetentionTableInstanceStats, RetentionTableInstanceStatsDaily, RetentionTableInstanceStatsWeekly:
+		return goqu.C("timestamp").Lt(cutoff), nil
+	case RetentionTableWebhookDelivery:
+		return goqu.And(
+			goqu.C("created_ts").Lt(cutoff),
+			goqu.C("status").Neq(WebhookDeliveryPending),
+		), nil
+	}
+	return nil, fmt.Errorf("unknown retention table %q", table)
+}
+
+// prunableKey returns the columns identifying the rows of the table provided.
+func prunableKey(table RetentionTable) string {
+	switch table {
+	case RetentionTableInstanceApplication:
+		return "instance_id, application_id"
+	case RetentionTableInstanceStats, RetentionTableInstanceStatsDaily, RetentionTableInstanceStatsWeekly:
+		return "ctid"
+	default:
+		return "id"
+	}
+}
+
+// CountPrunableRows returns the number of rows of the table provided that are
+// older than the cutoff and would be removed by PruneRows.
+func (api *API) CountPrunableRows(table RetentionTable, cutoff time.Time) (int64, error) {
+	cond, err := prunableCondition(table, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	query, _, err := goqu.From(string(table)).
+		Select(goqu.COUNT("*")).
+		Where(cond).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := api.db.QueryRow(query).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// PruneRows removes up to batchSize rows of the table provided that are older
+// than the cutoff, returning the number of rows removed. Rows are removed in
+// small batches so that tables are never locked for long.
+func (api *API) PruneRows(table RetentionTable, cutoff time.Time, batchSize int) (int64, error) {
+	cond, err := prunableCondition(table, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	key := prunableKey(table)
+	subQuery := goqu.From(string(table)).
+		Select(goqu.L(key)).
+		Where(cond).
+		Limit(uint(batchSize))
+
+	query, _, err := goqu.Delete(string(table)).
+		Where(goqu.L("("+key+") IN ?", subQuery)).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := api.db.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestPruneRows_Instances(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tPkg, _ := as.AddPackage(&Package{Type: PkgTypeOther, URL: "http://sample.url/pkg", Version: "12.1.0", ApplicationID: tApp.ID})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID, PackageID: null.StringFrom(tPkg.ID)})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tOldInstance, err := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.1"}, NewInstanceApplication(tApp.ID, tGroup.ID, "1.0.0"))
+	require.NoError(t, err)
+	tInstance, err := a.RegisterInstance(Instance{ID: uuid.New().String(), IP: "10.0.0.2"}, NewInstanceApplication(tApp.ID, tGroup.ID, "1.0.0"))
+	require.NoError(t, err)
+
+	// Both instances were created long ago, but only one of them has been
+	// checking for updates since.
+	_, err = a.db.Exec("UPDATE instance SET created_ts = now() - interval '10 days'")
+	require.NoError(t, err)
+	_, err = a.db.Exec("UPDATE instance_application SET last_check_for_updates = now() - interval '10 days' WHERE instance_id = $1", tOldInstance.ID)
+	require.NoError(t, err)
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	count, err := a.CountPrunableRows(RetentionTableInstanceApplication, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = a.CountPrunableRows(RetentionTableInstance, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	pruned, err := a.PruneRows(RetentionTableInstanceApplication, cutoff, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	pruned, err = a.PruneRows(RetentionTableInstance, cutoff, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	_, err = a.GetInstance(tOldInstance.ID, tApp.ID)
+	assert.Error(t, err)
+	_, err = a.GetInstance(tInstance.ID, tApp.ID)
+	assert.NoError(t, err)
+
+	count, err = a.CountPrunableRows(RetentionTableInstance, cutoff)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestPruneRows_Batches(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	for i := 0; i < 5; i++ {
+		_, err := a.db.Exec("INSERT INTO instance_stats (timestamp, channel_id, channel_name, arch, version, instances) VALUES (now() - interval '10 days', (SELECT id FROM channel LIMIT 1), 'stable', 'AMD64', $1, 1)", i)
+		require.NoError(t, err)
+	}
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	pruned, err := a.PruneRows(RetentionTableInstanceStats, cutoff, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+
+	count, err := a.CountPrunableRows(RetentionTableInstanceStats, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	_, err = a.PruneRows("unknown", cutoff, 3)
+	assert.Error(t, err)
+}
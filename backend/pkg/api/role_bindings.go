@@ -0,0 +1,27 @@
+package api
+
+import "github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+
+// ErrInvalidRoleBinding indicates that the role binding provided is not valid.
+var ErrInvalidRoleBinding = types.ErrInvalidRoleBinding
+
+// Roles granted on the applications, from the least to the most privileged.
+const (
+	RoleViewer   = types.RoleViewer
+	RoleOperator = types.RoleOperator
+	RoleAdmin    = types.RoleAdmin
+)
+
+// Types of the subjects of the role bindings.
+const (
+	RoleBindingSubjectOIDCRole   = types.RoleBindingSubjectOIDCRole
+	RoleBindingSubjectGithubTeam = types.RoleBindingSubjectGithubTeam
+)
+
+type RoleBinding = types.RoleBinding
+
+// RoleIncludes reports whether the role provided grants at least the
+// permissions of the required one. Unknown roles grant nothing.
+func RoleIncludes(role, required string) bool {
+	return types.RoleIncludes(role, required)
+}
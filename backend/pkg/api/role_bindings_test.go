@@ -0,0 +1,102 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestRoleBindings(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, _ := as.AddTeam(&Team{Name: "test_team"})
+	tApp, _ := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	tApp2, _ := as.AddApp(&Application{Name: "test_app2", TeamID: tTeam.ID})
+	tGroup, _ := as.AddGroup(&Group{Name: "group1", ApplicationID: tApp.ID, PolicyPeriodInterval: "15 minutes", PolicyUpdateTimeout: "60 minutes"})
+	tGroup2, _ := as.AddGroup(&Group{Name: "group2", ApplicationID: tApp2.ID, PolicyPeriodInterval: "15 minutes", PolicyUpdateTimeout: "60 minutes"})
+	tChannel, _ := as.AddChannel(&Channel{Name: "test_channel", Color: "blue", ApplicationID: tApp.ID})
+
+	_, err := as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, SubjectType: "ldap_group", Subject: "ops", Role: RoleAdmin})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: "owner"})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, SubjectType: RoleBindingSubjectOIDCRole, Subject: "", Role: RoleViewer})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, GroupID: null.StringFrom(tGroup2.ID), SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: RoleOperator})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, GroupID: null.StringFrom(tGroup.ID), ChannelID: null.StringFrom(tChannel.ID), SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: RoleOperator})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+
+	appBinding, err := as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: RoleViewer, CreatedBy: "admin"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, appBinding.ID)
+	groupBinding, err := as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, GroupID: null.StringFrom(tGroup.ID), SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: RoleOperator, CreatedBy: "admin"})
+	require.NoError(t, err)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp.ID, GroupID: null.StringFrom(tGroup.ID), SubjectType: RoleBindingSubjectOIDCRole, Subject: "ops", Role: RoleAdmin})
+	assert.ErrorIs(t, err, ErrInvalidRoleBinding)
+	_, err = as.AddRoleBinding(&RoleBinding{ApplicationID: tApp2.ID, SubjectType: RoleBindingSubjectGithubTeam, Subject: "kinvolk/ops", Role: RoleAdmin})
+	require.NoError(t, err)
+
+	bindingsCount, err := a.GetRoleBindingsCount(tApp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, bindingsCount)
+	bindings, err := a.GetRoleBindings(tApp.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Len(t, bindings, 2)
+
+	bindings, err = a.GetSubjectsRoleBindings(RoleBindingSubjectOIDCRole, []string{"ops", "dev"}, tApp.ID)
+	require.NoError(t, err)
+	assert.Len(t, bindings, 2)
+	bindings, err = a.GetSubjectsRoleBindings(RoleBindingSubjectOIDCRole, []string{"ops"}, tApp2.ID)
+	require.NoError(t, err)
+	assert.Len(t, bindings, 0)
+	bindings, err = a.GetSubjectsRoleBindings(RoleBindingSubjectGithubTeam, []string{"kinvolk", "kinvolk/ops"}, "")
+	require.NoError(t, err)
+	require.Len(t, bindings, 1)
+	assert.Equal(t, tApp2.ID, bindings[0].ApplicationID)
+
+	bound, err := a.HasRoleBindings(RoleBindingSubjectOIDCRole, []string{"dev", "ops"})
+	require.NoError(t, err)
+	assert.True(t, bound)
+	bound, err = a.HasRoleBindings(RoleBindingSubjectGithubTeam, []string{"ops"})
+	require.NoError(t, err)
+	assert.False(t, bound)
+
+	err = as.DeleteRoleBinding(groupBinding.ID)
+	require.NoError(t, err)
+	err = as.DeleteRoleBinding(groupBinding.ID)
+	assert.Equal(t, ErrNoRowsAffected, err)
+
+	err = as.DeleteApp(tApp.ID)
+	require.NoError(t, err)
+	_, err = a.GetRoleBinding(appBinding.ID)
+	assert.Error(t, err)
+}
+
+func TestRoleBinding_Applies(t *testing.T) {
+	appBinding := &RoleBinding{}
+	assert.True(t, appBinding.Applies("", ""))
+	assert.True(t, appBinding.Applies("group1", ""))
+
+	groupBinding := &RoleBinding{GroupID: null.StringFrom("group1")}
+	assert.True(t, groupBinding.Applies("group1", ""))
+	assert.False(t, groupBinding.Applies("group2", ""))
+	assert.False(t, groupBinding.Applies("", ""))
+
+	channelBinding := &RoleBinding{ChannelID: null.StringFrom("channel1")}
+	assert.True(t, channelBinding.Applies("", "channel1"))
+	assert.False(t, channelBinding.Applies("group1", ""))
+}
+
+func TestRoleIncludes(t *testing.T) {
+	assert.True(t, RoleIncludes(RoleAdmin, RoleOperator))
+	assert.True(t, RoleIncludes(RoleOperator, RoleOperator))
+	assert.True(t, RoleIncludes(RoleOperator, RoleViewer))
+	assert.False(t, RoleIncludes(RoleViewer, RoleOperator))
+	assert.False(t, RoleIncludes("", RoleViewer))
+	assert.False(t, RoleIncludes("owner", RoleViewer))
+}
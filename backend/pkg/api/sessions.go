@@ -0,0 +1,148 @@
+package api
+
+import (
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// GetSessionData returns the data of the session identified by the id
+// provided, or sql.ErrNoRows if it doesn't exist or has expired.
+func (api *API) GetSessionData(id string) ([]byte, error) {
+	var data []byte
+	err := api.db.QueryRow(`SELECT data FROM session WHERE id = $1 AND expires_ts > $2`, id, time.Now().UTC()).Scan(&data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AddSessionData stores the data of a new session, expiring at the time
+// provided.
+func (api *API) AddSessionData(id string, data []byte, expiresTs time.Time) error {
+	_, err := api.db.Exec(`INSERT INTO session (id, data, expires_ts) VALUES ($1, $2, $3)`, id, data, expiresTs.UTC())
+	return err
+}
+
+// UpdateSessionData replaces the data of the session identified by the id
+// provided and extends its expiration. It reports false if the session
+// doesn't exist anymore, because it was destroyed or it expired.
+func (api *API) UpdateSessionData(id string, data []byte, expiresTs time.Time) (bool, error) {
+	result, err := api.db.Exec(`UPDATE session SET data = $2, expires_ts = $3 WHERE id = $1 AND expires_ts > $4`, id, data, expiresTs.UTC(), time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// DeleteSessionData destroys the session identified by the id provided.
+func (api *API) DeleteSessionData(id string) error {
+	query, _, err := goqu.Delete("session").
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = api.db.Exec(query)
+	return err
+}
+
+// DeleteExpiredSessionsData destroys the sessions that expired before the
+// time provided, returning how many were destroyed.
+func (api *API) DeleteExpiredSessionsData(now time.Time) (int64, error) {
+	query, _, err := goqu.Delete("session").
+		Where(goqu.C("expires_ts").Lte(now.UTC())).
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	result, err := api.db.Exec(query)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// AddGithubSession records the session of a GitHub user, along with the
+// organization and the optional team granting it access, and all of its
+// teams and organizations.
+func (api *API) AddGithubSession(sessionID, username, org, team string, subjects []string) error {
+	record := goqu.Record{
+		"session_id": sessionID,
+		"username":   username,
+		"org":        org,
+		"team":       null.NewString(team, team != ""),
+		"subjects":   pq.StringArray(append([]string{}, subjects...)),
+	}
+	query, _, err := goqu.Insert("github_session").
+		Rows(record).
+		OnConflict(goqu.DoUpdate("session_id", record)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	_, err = api.db.Exec(query)
+	return err
+}
+
+// RemoveGithubUserSessions forgets all the sessions of the GitHub user
+// provided, returning their ids.
+func (api *API) RemoveGithubUserSessions(username string) ([]string, error) {
+	return api.removeGithubSessions(goqu.C("username").Eq(username))
+}
+
+// RemoveGithubUserOrgSessions forgets the sessions of the GitHub user
+// provided that depend on its membership of the organization provided,
+// returning their ids.
+func (api *API) RemoveGithubUserOrgSessions(username, org string) ([]string, error) {
+	return api.removeGithubSessions(goqu.And(
+		goqu.C("username").Eq(username),
+		goqu.Or(
+			goqu.And(goqu.C("org").Eq(org), goqu.C("team").IsNull()),
+			goqu.L("? = ANY(subjects)", org),
+		),
+	))
+}
+
+// RemoveGithubUserTeamSessions forgets the sessions of the GitHub user
+// provided that depend on its membership of the team provided, returning
+// their ids.
+func (api *API) RemoveGithubUserTeamSessions(username, org, team string) ([]string, error) {
+	return api.removeGithubSessions(goqu.And(
+		goqu.C("username").Eq(username),
+		goqu.Or(
+			goqu.And(goqu.C("org").Eq(org), goqu.C("team").Eq(team)),
+			goqu.L("? = ANY(subjects)", org+"/"+team),
+		),
+	))
+}
+
+// RemoveGithubTeamSessions forgets the sessions of all the GitHub users
+// that depend on their membership of the team provided, returning their ids.
+func (api *API) RemoveGithubTeamSessions(org, team string) ([]string, error) {
+	return api.removeGithubSessions(goqu.Or(
+		goqu.And(goqu.C("org").Eq(org), goqu.C("team").Eq(team)),
+		goqu.L("? = ANY(subjects)", org+"/"+team),
+	))
+}
+
+func (api *API) removeGithubSessions(condition goqu.Expression) ([]string, error) {
+	query, _, err := goqu.Delete("github_session").
+		Where(condition).
+		Returning("session_id").
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var sessionIDs []string
+	if err := api.db.Select(&sessionIDs, query); err != nil {
+		return nil, err
+	}
+	return sessionIDs, nil
+}
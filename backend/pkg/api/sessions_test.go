@@ -0,0 +1,81 @@
+package api
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionData(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	now := time.Now()
+	err := a.AddSessionData("session1", []byte{0, 1, 2}, now.Add(time.Hour))
+	require.NoError(t, err)
+	err = a.AddSessionData("session2", []byte{3}, now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	data, err := a.GetSessionData("session1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2}, data)
+	_, err = a.GetSessionData("session2")
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	updated, err := a.UpdateSessionData("session1", []byte{4, 5}, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, updated)
+	data, err = a.GetSessionData("session1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 5}, data)
+	updated, err = a.UpdateSessionData("session2", []byte{4, 5}, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	count, err := a.DeleteExpiredSessionsData(now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	err = a.DeleteSessionData("session1")
+	require.NoError(t, err)
+	_, err = a.GetSessionData("session1")
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestGithubSessions(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	expiresTs := time.Now().Add(time.Hour)
+	for _, id := range []string{"session1", "session2", "session3", "session4", "session5"} {
+		require.NoError(t, a.AddSessionData(id, []byte{}, expiresTs))
+	}
+	require.NoError(t, a.AddGithubSession("session1", "user1", "kinvolk", "", []string{"kinvolk", "flatcar"}))
+	require.NoError(t, a.AddGithubSession("session2", "user1", "kinvolk", "ops", []string{"kinvolk", "kinvolk/ops", "flatcar/dev"}))
+	require.NoError(t, a.AddGithubSession("session3", "user2", "kinvolk", "ops", []string{"kinvolk", "kinvolk/ops"}))
+	require.NoError(t, a.AddGithubSession("session4", "user3", "flatcar", "", []string{"flatcar"}))
+	require.NoError(t, a.AddGithubSession("session5", "user4", "flatcar", "", []string{"flatcar", "kinvolk/ops"}))
+
+	sessionIDs, err := a.RemoveGithubUserOrgSessions("user1", "flatcar")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session1"}, sessionIDs)
+
+	sessionIDs, err = a.RemoveGithubUserTeamSessions("user1", "flatcar", "dev")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session2"}, sessionIDs)
+
+	// the sessions granted access by another organization or team but
+	// depending on the team are forgotten too
+	sessionIDs, err = a.RemoveGithubTeamSessions("kinvolk", "ops")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"session3", "session5"}, sessionIDs)
+
+	// destroying the session forgets it
+	require.NoError(t, a.DeleteSessionData("session4"))
+	sessionIDs, err = a.RemoveGithubUserSessions("user3")
+	require.NoError(t, err)
+	assert.Empty(t, sessionIDs)
+}
@@ -2,4 +2,13 @@ package api
 
 import "github.com/flatcar/nebraska/backend/pkg/api/internal/types"
 
+// ErrInvalidTeam indicates that the team provided is not valid.
+var ErrInvalidTeam = types.ErrInvalidTeam
+
+// Types of the subjects mapping the users to the teams.
+const (
+	TeamSubjectOIDCClaim = types.TeamSubjectOIDCClaim
+	TeamSubjectGithubOrg = types.TeamSubjectGithubOrg
+)
+
 type Team = types.Team
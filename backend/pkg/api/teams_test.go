@@ -0,0 +1,81 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeams(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	_, err := as.AddTeam(&Team{Name: ""})
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	_, err = as.AddTeam(&Team{Name: "team_with_a_too_long_name!"})
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	_, err = as.AddTeam(&Team{Name: "ops", GithubOrgs: pq.StringArray{""}})
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+
+	tTeam, err := as.AddTeam(&Team{Name: "ops", OIDCClaims: pq.StringArray{"ops", "sre"}, GithubOrgs: pq.StringArray{"kinvolk"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ops", "sre"}, []string(tTeam.OIDCClaims))
+	tTeam2, err := as.AddTeam(&Team{Name: "dev"})
+	require.NoError(t, err)
+	assert.Empty(t, tTeam2.OIDCClaims)
+
+	_, err = as.AddTeam(&Team{Name: "ops"})
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	_, err = as.AddTeam(&Team{Name: "qa", OIDCClaims: pq.StringArray{"qa", "sre"}})
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+
+	defaultTeam, err := a.GetTeam()
+	require.NoError(t, err)
+	assert.NotEqual(t, tTeam.ID, defaultTeam.ID)
+	assert.NotEqual(t, tTeam2.ID, defaultTeam.ID)
+
+	teamIDs, err := a.ResolveTeams(TeamSubjectOIDCClaim, []string{"dev", "sre"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{tTeam.ID}, teamIDs)
+	teamIDs, err = a.ResolveTeams(TeamSubjectGithubOrg, []string{"flatcar", "kinvolk"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{tTeam.ID}, teamIDs)
+	teamIDs, err = a.ResolveTeams(TeamSubjectGithubOrg, []string{"sre"})
+	require.NoError(t, err)
+	assert.Empty(t, teamIDs)
+	mapped, err := a.HasTeamMappings(TeamSubjectOIDCClaim)
+	require.NoError(t, err)
+	assert.True(t, mapped)
+
+	tTeam2.OIDCClaims = pq.StringArray{"dev"}
+	err = as.UpdateTeam(tTeam2)
+	require.NoError(t, err)
+	teamIDs, err = a.ResolveTeams(TeamSubjectOIDCClaim, []string{"dev", "sre"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{tTeam.ID, tTeam2.ID}, teamIDs)
+	tTeam2.GithubOrgs = pq.StringArray{"kinvolk"}
+	err = as.UpdateTeam(tTeam2)
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	team, err := a.GetTeamByID(tTeam2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, []string(team.OIDCClaims))
+	assert.Empty(t, team.GithubOrgs)
+
+	// the same team can keep its own mappings
+	err = as.UpdateTeam(tTeam)
+	require.NoError(t, err)
+
+	err = as.DeleteTeam(defaultTeam.ID)
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	_, err = as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	require.NoError(t, err)
+	err = as.DeleteTeam(tTeam.ID)
+	assert.ErrorIs(t, err, ErrInvalidTeam)
+	err = as.DeleteTeam(tTeam2.ID)
+	require.NoError(t, err)
+	err = as.DeleteTeam(tTeam2.ID)
+	assert.Equal(t, ErrNoRowsAffected, err)
+}
@@ -59,6 +59,11 @@ var (
 	// ErrGrantingUpdate indicates that something went wrong while granting an
 	// update.
 	ErrGrantingUpdate = errors.New("nebraska: error granting update")
+
+	// ErrSuspectedDuplicateInstance indicates that the instance is suspected of
+	// sharing its machine id with other machines, and updates are refused to
+	// such instances.
+	ErrSuspectedDuplicateInstance = errors.New("nebraska: instance suspected of sharing its machine id")
 )
 
 // GetUpdatePackage returns an update package for the instance/application
@@ -71,6 +76,10 @@ func (api *API) GetUpdatePackage(inst Instance, instApp InstanceApplication) (*P
 		return nil, ErrRegisterInstanceFailed
 	}
 
+	if api.refuseSuspectedDuplicates && instance.SuspectedDuplicate {
+		return nil, ErrSuspectedDuplicateInstance
+	}
+
 	instanceVersion := instApp.Version
 	appID := instApp.ApplicationID
 	groupID := instApp.GroupID.String
@@ -203,6 +212,10 @@ func (api *API) GetUpdatePackagesForSyncer(inst Instance, instApp InstanceApplic
 		return nil, ErrRegisterInstanceFailed
 	}
 
+	if api.refuseSuspectedDuplicates && instance.SuspectedDuplicate {
+		return nil, ErrSuspectedDuplicateInstance
+	}
+
 	instanceVersion := instApp.Version
 	appID := instApp.ApplicationID
 	groupID := instApp.GroupID.String
@@ -1,7 +1,177 @@
 package api
 
-import "github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+	"github.com/flatcar/nebraska/backend/pkg/random"
+)
 
 type User = types.User
 
-var ErrUpdatingPassword = types.ErrUpdatingPassword
+var (
+	ErrUpdatingPassword = types.ErrUpdatingPassword
+
+	// ErrInvalidUser indicates that the user provided is not valid.
+	ErrInvalidUser = types.ErrInvalidUser
+
+	// ErrInvalidCredentials indicates that the username or the password
+	// used to log in are wrong.
+	ErrInvalidCredentials = types.ErrInvalidCredentials
+)
+
+// Roles of the local users on the applications of their team.
+const (
+	UserRoleViewer = types.UserRoleViewer
+	UserRoleAdmin  = types.UserRoleAdmin
+)
+
+// MinUserPasswordLength is the minimum length of the passwords of the local
+// users.
+const MinUserPasswordLength = types.MinUserPasswordLength
+
+// dummyUserSecret is checked against the passwords of unknown users, so that
+// logging in as them takes as long as logging in as known users.
+var (
+	dummyUserSecret     string
+	dummyUserSecretOnce sync.Once
+)
+
+// AuthenticateUser returns the user identified by the username provided if
+// the password provided is its password, ErrInvalidCredentials otherwise.
+// Legacy md5 secrets are replaced by bcrypt hashes.
+func (api *API) AuthenticateUser(username, password string) (*User, error) {
+	user, err := api.GetUser(username)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		dummyUserSecretOnce.Do(func() {
+			dummyUserSecret, _ = types.HashUserPassword(random.String(32))
+		})
+		dummy := &User{Secret: dummyUserSecret}
+		dummy.CheckPassword(password)
+		return nil, ErrInvalidCredentials
+	}
+
+	ok, legacy := user.CheckPassword(password)
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+
+	if legacy {
+		if err := api.upgradeUserSecret(user, password); err != nil {
+			l.Error().Err(err).Str("username", username).Msg("AuthenticateUser - could not replace legacy secret")
+		}
+	}
+
+	return user, nil
+}
+
+func (api *API) upgradeUserSecret(user *User, password string) error {
+	// the password is hashed as is, even if it doesn't meet the current
+	// length requirements, so that the user can still log in with it
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	secret := string(hash)
+	query, _, err := goqu.Update("users").
+		Set(goqu.Record{"secret": secret}).
+		Where(goqu.C("id").Eq(user.ID), goqu.C("secret").Eq(user.Secret)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err := api.db.Exec(query); err != nil {
+		return err
+	}
+	user.Secret = secret
+	return nil
+}
+
+// AuthenticateLocalUser reports whether the password provided is the one of
+// the local user provided. It's a part of auth.LocalUsers interface.
+func (api *API) AuthenticateLocalUser(username, password string) (bool, error) {
+	_, err := api.AuthenticateUser(username, password)
+	if errors.Is(err, ErrInvalidCredentials) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetLocalUser returns the team and the role of the local user provided, and
+// a stamp that changes along with its password, or empty values if it doesn't
+// exist. It's a part of auth.LocalUsers interface.
+func (api *API) GetLocalUser(username string) (teamID, role, stamp string, err error) {
+	user, err := api.GetUser(username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", "", nil
+		}
+		return "", "", "", err
+	}
+	sum := sha256.Sum256([]byte(user.Secret))
+	return user.TeamID, user.Role, hex.EncodeToString(sum[:16]), nil
+}
+
+// GetLocalUserLoginLock returns when the logins of the local user provided are
+// unlocked, or the zero time if they were never locked. It's a part of
+// auth.LocalUsers interface.
+func (api *API) GetLocalUserLoginLock(username string) (time.Time, error) {
+	var lockedUntil sql.NullTime
+	err := api.db.QueryRow(`
+		SELECT f.locked_until
+		FROM user_login_failures f JOIN users u ON u.id = f.user_id
+		WHERE u.username = $1`, username).Scan(&lockedUntil)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, err
+	}
+	return lockedUntil.Time, nil
+}
+
+// RecordLocalUserLoginFailure records a failed login of the local user
+// provided at the time provided and returns its number of consecutive failed
+// logins, starting over when the previous failure is older than forgetAfter.
+// The failed logins of unknown users aren't recorded, 0 is returned for them.
+// It's a part of auth.LocalUsers interface.
+func (api *API) RecordLocalUserLoginFailure(username string, at time.Time, forgetAfter time.Duration) (int, error) {
+	var failures int
+	err := api.db.QueryRow(`
+		INSERT INTO user_login_failures (user_id, failures, last_failure_ts)
+		SELECT id, 1, $2 FROM users WHERE username = $1
+		ON CONFLICT (user_id) DO UPDATE SET
+			failures = CASE WHEN user_login_failures.last_failure_ts < $3 THEN 1 ELSE user_login_failures.failures + 1 END,
+			last_failure_ts = EXCLUDED.last_failure_ts
+		RETURNING failures`, username, at.UTC(), at.Add(-forgetAfter).UTC()).Scan(&failures)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return failures, err
+}
+
+// LockLocalUserLogins locks the logins of the local user provided until the
+// time provided. It's a part of auth.LocalUsers interface.
+func (api *API) LockLocalUserLogins(username string, until time.Time) error {
+	_, err := api.db.Exec(`
+		UPDATE user_login_failures SET locked_until = $2
+		WHERE user_id = (SELECT id FROM users WHERE username = $1)`, username, until.UTC())
+	return err
+}
+
+// ResetLocalUserLoginFailures forgets the failed logins of the local user
+// provided. It's a part of auth.LocalUsers interface.
+func (api *API) ResetLocalUserLoginFailures(username string) error {
+	_, err := api.db.Exec(`
+		DELETE FROM user_login_failures
+		WHERE user_id = (SELECT id FROM users WHERE username = $1)`, username)
+	return err
+}
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -40,7 +44,102 @@ func TestUpdateUserPassword(t *testing.T) {
 	assert.Equal(t, "admin", user.Username)
 	assert.Equal(t, defaultTeamID, user.TeamID)
 	assert.NotEqual(t, "8b31292d4778582c0e5fa96aee5513f1", user.Secret)
-	assert.Equal(t, "c01e8daa7a6c135909f218ff2bea1cfe", user.Secret)
+	ok, legacy := user.CheckPassword("new-password")
+	assert.True(t, ok)
+	assert.False(t, legacy)
+
+	err = as.UpdateUserPassword("admin", "short")
+	assert.ErrorIs(t, err, ErrInvalidUser)
+}
+
+func TestAuthenticateUser(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	_, err := a.AuthenticateUser("non-existent", "admin")
+	assert.Equal(t, ErrInvalidCredentials, err)
+
+	_, err = a.AuthenticateUser("admin", "wrong-password")
+	assert.Equal(t, ErrInvalidCredentials, err)
+
+	// the legacy md5 digest of the sample data is replaced on login
+	user, err := a.AuthenticateUser("admin", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, UserRoleAdmin, user.Role)
+	user, err = a.GetUser("admin")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(user.Secret, "$2"))
+
+	_, err = a.AuthenticateUser("admin", "admin")
+	assert.NoError(t, err)
+
+	ok, err := a.AuthenticateLocalUser("admin", "wrong-password")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestGetLocalUser(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	teamID, role, stamp, err := a.GetLocalUser("non-existent")
+	assert.NoError(t, err)
+	assert.Empty(t, teamID)
+	assert.Empty(t, role)
+	assert.Empty(t, stamp)
+
+	teamID, role, stamp, err = a.GetLocalUser("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, defaultTeamID, teamID)
+	assert.Equal(t, UserRoleAdmin, role)
+	assert.NotEmpty(t, stamp)
+
+	// the stamp changes along with the password
+	err = as.UpdateUserPassword("admin", "new-password")
+	require.NoError(t, err)
+	_, _, newStamp, err := a.GetLocalUser("admin")
+	assert.NoError(t, err)
+	assert.NotEqual(t, stamp, newStamp)
+}
+
+func TestLocalUserLoginFailures(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+
+	now := time.Now().Truncate(time.Second)
+	lockedUntil, err := a.GetLocalUserLoginLock("admin")
+	require.NoError(t, err)
+	assert.True(t, lockedUntil.IsZero())
+
+	for i := 1; i <= 3; i++ {
+		failures, err := a.RecordLocalUserLoginFailure("admin", now, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, i, failures)
+	}
+	require.NoError(t, a.LockLocalUserLogins("admin", now.Add(time.Minute)))
+	lockedUntil, err = a.GetLocalUserLoginLock("admin")
+	require.NoError(t, err)
+	assert.True(t, lockedUntil.Equal(now.Add(time.Minute)))
+
+	// old failures are forgotten
+	failures, err := a.RecordLocalUserLoginFailure("admin", now.Add(2*time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures)
+
+	require.NoError(t, a.ResetLocalUserLoginFailures("admin"))
+	lockedUntil, err = a.GetLocalUserLoginLock("admin")
+	require.NoError(t, err)
+	assert.True(t, lockedUntil.IsZero())
+
+	// the failed logins of unknown users aren't recorded
+	failures, err = a.RecordLocalUserLoginFailure("non-existent", now, time.Hour)
+	require.NoError(t, err)
+	assert.Zero(t, failures)
+	require.NoError(t, a.LockLocalUserLogins("non-existent", now.Add(time.Minute)))
+	lockedUntil, err = a.GetLocalUserLoginLock("non-existent")
+	require.NoError(t, err)
+	assert.True(t, lockedUntil.IsZero())
 }
 
 func TestAddUser(t *testing.T) {
@@ -58,8 +157,53 @@ func TestAddUser(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, user.Username, chandler.Username)
 
+	assert.Equal(t, UserRoleViewer, chandler.Role)
+
 	_, err = as.AddUser(user)
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUser)
+
+	_, err = as.AddUser(&User{Username: "monica geller", TeamID: defaultTeamID})
+	assert.ErrorIs(t, err, ErrInvalidUser)
+
+	_, err = as.AddUser(&User{Username: "monica", Role: "owner", TeamID: defaultTeamID})
+	assert.ErrorIs(t, err, ErrInvalidUser)
+}
+
+func TestUpdateAndDeleteUser(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	secret, err := as.GenerateUserSecret("chandler-password")
+	require.NoError(t, err)
+	chandler, err := as.AddUser(&User{Username: "chandler", Secret: secret, TeamID: defaultTeamID})
+	require.NoError(t, err)
+
+	count, err := a.GetTeamAdminsCount(defaultTeamID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	chandler.Role = UserRoleAdmin
+	err = as.UpdateUser(chandler)
+	assert.NoError(t, err)
+	user, err := a.GetUserByID(chandler.ID)
+	require.NoError(t, err)
+	assert.Equal(t, UserRoleAdmin, user.Role)
+	count, err = a.GetTeamAdminsCount(defaultTeamID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	chandler.Role = "owner"
+	err = as.UpdateUser(chandler)
+	assert.ErrorIs(t, err, ErrInvalidUser)
+
+	err = as.DeleteUser(chandler.ID)
+	assert.NoError(t, err)
+	_, err = a.GetUserByID(chandler.ID)
+	assert.Equal(t, sql.ErrNoRows, err)
+
+	err = as.DeleteUser(chandler.ID)
+	assert.Equal(t, ErrNoRowsAffected, err)
 }
 
 func TestGetUsersInTeam(t *testing.T) {
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/flatcar/nebraska/backend/pkg/api/internal/types"
+)
+
+const (
+	WebhookDeliveryPending   = types.WebhookDeliveryPending
+	WebhookDeliveryDelivered = types.WebhookDeliveryDelivered
+	WebhookDeliveryFailed    = types.WebhookDeliveryFailed
+
+	WebhookEventActivity = types.WebhookEventActivity
+)
+
+// ErrInvalidWebhook indicates that the webhook provided is not valid.
+var ErrInvalidWebhook = types.ErrInvalidWebhook
+
+type (
+	Webhook                = types.Webhook
+	WebhookDelivery        = types.WebhookDelivery
+	WebhookDeliveryAttempt = types.WebhookDeliveryAttempt
+	WebhookPayload         = types.WebhookPayload
+)
+
+// webhookEnqueueBatchSize is the maximum number of activity entries taken
+// from the webhooks outbox at once.
+const webhookEnqueueBatchSize = 500
+
+// EnqueueWebhookDeliveries takes the activity entries queued in the webhooks
+// outbox by the transactions creating them, and queues for delivery to the
+// enabled webhooks the ones matching their filters, returning the number of
+// deliveries queued.
+func (api *API) EnqueueWebhookDeliveries() (int64, error) {
+	var total int64
+	for {
+		queued, taken, err := api.enqueueWebhookDeliveries()
+		total += queued
+		if err != nil || taken < webhookEnqueueBatchSize {
+			return total, err
+		}
+	}
+}
+
+// enqueueWebhookDeliveries takes a batch of activity entries from the webhooks
+// outbox and queues their deliveries to the enabled webhooks, in the same
+// transaction so that they are taken again if queueing fails. It returns the
+// number of deliveries queued and of activity entries taken.
+func (api *API) enqueueWebhookDeliveries() (int64, int, error) {
+	tx, err := api.db.Beginx()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			l.Error().Err(err).Msg("enqueueWebhookDeliveries - could not roll back")
+		}
+	}()
+
+	// the entries are locked so that other Nebraska instances skip them
+	var activityIDs []string
+	query := `
+		DELETE FROM webhook_outbox
+		WHERE id IN (
+			SELECT id FROM webhook_outbox
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING activity_id`
+	if err := tx.Select(&activityIDs, query, webhookEnqueueBatchSize); err != nil {
+		return 0, 0, err
+	}
+	if len(activityIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	webhooks, err := api.GetEnabledWebhooks()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var rows []interface{}
+	for _, webhook := range webhooks {
+		activityEntries, err := api.GetWebhookActivity(webhook, activityIDs)
+		if err != nil {
+			return 0, 0, fmt.Errorf("getting activity of webhook %s: %w", webhook.ID, err)
+		}
+		for _, activityEntry := range activityEntries {
+			rows = append(rows, goqu.Record{
+				"webhook_id":  webhook.ID,
+				"activity_id": activityEntry.ID,
+				"payload": WebhookPayload{
+					Event:     WebhookEventActivity,
+					WebhookID: webhook.ID,
+					Activity:  activityEntry,
+				},
+			})
+		}
+	}
+
+	var queued int64
+	if len(rows) > 0 {
+		query, _, err := goqu.Insert("webhook_delivery").
+			Rows(rows...).
+			OnConflict(goqu.DoNothing()).
+			ToSQL()
+		if err != nil {
+			return 0, 0, err
+		}
+		result, err := tx.Exec(query)
+		if err != nil {
+			return 0, 0, err
+		}
+		queued, err = result.RowsAffected()
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+	return queued, len(activityIDs), nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries of enabled
+// webhooks whose next attempt is due, along with the url and secret of their
+// webhook. Their next attempt is postponed by the lease provided, so that they
+// are not claimed again while being attempted.
+func (api *API) ClaimWebhookDeliveries(limit int, lease time.Duration) ([]*WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_delivery AS d
+		SET next_attempt_ts = now() + $2::interval
+		FROM webhook AS w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT pd.id
+			FROM webhook_delivery AS pd JOIN webhook AS pw ON pw.id = pd.webhook_id
+			WHERE pd.status = $3 AND pd.next_attempt_ts <= now() AND pw.enabled
+			ORDER BY pd.next_attempt_ts
+			LIMIT $1
+			FOR UPDATE OF pd SKIP LOCKED
+		)
+		RETURNING d.*, w.url, w.secret`
+
+	rows, err := api.db.Queryx(query, limit, fmt.Sprintf("%d milliseconds", lease.Milliseconds()), WebhookDeliveryPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		delivery := WebhookDelivery{}
+		if err := rows.StructScan(&delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookDeliveryAttempt records the outcome of an attempt to deliver
+// the delivery identified by the id provided.
+func (api *API) RecordWebhookDeliveryAttempt(deliveryID string, attempt WebhookDeliveryAttempt) error {
+	query, _, err := goqu.Update("webhook_delivery").
+		Set(goqu.Record{
+			"status":          attempt.Status,
+			"attempts":        goqu.L("attempts + 1"),
+			"last_attempt_ts": attempt.AttemptTs,
+			"next_attempt_ts": attempt.NextAttemptTs,
+			"response_status": attempt.ResponseStatus,
+			"last_error":      attempt.Error,
+		}).
+		Where(goqu.C("id").Eq(deliveryID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	result, err := api.db.Exec(query)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnqueueWebhookDeliveries(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	as := adminSvc(a)
+
+	tTeam, err := as.AddTeam(&Team{Name: "test_team"})
+	require.NoError(t, err)
+	tApp, err := as.AddApp(&Application{Name: "test_app", TeamID: tTeam.ID})
+	require.NoError(t, err)
+	webhook, err := as.AddWebhook(&Webhook{Name: "all", URL: "http://localhost/hook", TeamID: tTeam.ID, Enabled: true})
+	require.NoError(t, err)
+
+	// more entries than a batch sharing the same creation time, as created
+	// by a single statement
+	_, err = a.db.Exec(`
+		INSERT INTO admin_activity (created_ts, class, severity, version, application_id)
+		SELECT current_timestamp, $1, $2, '1.0.0', $3 FROM generate_series(1, $4)`,
+		ActivityChannelPackageUpdated, activityInfo, tApp.ID, webhookEnqueueBatchSize+100)
+	require.NoError(t, err)
+
+	queued, err := a.EnqueueWebhookDeliveries()
+	require.NoError(t, err)
+	assert.Equal(t, int64(webhookEnqueueBatchSize+100), queued)
+
+	// an entry whose transaction committed after later entries were queued
+	_, err = a.db.Exec(`
+		INSERT INTO admin_activity (created_ts, class, severity, version, application_id)
+		SELECT min(created_ts), $1, $2, '1.0.0', $3 FROM admin_activity WHERE application_id = $3`,
+		ActivityChannelPackageUpdated, activityInfo, tApp.ID)
+	require.NoError(t, err)
+
+	queued, err = a.EnqueueWebhookDeliveries()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), queued)
+
+	count, err := a.GetWebhookDeliveriesCount(webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, webhookEnqueueBatchSize+101, count)
+
+	// the entries are only queued once
+	queued, err = a.EnqueueWebhookDeliveries()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), queued)
+}
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"errors"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/flatcar/nebraska/backend/pkg/logger"
@@ -8,6 +10,14 @@ import (
 
 var (
 	l = logger.New("auth")
+
+	// errUserNotMapped error indicates that a user isn't mapped to any
+	// team while some teams map their users.
+	errUserNotMapped = errors.New("user not mapped to any team")
+
+	// errAmbiguousTeam error indicates that a user is mapped to several
+	// teams.
+	errAmbiguousTeam = errors.New("user mapped to several teams")
 )
 
 // Authenticator provides a way to authorize a user sending an HTTP
@@ -22,9 +32,64 @@ type Authenticator interface {
 
 	Login(ctx echo.Context) error
 
+	Logout(ctx echo.Context) error
+
 	LoginCb(ctx echo.Context) error
 
 	ValidateToken(ctx echo.Context) error
 
 	LoginWebhook(ctx echo.Context) error
 }
+
+// RoleBindingChecker tells whether role bindings grant some access to the
+// users identified by the subjects provided: their OIDC roles, or the GitHub
+// teams and organizations they belong to. It lets in the users that have no
+// global access level but a role on some applications.
+type RoleBindingChecker interface {
+	HasRoleBindings(subjectType string, subjects []string) (bool, error)
+}
+
+// TeamResolver maps the users to the teams owning the applications they can
+// access, by the values of an OIDC claim or by the GitHub organizations they
+// belong to.
+type TeamResolver interface {
+	ResolveTeams(subjectType string, subjects []string) (teamIDs []string, err error)
+	HasTeamMappings(subjectType string) (bool, error)
+}
+
+// resolveTeam returns the id of the team the users identified by the subjects
+// provided are mapped to. The users not mapped to any team belong to the
+// default team as long as no team maps its users, and fail with
+// errUserNotMapped otherwise, as the members of the default team manage all
+// the teams. Users mapped to several teams fail with errAmbiguousTeam.
+func resolveTeam(teams TeamResolver, subjectType string, subjects []string, defaultTeamID string) (string, error) {
+	if teams == nil {
+		return defaultTeamID, nil
+	}
+	teamIDs, err := teams.ResolveTeams(subjectType, subjects)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case len(teamIDs) == 1:
+		return teamIDs[0], nil
+	case len(teamIDs) > 1:
+		return "", errAmbiguousTeam
+	}
+	mapped, err := teams.HasTeamMappings(subjectType)
+	if err != nil {
+		return "", err
+	}
+	if mapped {
+		return "", errUserNotMapped
+	}
+	return defaultTeamID, nil
+}
+
+// Access levels granted to the users on all the applications, set as
+// "access_level" in the request context by the authenticators. The subjects
+// identifying the user for the role bindings are set as "role_subjects".
+const (
+	AccessLevelAdmin  = "admin"
+	AccessLevelViewer = "viewer"
+)
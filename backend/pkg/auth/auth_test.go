@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTeams maps the subjects to the teams, by subject type.
+type mockTeams map[string]map[string]string
+
+func (m mockTeams) ResolveTeams(subjectType string, subjects []string) ([]string, error) {
+	var teamIDs []string
+	for _, subject := range subjects {
+		if teamID, ok := m[subjectType][subject]; ok {
+			teamIDs = append(teamIDs, teamID)
+		}
+	}
+	return teamIDs, nil
+}
+
+func (m mockTeams) HasTeamMappings(subjectType string) (bool, error) {
+	return len(m[subjectType]) > 0, nil
+}
+
+func TestResolveTeam(t *testing.T) {
+	teams := mockTeams{
+		"github_org": {"kinvolk": "team1", "flatcar": "team2"},
+	}
+
+	teamID, err := resolveTeam(teams, "github_org", []string{"kinvolk", "other"}, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "team1", teamID)
+
+	_, err = resolveTeam(teams, "github_org", []string{"kinvolk", "flatcar"}, "default")
+	assert.ErrorIs(t, err, errAmbiguousTeam)
+
+	// unmapped users don't fall back to the default team once some teams
+	// map their users
+	_, err = resolveTeam(teams, "github_org", []string{"other"}, "default")
+	assert.ErrorIs(t, err, errUserNotMapped)
+
+	teamID, err = resolveTeam(teams, "oidc_claim", []string{"other"}, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "default", teamID)
+
+	teamID, err = resolveTeam(nil, "oidc_claim", []string{"other"}, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "default", teamID)
+}
@@ -6,11 +6,12 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
-	"sync"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/labstack/echo/v4"
@@ -32,18 +33,42 @@ type (
 		ReadOnlyTeams     []string
 		DefaultTeamID     string
 		SessionStore      *sessions.Store
+		// RoleBindings lets in the users whose teams or organizations
+		// are bound to some applications, without being read-only or
+		// read-write teams. Only those teams are accepted when nil.
+		RoleBindings RoleBindingChecker
+		// Teams maps the users to the teams by their GitHub
+		// organizations. All the users belong to the default team when
+		// nil.
+		Teams TeamResolver
+		// Sessions keeps track of the sessions of the users, so that
+		// the webhook events can drop them.
+		Sessions GithubSessionIndex
+	}
+
+	// GithubSessionIndex keeps track of the sessions of the users
+	// logged in through GitHub, with the organization and the optional
+	// team granting them access and all of their teams and
+	// organizations. It has to be shared between the Nebraska replicas
+	// like the sessions, and it should forget the sessions once they
+	// are destroyed. The Remove functions forget the matching sessions
+	// and return their ids, for them to be destroyed.
+	GithubSessionIndex interface {
+		AddGithubSession(sessionID, username, org, team string, subjects []string) error
+		RemoveGithubUserSessions(username string) ([]string, error)
+		RemoveGithubUserOrgSessions(username, org string) ([]string, error)
+		RemoveGithubUserTeamSessions(username, org, team string) ([]string, error)
+		RemoveGithubTeamSessions(org, team string) ([]string, error)
 	}
 
 	githubTeamData struct {
 		org  string
 		team *string
+		// subjects are the teams and organizations of the user
+		// matched against the role bindings
+		subjects []string
 	}
 
-	stringSet              map[string]struct{}
-	teamToUsersMap         map[string]stringSet
-	sessionIDToTeamDataMap map[string]githubTeamData
-	userSessionMap         map[string]sessionIDToTeamDataMap
-
 	githubAuth struct {
 		enterpriseURL string
 
@@ -51,14 +76,13 @@ type (
 		oauthConfig   *oauth2.Config
 
 		sessionsStore *sessions.Store
-
-		userInfoLock   sync.Mutex
-		userSessionIDs userSessionMap
-		teamToUsers    teamToUsersMap
+		sessions      GithubSessionIndex
 
 		readWriteTeams []string
 		readOnlyTeams  []string
 		defaultTeamID  string
+		roleBindings   RoleBindingChecker
+		teams          TeamResolver
 	}
 )
 
@@ -100,11 +124,12 @@ func NewGithubAuthenticator(config *GithubAuthConfig) Authenticator {
 		},
 
 		sessionsStore:  config.SessionStore,
-		userSessionIDs: make(userSessionMap),
-		teamToUsers:    make(teamToUsersMap),
+		sessions:       config.Sessions,
 		readWriteTeams: copyStringSlice(config.ReadWriteTeams),
 		readOnlyTeams:  copyStringSlice(config.ReadOnlyTeams),
 		defaultTeamID:  config.DefaultTeamID,
+		roleBindings:   config.RoleBindings,
+		teams:          config.Teams,
 	}
 }
 
@@ -125,6 +150,13 @@ func (gha *githubAuth) Login(c echo.Context) error {
 	})
 }
 
+// Logout destroys the session of the user.
+func (gha *githubAuth) Logout(ctx echo.Context) error {
+	gha.cleanupSession(ctx)
+	sessionSave(ctx, echosessions.GetSession(ctx), "logout")
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 func (gha *githubAuth) ValidateToken(ctx echo.Context) error {
 	return ctx.JSON(http.StatusNotImplemented, map[string]any{
 		"error":       "validate_token_not_supported",
@@ -135,14 +167,7 @@ func (gha *githubAuth) ValidateToken(ctx echo.Context) error {
 func (gha *githubAuth) Authorize(c echo.Context) (teamID string, replied bool) {
 	session := echosessions.GetSession(c)
 	if session.Has("teamID") {
-		if session.Get("accesslevel") != "rw" {
-			if c.Request().Method != "HEAD" && c.Request().Method != "GET" {
-				httpError(c, http.StatusForbidden)
-				teamID = ""
-				replied = true
-				return
-			}
-		}
+		setAccess(c, session)
 		teamID = session.Get("teamID").(string)
 		replied = false
 		return
@@ -187,6 +212,7 @@ func (gha *githubAuth) Authorize(c echo.Context) (teamID string, replied bool) {
 		oauthClient := oauth2.NewClient(c.Request().Context(), tokenSource)
 		failed = false
 		if replied = gha.doLoginDance(c, oauthClient); !replied {
+			setAccess(c, session)
 			teamID = session.Get("teamID").(string)
 		} else {
 			teamID = ""
@@ -195,6 +221,23 @@ func (gha *githubAuth) Authorize(c echo.Context) (teamID string, replied bool) {
 	return
 }
 
+// setAccess sets the access level and the role subjects of the user of the
+// session in the request context, for the handlers to check that they allow
+// the request. Read-write teams are admins, read-only teams are viewers.
+func setAccess(c echo.Context, session *sessions.Session) {
+	switch session.Get("accesslevel") {
+	case "rw":
+		c.Set("access_level", AccessLevelAdmin)
+	case "ro":
+		c.Set("access_level", AccessLevelViewer)
+	default:
+		c.Set("access_level", "")
+	}
+	if subjects, ok := session.Get("rolesubjects").([]string); ok {
+		c.Set("role_subjects", subjects)
+	}
+}
+
 func (gha *githubAuth) LoginCb(ctx echo.Context) error {
 	const (
 		resultOK = iota
@@ -303,6 +346,7 @@ func (gha *githubAuth) doLoginDance(ctx echo.Context, oauthClient *http.Client)
 	const (
 		resultOK = iota
 		resultUnauthorized
+		resultForbidden
 		resultInternalFailure
 	)
 
@@ -316,6 +360,9 @@ func (gha *githubAuth) doLoginDance(ctx echo.Context, oauthClient *http.Client)
 		case resultUnauthorized:
 			gha.cleanupSession(ctx)
 			httpError(ctx, http.StatusUnauthorized)
+		case resultForbidden:
+			gha.cleanupSession(ctx)
+			httpError(ctx, http.StatusForbidden)
 		case resultInternalFailure:
 			httpError(ctx, http.StatusInternalServerError)
 		default:
@@ -352,6 +399,12 @@ func (gha *githubAuth) doLoginDance(ctx echo.Context, oauthClient *http.Client)
 	roTeams := gha.readOnlyTeams
 	teamData := githubTeamData{}
 	teamID := ""
+	accessLevel := ""
+	// subjects are all the teams and organizations of the user, matched
+	// against the role bindings
+	var subjects []string
+	// orgs are the organizations of the user, mapping it to a team
+	var orgs []string
 	listOpts := github.ListOptions{
 		Page:    1,
 		PerPage: 50,
@@ -359,7 +412,6 @@ func (gha *githubAuth) doLoginDance(ctx echo.Context, oauthClient *http.Client)
 	isRO := false
 	isRW := false
 
-checkLoop:
 	for {
 		ghTeams, response, err := client.Teams.ListUserTeams(ctx.Request().Context(), &listOpts)
 		if err != nil {
@@ -383,6 +435,13 @@ checkLoop:
 			}
 			l.Debug().Str("github team in organization", *ghTeam.Organization.Login).Msg("login dance")
 			fullGithubTeamName := makeTeamName(*ghTeam.Organization.Login, *ghTeam.Name)
+			subjects = append(subjects, fullGithubTeamName)
+			if !slices.Contains(orgs, *ghTeam.Organization.Login) {
+				orgs = append(orgs, *ghTeam.Organization.Login)
+			}
+			if isRW {
+				continue
+			}
 			l.Debug().Str("trying to find a matching ro or rw team", fullGithubTeamName).Msg("login dance")
 			for _, roTeam := range roTeams {
 				if isRO {
@@ -394,7 +453,7 @@ checkLoop:
 					teamData.team = ghTeam.Name
 					teamID = gha.defaultTeamID
 					isRO = true
-					session.Set("accesslevel", "ro")
+					accessLevel = "ro"
 					break
 				}
 			}
@@ -405,8 +464,8 @@ checkLoop:
 					teamData.team = ghTeam.Name
 					teamID = gha.defaultTeamID
 					isRW = true
-					session.Set("accesslevel", "rw")
-					break checkLoop
+					accessLevel = "rw"
+					break
 				}
 			}
 		}
@@ -417,118 +476,117 @@ checkLoop:
 		}
 		listOpts.Page = response.NextPage
 	}
-	if !isRW {
-		l.Debug().Str("login dance", "no matching teams found, trying orgs").Send()
-		listOpts.Page = 1
-	checkLoop2:
-		for {
-			ghOrgs, response, err := client.Organizations.List(ctx.Request().Context(), "", &listOpts)
-			if err != nil {
-				l.Error().Err(err).Str("login dance", "failed to get user orgs").Send()
-				result = resultInternalFailure
-				return
+	l.Debug().Str("login dance", "listing orgs").Send()
+	listOpts.Page = 1
+	for {
+		ghOrgs, response, err := client.Organizations.List(ctx.Request().Context(), "", &listOpts)
+		if err != nil {
+			l.Error().Err(err).Str("login dance", "failed to get user orgs").Send()
+			result = resultInternalFailure
+			return
+		}
+		for _, ghOrg := range ghOrgs {
+			if ghOrg.Login == nil {
+				l.Debug().Str("login dance", "unnamed github organization")
+				continue
 			}
-			for _, ghOrg := range ghOrgs {
-				if ghOrg.Login == nil {
-					l.Debug().Str("login dance", "unnamed github organization")
-					continue
-				}
-				l.Debug().Str("github org", *ghOrg.Login).Msg("login dance")
-				l.Debug().Str("trying to find a matching ro or rw team", *ghOrg.Login).Msg("login dance")
-				nebraskaOrgName := *ghOrg.Login
-				for _, roTeam := range roTeams {
-					if isRO {
-						break
-					}
-					if nebraskaOrgName == roTeam {
-						l.Debug().Str("found matching ro team", nebraskaOrgName).Msg("login dance")
-						teamData.org = nebraskaOrgName
-						teamID = gha.defaultTeamID
-						isRO = true
-						session.Set("accesslevel", "ro")
-						break
-					}
+			l.Debug().Str("github org", *ghOrg.Login).Msg("login dance")
+			nebraskaOrgName := *ghOrg.Login
+			subjects = append(subjects, nebraskaOrgName)
+			if !slices.Contains(orgs, nebraskaOrgName) {
+				orgs = append(orgs, nebraskaOrgName)
+			}
+			if isRW {
+				continue
+			}
+			l.Debug().Str("trying to find a matching ro or rw team", *ghOrg.Login).Msg("login dance")
+			for _, roTeam := range roTeams {
+				if isRO {
+					break
 				}
-				for _, rwTeam := range rwTeams {
-					if nebraskaOrgName == rwTeam {
-						l.Debug().Str("found matching rw team", nebraskaOrgName).Msg("login dance")
-						teamData.org = nebraskaOrgName
-						teamID = gha.defaultTeamID
-						session.Set("accesslevel", "rw")
-						break checkLoop2
-					}
+				if nebraskaOrgName == roTeam {
+					l.Debug().Str("found matching ro team", nebraskaOrgName).Msg("login dance")
+					teamData.org = nebraskaOrgName
+					teamID = gha.defaultTeamID
+					isRO = true
+					accessLevel = "ro"
+					break
 				}
 			}
-			// Next page being zero means that we are on the last
-			// page.
-			if response.NextPage == 0 {
-				break
+			for _, rwTeam := range rwTeams {
+				if nebraskaOrgName == rwTeam {
+					l.Debug().Str("found matching rw team", nebraskaOrgName).Msg("login dance")
+					teamData.org = nebraskaOrgName
+					teamID = gha.defaultTeamID
+					isRW = true
+					accessLevel = "rw"
+					break
+				}
 			}
-			listOpts.Page = response.NextPage
+		}
+		// Next page being zero means that we are on the last
+		// page.
+		if response.NextPage == 0 {
+			break
+		}
+		listOpts.Page = response.NextPage
+	}
+	if teamID == "" && gha.roleBindings != nil && len(subjects) > 0 {
+		bound, err := gha.roleBindings.HasRoleBindings("github_team", subjects)
+		if err != nil {
+			l.Error().Err(err).Str("login dance", "failed to check role bindings").Send()
+			result = resultInternalFailure
+			return
+		}
+		if bound {
+			l.Debug().Str("login dance", "found role bindings").Send()
+			teamID = gha.defaultTeamID
 		}
 	}
 	if teamID == "" {
 		l.Debug().Str("login dance", "not authorized").Send()
 		return
 	}
+	teamID, err = resolveTeam(gha.teams, "github_org", orgs, gha.defaultTeamID)
+	if errors.Is(err, errUserNotMapped) || errors.Is(err, errAmbiguousTeam) {
+		l.Warn().Err(err).Str("login dance", "not mapped to a team").Str("username", *ghUser.Login).Strs("orgs", orgs).Send()
+		result = resultForbidden
+		return
+	}
+	if err != nil {
+		l.Error().Err(err).Str("login dance", "failed to map the user to a team").Send()
+		result = resultInternalFailure
+		return
+	}
 	username := *ghUser.Login
 	session.Set("teamID", teamID)
 	session.Set("username", username)
+	session.Set("accesslevel", accessLevel)
+	session.Set("rolesubjects", subjects)
 	sessionSave(ctx, session, "login dance")
-	gha.addSessionID(username, session.ID(), teamData)
+	teamData.subjects = subjects
+	if err := gha.addSessionID(username, session.ID(), teamData); err != nil {
+		l.Error().Err(err).Str("login dance", "failed to record the session").Send()
+		session.Mark()
+		result = resultInternalFailure
+		return
+	}
 	result = resultOK
 	return
 }
 
-func (gha *githubAuth) addSessionID(username, sessionID string, teamData githubTeamData) {
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	sessionIDs := gha.userSessionIDs[username]
-	if sessionIDs == nil {
-		sessionIDs = make(sessionIDToTeamDataMap)
-		gha.userSessionIDs[username] = sessionIDs
-	}
-	sessionIDs[sessionID] = teamData
+func (gha *githubAuth) addSessionID(username, sessionID string, teamData githubTeamData) error {
+	team := ""
 	if teamData.team != nil {
-		teamName := makeTeamName(teamData.org, *teamData.team)
-		users := gha.teamToUsers[teamName]
-		if users == nil {
-			users = make(stringSet)
-			gha.teamToUsers[teamName] = users
-		}
-		users[username] = struct{}{}
+		team = *teamData.team
 	}
+	return gha.sessions.AddGithubSession(sessionID, username, teamData.org, team, teamData.subjects)
 }
 
 func (gha *githubAuth) cleanupSession(ctx echo.Context) {
-	session := echosessions.GetSession(ctx)
-	defer session.Mark()
-	username, ok := session.Get("username").(string)
-	if !ok {
-		return
-	}
-	sessionID := session.ID()
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	sessionIDs, ok := gha.userSessionIDs[username]
-	if !ok {
-		return
-	}
-	if teamData, ok := sessionIDs[sessionID]; ok {
-		if teamData.team != nil {
-			teamName := makeTeamName(teamData.org, *teamData.team)
-			if usersSet, ok := gha.teamToUsers[teamName]; ok {
-				delete(usersSet, username)
-				if len(usersSet) == 0 {
-					delete(gha.teamToUsers, teamName)
-				}
-			}
-		}
-		delete(sessionIDs, sessionID)
-		if len(sessionIDs) == 0 {
-			delete(gha.userSessionIDs, username)
-		}
-	}
+	// marking the session destroys it, the session index forgets
+	// it along
+	echosessions.GetSession(ctx).Mark()
 }
 
 type (
@@ -601,26 +659,11 @@ func (gha *githubAuth) loginWebhookAuthorizationEvent(ctx echo.Context, payloadR
 }
 
 func (gha *githubAuth) stealUserSessionIDs(username string) []string {
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	var userSessionIDs []string
-	if sessionIDsAndTeams, ok := gha.userSessionIDs[username]; ok {
-		userSessionIDs = make([]string, 0, len(sessionIDsAndTeams))
-		for sessionID, teamData := range sessionIDsAndTeams {
-			userSessionIDs = append(userSessionIDs, sessionID)
-			if teamData.team != nil {
-				teamName := makeTeamName(teamData.org, *teamData.team)
-				if usersSet, ok := gha.teamToUsers[teamName]; ok {
-					delete(usersSet, username)
-					if len(usersSet) == 0 {
-						delete(gha.teamToUsers, teamName)
-					}
-				}
-			}
-		}
-		delete(gha.userSessionIDs, username)
+	sessionIDs, err := gha.sessions.RemoveGithubUserSessions(username)
+	if err != nil {
+		l.Error().Err(err).Str("username", username).Msg("webhook failed to get the sessions of user")
 	}
-	return userSessionIDs
+	return sessionIDs
 }
 
 func (gha *githubAuth) loginWebhookOrganizationEvent(ctx echo.Context, payloadReader io.Reader) {
@@ -645,26 +688,11 @@ func (gha *githubAuth) loginWebhookOrganizationEvent(ctx echo.Context, payloadRe
 }
 
 func (gha *githubAuth) stealUserSessionIDsForOrg(username, org string) []string {
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	var userSessionIDs []string
-	if sessionIDsAndTeams, ok := gha.userSessionIDs[username]; ok {
-		var toDrop []string
-		for sessionID, teamData := range sessionIDsAndTeams {
-			if teamData.org == org && teamData.team == nil {
-				userSessionIDs = append(userSessionIDs, sessionID)
-				toDrop = append(toDrop, sessionID)
-			}
-		}
-		for _, sessionID := range toDrop {
-			delete(sessionIDsAndTeams, sessionID)
-		}
-		if len(sessionIDsAndTeams) == 0 {
-			l.Debug().Str("dropped all the sessions of user", username).Msg("webhook")
-			delete(gha.userSessionIDs, username)
-		}
+	sessionIDs, err := gha.sessions.RemoveGithubUserOrgSessions(username, org)
+	if err != nil {
+		l.Error().Err(err).Str("username", username).Str("org", org).Msg("webhook failed to get the sessions of user")
 	}
-	return userSessionIDs
+	return sessionIDs
 }
 
 func (gha *githubAuth) loginWebhookMembershipEvent(ctx echo.Context, payloadReader io.Reader) {
@@ -711,34 +739,11 @@ func (gha *githubAuth) loginWebhookMembershipEvent(ctx echo.Context, payloadRead
 }
 
 func (gha *githubAuth) stealUserSessionIDsForOrgAndTeam(username, org, team string) []string {
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	var userSessionIDs []string
-
-	if sessionIDsAndTeams, ok := gha.userSessionIDs[username]; ok {
-		var toDrop []string
-		for sessionID, teamData := range sessionIDsAndTeams {
-			if teamData.org == org && teamData.team != nil && *teamData.team == team {
-				userSessionIDs = append(userSessionIDs, sessionID)
-				toDrop = append(toDrop, sessionID)
-				teamName := makeTeamName(teamData.org, *teamData.team)
-				if usersSet, ok := gha.teamToUsers[teamName]; ok {
-					delete(usersSet, username)
-					if len(usersSet) == 0 {
-						delete(gha.teamToUsers, teamName)
-					}
-				}
-			}
-		}
-		for _, sessionID := range toDrop {
-			delete(sessionIDsAndTeams, sessionID)
-		}
-		if len(sessionIDsAndTeams) == 0 {
-			l.Debug().Str("dropped all the sessions of user", username).Msg("webhook")
-			delete(gha.userSessionIDs, username)
-		}
+	sessionIDs, err := gha.sessions.RemoveGithubUserTeamSessions(username, org, team)
+	if err != nil {
+		l.Error().Err(err).Str("username", username).Str("team", makeTeamName(org, team)).Msg("webhook failed to get the sessions of user")
 	}
-	return userSessionIDs
+	return sessionIDs
 }
 
 func (gha *githubAuth) loginWebhookTeamEvent(ctx echo.Context, payloadReader io.Reader) {
@@ -772,30 +777,11 @@ func (gha *githubAuth) loginWebhookTeamEvent(ctx echo.Context, payloadReader io.
 }
 
 func (gha *githubAuth) stealSessionIDsForOrgAndTeam(org, team string) []string {
-	gha.userInfoLock.Lock()
-	defer gha.userInfoLock.Unlock()
-	var userSessionIDs []string
-	teamName := makeTeamName(org, team)
-	for username := range gha.teamToUsers[teamName] {
-		if sessionIDsAndTeams, ok := gha.userSessionIDs[username]; ok {
-			var toDrop []string
-			for sessionID, teamData := range sessionIDsAndTeams {
-				if teamData.org == org && teamData.team != nil && *teamData.team == team {
-					userSessionIDs = append(userSessionIDs, sessionID)
-					toDrop = append(toDrop, sessionID)
-				}
-			}
-			for _, sessionID := range toDrop {
-				delete(sessionIDsAndTeams, sessionID)
-			}
-			if len(sessionIDsAndTeams) == 0 {
-				l.Debug().Str("dropped all the sessions of user", username).Msg("webhook")
-				delete(gha.userSessionIDs, username)
-			}
-		}
+	sessionIDs, err := gha.sessions.RemoveGithubTeamSessions(org, team)
+	if err != nil {
+		l.Error().Err(err).Str("team", makeTeamName(org, team)).Msg("webhook failed to get the sessions of team")
 	}
-	delete(gha.teamToUsers, teamName)
-	return userSessionIDs
+	return sessionIDs
 }
 
 func makeTeamName(org, team string) string {
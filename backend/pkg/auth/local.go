@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/sessions"
+	echosessions "github.com/flatcar/nebraska/backend/pkg/sessions/echo"
+)
+
+const (
+	// loginFailuresBeforeLock is the number of consecutive failed logins
+	// after which the logins of a user are locked.
+	loginFailuresBeforeLock = 5
+	// loginLockDuration is how long the logins of a user are locked for
+	// once it reaches loginFailuresBeforeLock failed logins. It doubles
+	// with every further failure, up to maxLoginLockDuration.
+	loginLockDuration    = time.Minute
+	maxLoginLockDuration = time.Hour
+	// loginFailuresTTL is how long the failed logins of a user are
+	// remembered for after the last one.
+	loginFailuresTTL = 24 * time.Hour
+)
+
+type (
+	LocalAuthConfig struct {
+		SessionStore *sessions.Store
+		Users        LocalUsers
+	}
+
+	// LocalUsers looks up the local users, who log in with the password
+	// stored in the database.
+	LocalUsers interface {
+		// AuthenticateLocalUser reports whether the password provided
+		// is the one of the user provided.
+		AuthenticateLocalUser(username, password string) (bool, error)
+		// GetLocalUser returns the team and the role of the user
+		// provided, and a stamp that changes along with its password,
+		// or empty values if the user doesn't exist.
+		GetLocalUser(username string) (teamID, role, stamp string, err error)
+		// GetLocalUserLoginLock returns when the logins of the user
+		// provided are unlocked, or the zero time if they never were
+		// locked.
+		GetLocalUserLoginLock(username string) (time.Time, error)
+		// RecordLocalUserLoginFailure records a failed login of the
+		// user provided and returns its number of consecutive failed
+		// logins, starting over when the previous one is older than
+		// forgetAfter, or 0 if the user doesn't exist.
+		RecordLocalUserLoginFailure(username string, at time.Time, forgetAfter time.Duration) (int, error)
+		// LockLocalUserLogins locks the logins of the user provided
+		// until the time provided.
+		LockLocalUserLogins(username string, until time.Time) error
+		// ResetLocalUserLoginFailures forgets the failed logins of the
+		// user provided.
+		ResetLocalUserLoginFailures(username string) error
+	}
+
+	localCredentials struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+
+	localAuth struct {
+		sessionsStore *sessions.Store
+		users         LocalUsers
+		now           func() time.Time
+	}
+)
+
+// NewLocalAuthenticator is an authenticator that lets in the local users
+// once they logged in with their username and password. The session of the
+// users is checked against the database on each request, so that deleting a
+// user, changing its role or its password takes effect right away.
+func NewLocalAuthenticator(config *LocalAuthConfig) Authenticator {
+	return &localAuth{
+		sessionsStore: config.SessionStore,
+		users:         config.Users,
+		now:           time.Now,
+	}
+}
+
+// Authorize is a part of the Authenticator interface implementation.
+func (la *localAuth) Authorize(c echo.Context) (teamID string, replied bool) {
+	session := echosessions.GetSession(c)
+	username, ok := session.Get("username").(string)
+	if !ok {
+		httpError(c, http.StatusUnauthorized)
+		return "", true
+	}
+
+	teamID, role, stamp, err := la.users.GetLocalUser(username)
+	if err != nil {
+		l.Error().Err(err).Str("username", username).Msg("authorize - getting local user")
+		httpError(c, http.StatusInternalServerError)
+		return "", true
+	}
+	if teamID == "" || stamp != session.Get("stamp") {
+		l.Debug().Str("username", username).Msg("authorize - dropping the session of a deleted user or of an old password")
+		session.Mark()
+		sessionSave(c, session, "authorize")
+		httpError(c, http.StatusUnauthorized)
+		return "", true
+	}
+
+	switch role {
+	case "admin":
+		c.Set("access_level", AccessLevelAdmin)
+	case "viewer":
+		c.Set("access_level", AccessLevelViewer)
+	default:
+		c.Set("access_level", "")
+	}
+	return teamID, false
+}
+
+// Login checks the username and the password of the user and starts a new
+// session for it. The logins of a user are locked for a while after too many
+// consecutive failures, so that its password can't be guessed. The failures
+// are recorded in the database, so that the lock applies to all the replicas.
+func (la *localAuth) Login(c echo.Context) error {
+	var credentials localCredentials
+	if err := c.Bind(&credentials); err != nil {
+		return c.NoContent(http.StatusBadRequest)
+	}
+	if credentials.Username == "" || credentials.Password == "" {
+		return c.NoContent(http.StatusBadRequest)
+	}
+
+	lockedUntil, err := la.users.GetLocalUserLoginLock(credentials.Username)
+	if err != nil {
+		l.Error().Err(err).Str("username", credentials.Username).Msg("login - getting login lock")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	if lockedUntil.After(la.now()) {
+		l.Warn().Str("username", credentials.Username).Str("ip", c.RealIP()).Time("lockedUntil", lockedUntil).Msg("login - rejected locked user")
+		return tooManyLoginsResponse(c, lockedUntil.Sub(la.now()))
+	}
+
+	ok, err := la.users.AuthenticateLocalUser(credentials.Username, credentials.Password)
+	if err != nil {
+		l.Error().Err(err).Str("username", credentials.Username).Msg("login - authenticating local user")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	if !ok {
+		failures, lockedUntil, err := la.recordLoginFailure(credentials.Username)
+		if err != nil {
+			l.Error().Err(err).Str("username", credentials.Username).Msg("login - recording failed login")
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		l.Warn().Str("username", credentials.Username).Str("ip", c.RealIP()).Int("failures", failures).Msg("login - invalid credentials")
+		if !lockedUntil.IsZero() {
+			return tooManyLoginsResponse(c, lockedUntil.Sub(la.now()))
+		}
+		return c.JSON(http.StatusUnauthorized, map[string]any{
+			"error":       "invalid_credentials",
+			"description": "The username or the password is wrong.",
+		})
+	}
+	if err := la.users.ResetLocalUserLoginFailures(credentials.Username); err != nil {
+		l.Error().Err(err).Str("username", credentials.Username).Msg("login - resetting failed logins")
+	}
+	teamID, role, stamp, err := la.users.GetLocalUser(credentials.Username)
+	if err != nil {
+		l.Error().Err(err).Str("username", credentials.Username).Msg("login - getting local user")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	session := la.renewSession(c)
+	defer la.sessionsStore.PutSessionUse(session)
+	session.Set("username", credentials.Username)
+	session.Set("stamp", stamp)
+	if err := echosessions.SaveSession(c, session); err != nil {
+		l.Error().Err(err).Str("username", credentials.Username).Msg("login - saving session")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	l.Info().Str("username", credentials.Username).Msg("login - local user logged in")
+	return c.JSON(http.StatusOK, map[string]any{
+		"username": credentials.Username,
+		"role":     role,
+		"team_id":  teamID,
+	})
+}
+
+// recordLoginFailure records a failed login of the user provided, locking its
+// logins once it failed too many times in a row. It returns the number of
+// consecutive failures and when the logins of the user are unlocked, or the
+// zero time if they aren't locked.
+func (la *localAuth) recordLoginFailure(username string) (int, time.Time, error) {
+	now := la.now()
+	failures, err := la.users.RecordLocalUserLoginFailure(username, now, loginFailuresTTL)
+	if err != nil || failures < loginFailuresBeforeLock {
+		return failures, time.Time{}, err
+	}
+
+	lock := maxLoginLockDuration
+	if doublings := failures - loginFailuresBeforeLock; doublings < 10 {
+		lock = min(loginLockDuration<<doublings, maxLoginLockDuration)
+	}
+	lockedUntil := now.Add(lock)
+	if err := la.users.LockLocalUserLogins(username, lockedUntil); err != nil {
+		return failures, time.Time{}, err
+	}
+	return failures, lockedUntil, nil
+}
+
+func tooManyLoginsResponse(c echo.Context, retryAfter time.Duration) error {
+	c.Response().Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second).Seconds())))
+	return c.JSON(http.StatusTooManyRequests, map[string]any{
+		"error":       "too_many_login_attempts",
+		"description": "Too many failed logins, try again later.",
+	})
+}
+
+// renewSession destroys the session the request came with, if any, and
+// returns a new one, so that a session planted in the browser of the user
+// can't be logged in. The use of the returned session has to be put.
+func (la *localAuth) renewSession(c echo.Context) *sessions.Session {
+	oldSession := echosessions.GetSession(c)
+	oldSession.Mark()
+	request := c.Request().Clone(c.Request().Context())
+	request.Header.Del("Cookie")
+	session := la.sessionsStore.GetSessionUse(request, oldSession.Name())
+	c.Set("session", session)
+	return session
+}
+
+// Logout destroys the session of the user.
+func (la *localAuth) Logout(c echo.Context) error {
+	session := echosessions.GetSession(c)
+	session.Mark()
+	sessionSave(c, session, "logout")
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (la *localAuth) LoginCb(c echo.Context) error {
+	return c.JSON(http.StatusNotImplemented, map[string]any{
+		"error":       "login_callback_not_supported",
+		"description": "OAuth callback flow is not supported in local mode. The users log in with their username and password.",
+	})
+}
+
+func (la *localAuth) ValidateToken(c echo.Context) error {
+	return c.JSON(http.StatusNotImplemented, map[string]any{
+		"error":       "validate_token_not_supported",
+		"description": "Token validation is not supported in local mode. Local mode uses session-based authentication.",
+	})
+}
+
+func (la *localAuth) LoginWebhook(c echo.Context) error {
+	return c.JSON(http.StatusNotImplemented, map[string]any{
+		"error":       "webhook_not_supported",
+		"description": "Webhooks are not supported in local mode. Webhooks are only used with GitHub authentication.",
+	})
+}
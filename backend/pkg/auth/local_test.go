@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/sessions"
+	echosessions "github.com/flatcar/nebraska/backend/pkg/sessions/echo"
+	"github.com/flatcar/nebraska/backend/pkg/sessions/memcache"
+	"github.com/flatcar/nebraska/backend/pkg/sessions/memcache/gob"
+	"github.com/flatcar/nebraska/backend/pkg/sessions/securecookie"
+)
+
+type mockLocalUser struct {
+	password    string
+	teamID      string
+	role        string
+	stamp       string
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+type mockLocalUsers map[string]*mockLocalUser
+
+func (m mockLocalUsers) AuthenticateLocalUser(username, password string) (bool, error) {
+	user, ok := m[username]
+	return ok && user.password == password, nil
+}
+
+func (m mockLocalUsers) GetLocalUser(username string) (string, string, string, error) {
+	user, ok := m[username]
+	if !ok {
+		return "", "", "", nil
+	}
+	return user.teamID, user.role, user.stamp, nil
+}
+
+func (m mockLocalUsers) GetLocalUserLoginLock(username string) (time.Time, error) {
+	user, ok := m[username]
+	if !ok {
+		return time.Time{}, nil
+	}
+	return user.lockedUntil, nil
+}
+
+func (m mockLocalUsers) RecordLocalUserLoginFailure(username string, at time.Time, forgetAfter time.Duration) (int, error) {
+	user, ok := m[username]
+	if !ok {
+		return 0, nil
+	}
+	if user.lastFailure.Before(at.Add(-forgetAfter)) {
+		user.failures = 0
+	}
+	user.failures++
+	user.lastFailure = at
+	return user.failures, nil
+}
+
+func (m mockLocalUsers) LockLocalUserLogins(username string, until time.Time) error {
+	if user, ok := m[username]; ok {
+		user.lockedUntil = until
+	}
+	return nil
+}
+
+func (m mockLocalUsers) ResetLocalUserLoginFailures(username string) error {
+	if user, ok := m[username]; ok {
+		user.failures, user.lastFailure, user.lockedUntil = 0, time.Time{}, time.Time{}
+	}
+	return nil
+}
+
+func newLocalAuthTestServer(users mockLocalUsers) *echo.Echo {
+	e, _ := newLocalAuthTestServerWithAuth(users)
+	return e
+}
+
+func newLocalAuthTestServerWithAuth(users mockLocalUsers) (*echo.Echo, *localAuth) {
+	store := sessions.NewStore(memcache.New(gob.New()), securecookie.New([]byte("auth-key"), []byte("crypt-key-of-32-bytes-0123456789")))
+	authenticator := NewLocalAuthenticator(&LocalAuthConfig{SessionStore: store, Users: users}).(*localAuth)
+
+	e := echo.New()
+	e.Use(echosessions.SessionsMiddleware(store, "local"))
+	e.POST("/login", authenticator.Login)
+	e.POST("/logout", authenticator.Logout)
+	e.GET("/api", func(c echo.Context) error {
+		teamID, replied := authenticator.Authorize(c)
+		if replied {
+			return nil
+		}
+		return c.String(http.StatusOK, teamID+" "+c.Get("access_level").(string))
+	})
+	return e, authenticator
+}
+
+func localAuthDo(e *echo.Echo, method, path, body string, cookies []*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLocalAuth(t *testing.T) {
+	users := mockLocalUsers{
+		"admin": {password: "admin-password", teamID: "team1", role: "admin", stamp: "stamp1"},
+	}
+	e := newLocalAuthTestServer(users)
+
+	rec := localAuthDo(e, http.MethodGet, "/api", "", nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin"}`, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, rec.Result().Cookies())
+
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"team_id":"team1"`)
+	cookies := rec.Result().Cookies()
+	require.NotEmpty(t, cookies)
+
+	rec = localAuthDo(e, http.MethodGet, "/api", "", cookies)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "team1 "+AccessLevelAdmin, rec.Body.String())
+
+	// logging in again starts a new session and destroys the old one
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, cookies)
+	require.Equal(t, http.StatusOK, rec.Code)
+	newCookies := rec.Result().Cookies()
+	require.NotEmpty(t, newCookies)
+	assert.NotEqual(t, cookies[0].Value, newCookies[0].Value)
+	rec = localAuthDo(e, http.MethodGet, "/api", "", cookies)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = localAuthDo(e, http.MethodPost, "/logout", "", newCookies)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = localAuthDo(e, http.MethodGet, "/api", "", newCookies)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestLocalAuth_PasswordChangeEndsSessions(t *testing.T) {
+	users := mockLocalUsers{
+		"viewer": {password: "viewer-password", teamID: "team1", role: "viewer", stamp: "stamp1"},
+	}
+	e := newLocalAuthTestServer(users)
+
+	rec := localAuthDo(e, http.MethodPost, "/login", `{"username":"viewer","password":"viewer-password"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	cookies := rec.Result().Cookies()
+
+	rec = localAuthDo(e, http.MethodGet, "/api", "", cookies)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "team1 "+AccessLevelViewer, rec.Body.String())
+
+	users["viewer"].stamp = "stamp2"
+	rec = localAuthDo(e, http.MethodGet, "/api", "", cookies)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// the session isn't valid anymore even if the stamp is back
+	users["viewer"].stamp = "stamp1"
+	rec = localAuthDo(e, http.MethodGet, "/api", "", cookies)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestLocalAuth_LoginLock(t *testing.T) {
+	users := mockLocalUsers{
+		"admin":  {password: "admin-password", teamID: "team1", role: "admin", stamp: "stamp1"},
+		"viewer": {password: "viewer-password", teamID: "team1", role: "viewer", stamp: "stamp1"},
+	}
+	e, authenticator := newLocalAuthTestServerWithAuth(users)
+	now := time.Now()
+	authenticator.now = func() time.Time { return now }
+
+	// failures are forgotten after a successful login
+	for i := 0; i < loginFailuresBeforeLock-1; i++ {
+		rec := localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+	rec := localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	for i := 0; i < loginFailuresBeforeLock-1; i++ {
+		rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+
+	// the right password is refused while the user is locked, but other
+	// users can still log in
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"viewer","password":"viewer-password"}`, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// the lock doubles with every further failure
+	now = now.Add(loginLockDuration)
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "120", rec.Header().Get("Retry-After"))
+
+	now = now.Add(2 * loginLockDuration)
+	rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// the lock applies to all the replicas sharing the users
+	for i := 0; i < loginFailuresBeforeLock; i++ {
+		rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"admin","password":"wrong-password"}`, nil)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	otherReplica, otherAuthenticator := newLocalAuthTestServerWithAuth(users)
+	otherAuthenticator.now = authenticator.now
+	rec = localAuthDo(otherReplica, http.MethodPost, "/login", `{"username":"admin","password":"admin-password"}`, nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	// failed logins of unknown users aren't tracked
+	for i := 0; i < loginFailuresBeforeLock; i++ {
+		rec = localAuthDo(e, http.MethodPost, "/login", `{"username":"unknown","password":"wrong-password"}`, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+	assert.NotContains(t, users, "unknown")
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -22,6 +23,15 @@ type OIDCAuthConfig struct {
 	RolesPath     string
 	UseUserInfo   bool
 	HTTPClient    *http.Client
+	// RoleBindings lets in the users whose roles are bound to some
+	// applications, without being admin or viewer roles. Only the admin and
+	// viewer roles are accepted when nil.
+	RoleBindings RoleBindingChecker
+	// TeamsPath is the path of the claim whose values map the users to
+	// the teams through Teams. All the users belong to the default team
+	// when empty.
+	TeamsPath string
+	Teams     TeamResolver
 }
 
 type oidcAuth struct {
@@ -34,6 +44,9 @@ type oidcAuth struct {
 	rolesPath     string
 	useUserInfo   bool
 	httpClient    *http.Client
+	roleBindings  RoleBindingChecker
+	teamsPath     string
+	teams         TeamResolver
 }
 
 func NewOIDCAuthenticator(config *OIDCAuthConfig) (Authenticator, error) {
@@ -68,6 +81,9 @@ func NewOIDCAuthenticator(config *OIDCAuthConfig) (Authenticator, error) {
 		rolesPath:     config.RolesPath,
 		useUserInfo:   config.UseUserInfo,
 		httpClient:    config.HTTPClient,
+		roleBindings:  config.RoleBindings,
+		teamsPath:     config.TeamsPath,
+		teams:         config.Teams,
 	}
 
 	return oidcAuthenticator, nil
@@ -119,6 +135,16 @@ func (oa *oidcAuth) Login(c echo.Context) error {
 	})
 }
 
+// Logout is not used in the new OIDC architecture
+// Frontend ends the session directly with OIDC provider
+func (oa *oidcAuth) Logout(c echo.Context) error {
+	return c.JSON(http.StatusNotImplemented, map[string]any{
+		"error":       "logout_endpoint_not_supported",
+		"description": "Server-side logout is not supported in OIDC mode. The frontend ends the session directly with the identity provider.",
+		"docs":        "See OIDC migration guide for proper configuration.",
+	})
+}
+
 // tokenFromRequest extracts token from request header.
 func tokenFromRequest(c echo.Context) string {
 	token := c.Request().Header.Get("Authorization")
@@ -130,25 +156,22 @@ func tokenFromRequest(c echo.Context) string {
 }
 
 // rolesFromToken extracts roles from JWT access token claims
-func rolesFromToken(token *oidc.IDToken, rolesPath string) ([]string, error) {
+// rolesFromClaims returns the roles found at rolesPath in the claims
+// provided, read from the source provided.
+func rolesFromClaims(claims map[string]any, source, rolesPath string) ([]string, error) {
 	roles := []string{}
 	if rolesPath == "" {
 		return roles, nil
 	}
 
-	var claims map[string]any
-	if err := token.Claims(&claims); err != nil {
-		return roles, err
-	}
-
 	claimsJSON, err := json.Marshal(claims)
 	if err != nil {
-		return roles, err
+		return roles, fmt.Errorf("failed to marshal %s claims: %w", source, err)
 	}
 
 	result := gjson.GetBytes(claimsJSON, rolesPath)
 	if !result.Exists() {
-		return roles, fmt.Errorf("token does not contain roles at path '%s'", rolesPath)
+		return roles, fmt.Errorf("%s does not contain roles at path '%s'", source, rolesPath)
 	}
 	result.ForEach(func(_, value gjson.Result) bool {
 		roles = append(roles, value.String())
@@ -158,11 +181,40 @@ func rolesFromToken(token *oidc.IDToken, rolesPath string) ([]string, error) {
 	return roles, nil
 }
 
+// usernameFromToken returns the identity of the user the JWT access token was
+// issued to, recorded in the logs and the audit log: its preferred username or
+// email when available, its subject otherwise.
+func usernameFromToken(token *oidc.IDToken) string {
+	var claims struct {
+		PreferredUsername string `json:"preferred_username"`
+		Email             string `json:"email"`
+	}
+	if err := token.Claims(&claims); err == nil {
+		if claims.PreferredUsername != "" {
+			return claims.PreferredUsername
+		}
+		if claims.Email != "" {
+			return claims.Email
+		}
+	}
+	return token.Subject
+}
+
 // rolesFromUserInfo calls the OIDC providers userinfo endpoint to get user roles
-func (oa *oidcAuth) rolesFromUserInfo(ctx context.Context, rawToken string, rolesPath string) ([]string, error) {
-	roles := []string{}
-	if rolesPath == "" {
-		return roles, nil
+// claims returns the claims of the user, read from the userinfo endpoint in
+// userinfo mode and from the access token otherwise, along with their source.
+// The userinfo endpoint is only queried when the roles or the teams are read
+// from the claims.
+func (oa *oidcAuth) claims(ctx context.Context, rawToken string, token *oidc.IDToken) (map[string]any, string, error) {
+	var claims map[string]any
+	if !oa.useUserInfo {
+		if err := token.Claims(&claims); err != nil {
+			return nil, "", err
+		}
+		return claims, "token", nil
+	}
+	if oa.rolesPath == "" && (oa.teamsPath == "" || oa.teams == nil) {
+		return nil, "userinfo", nil
 	}
 
 	if oa.httpClient != nil {
@@ -174,29 +226,12 @@ func (oa *oidcAuth) rolesFromUserInfo(ctx context.Context, rawToken string, role
 		TokenType:   "Bearer",
 	}))
 	if err != nil {
-		return roles, fmt.Errorf("error loading userinfo: %w", err)
+		return nil, "", fmt.Errorf("error loading userinfo: %w", err)
 	}
-
-	var claims map[string]any
 	if err := userInfo.Claims(&claims); err != nil {
-		return roles, fmt.Errorf("failed to decode userinfo claims: %w", err)
-	}
-
-	claimsJSON, err := json.Marshal(claims)
-	if err != nil {
-		return roles, fmt.Errorf("failed to marshal userinfo claims: %w", err)
-	}
-
-	result := gjson.GetBytes(claimsJSON, rolesPath)
-	if !result.Exists() {
-		return roles, fmt.Errorf("userinfo does not contain roles at path '%s'", rolesPath)
+		return nil, "", fmt.Errorf("failed to decode userinfo claims: %w", err)
 	}
-	result.ForEach(func(_, value gjson.Result) bool {
-		roles = append(roles, value.String())
-		return true
-	})
-
-	return roles, nil
+	return claims, "userinfo", nil
 }
 
 // determineAccessLevel determines user access level based on roles
@@ -206,10 +241,10 @@ func (oa *oidcAuth) determineAccessLevel(roles []string) string {
 	// Check and set access level
 	for _, role := range roles {
 		if slices.Contains(oa.adminRoles, role) {
-			return "admin"
+			return AccessLevelAdmin
 		}
-		if accessLevel != "viewer" && slices.Contains(oa.viewerRoles, role) {
-			accessLevel = "viewer"
+		if accessLevel != AccessLevelViewer && slices.Contains(oa.viewerRoles, role) {
+			accessLevel = AccessLevelViewer
 		}
 	}
 
@@ -236,48 +271,94 @@ func (oa *oidcAuth) Authorize(c echo.Context) (teamID string, replied bool) {
 		return "", true
 	}
 
-	var roles []string
-	if oa.useUserInfo {
-		// Extract roles from UserInfo endpoint
-		roles, err = oa.rolesFromUserInfo(ctx, token, oa.rolesPath)
+	c.Set("username", usernameFromToken(accessToken))
+
+	claims, source, err := oa.claims(ctx, token, accessToken)
+	if err != nil {
+		l.Error().Str("request_id", requestID).AnErr("error", err).Msg("Can't read the user claims")
+		httpError(c, http.StatusInternalServerError)
+		return "", true
+	}
+	roles, err := rolesFromClaims(claims, source, oa.rolesPath)
+	if err != nil {
+		l.Error().Str("request_id", requestID).AnErr("error", err).Msgf("Can't extract roles from %s", source)
+		httpError(c, http.StatusInternalServerError)
+		return "", true
+	}
+
+	accessLevel := oa.determineAccessLevel(roles)
+
+	// If access level is empty and no role is bound to an application then
+	// return an error
+	if accessLevel == "" {
+		bound, err := oa.hasRoleBindings(roles)
 		if err != nil {
-			l.Error().Str("request_id", requestID).AnErr("error", err).Msg("Can't extract roles from userinfo")
+			l.Error().Str("request_id", requestID).AnErr("error", err).Msg("Can't check the role bindings")
 			httpError(c, http.StatusInternalServerError)
 			return "", true
 		}
-	} else {
-		// Extract roles from access token claims
-		roles, err = rolesFromToken(accessToken, oa.rolesPath)
-		if err != nil {
-			l.Error().Str("request_id", requestID).AnErr("error", err).Msg("Can't extract roles from access token")
-			httpError(c, http.StatusInternalServerError)
+		if !bound {
+			l.Debug().
+				Str("request_id", requestID).
+				Strs("roles_found", roles).
+				Str("roles_path", oa.rolesPath).
+				Strs("admin_roles", oa.adminRoles).
+				Strs("viewer_roles", oa.viewerRoles).
+				Bool("use_userinfo", oa.useUserInfo).
+				Msg("User roles do not match any configured admin or viewer roles nor role binding")
+			httpError(c, http.StatusForbidden)
 			return "", true
 		}
 	}
 
-	accessLevel := oa.determineAccessLevel(roles)
-
-	// If access level is empty or doesn't match role scope then return an error
-	if accessLevel == "" {
-		l.Debug().
-			Str("request_id", requestID).
-			Strs("roles_found", roles).
-			Str("roles_path", oa.rolesPath).
-			Strs("admin_roles", oa.adminRoles).
-			Strs("viewer_roles", oa.viewerRoles).
-			Bool("use_userinfo", oa.useUserInfo).
-			Msg("User roles do not match any configured admin or viewer roles")
+	teamID, err = oa.resolveTeam(claims)
+	if errors.Is(err, errUserNotMapped) || errors.Is(err, errAmbiguousTeam) {
+		l.Warn().Str("request_id", requestID).AnErr("error", err).Str("teams_path", oa.teamsPath).Msg("User not mapped to a single team")
 		httpError(c, http.StatusForbidden)
 		return "", true
-	} else if accessLevel != "admin" {
-		if c.Request().Method != "HEAD" && c.Request().Method != "GET" {
-			l.Error().Str("request_id", requestID).Msg("User doesn't have admin access")
-			httpError(c, http.StatusForbidden)
-			return "", true
-		}
+	}
+	if err != nil {
+		l.Error().Str("request_id", requestID).AnErr("error", err).Msg("Can't map the user to a team")
+		httpError(c, http.StatusInternalServerError)
+		return "", true
+	}
+
+	// The handlers check that the access level and the role bindings allow
+	// the request.
+	c.Set("access_level", accessLevel)
+	c.Set("role_subjects", roles)
+
+	return teamID, false
+}
+
+// resolveTeam returns the id of the team the user is mapped to by the values
+// of the teams claim. All the users belong to the default team when no teams
+// claim is configured.
+func (oa *oidcAuth) resolveTeam(claims map[string]any) (string, error) {
+	if oa.teamsPath == "" || oa.teams == nil {
+		return oa.defaultTeamID, nil
 	}
 
-	return oa.defaultTeamID, false
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	gjson.GetBytes(claimsJSON, oa.teamsPath).ForEach(func(_, value gjson.Result) bool {
+		values = append(values, value.String())
+		return true
+	})
+
+	return resolveTeam(oa.teams, "oidc_claim", values, oa.defaultTeamID)
+}
+
+// hasRoleBindings reports whether any of the roles provided is bound to an
+// application.
+func (oa *oidcAuth) hasRoleBindings(roles []string) (bool, error) {
+	if oa.roleBindings == nil || len(roles) == 0 {
+		return false, nil
+	}
+	return oa.roleBindings.HasRoleBindings("oidc_role", roles)
 }
 
 func (oa *oidcAuth) LoginWebhook(ctx echo.Context) error {
@@ -17,7 +17,7 @@ func TestMain(m *testing.M) {
 }
 
 // TestRolesPathExtraction tests the gjson path extraction logic used in both
-// rolesFromToken and rolesFromUserInfo
+// rolesFromClaims
 func TestRolesPathExtraction(t *testing.T) {
 	tests := []struct {
 		name          string
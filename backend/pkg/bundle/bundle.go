@@ -0,0 +1,164 @@
+// Package bundle implements the export and import of application bundles,
+// signed tarballs containing an application's packages, Flatcar actions,
+// channels, floors and optionally the packages payloads. Bundles are meant to
+// move updates into disconnected (air-gapped) Nebraska instances.
+//
+// A bundle is a gzipped tarball whose first entry is the manifest
+// (manifest.json), followed by the manifest ed25519 signature
+// (manifest.json.sig) and then the payload files under payloads/. Payloads
+// integrity is guaranteed through the sha256 hashes listed in the signed
+// manifest.
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+)
+
+const (
+	manifestName    = "manifest.json"
+	signatureName   = "manifest.json.sig"
+	payloadsDir     = "payloads/"
+	manifestVersion = 1
+)
+
+var (
+	l = logger.New("bundle")
+
+	// ErrInvalidBundle error indicates that the bundle provided is malformed.
+	ErrInvalidBundle = errors.New("bundle: invalid bundle")
+
+	// ErrInvalidSignature error indicates that the bundle manifest signature
+	// could not be verified with the public key provided.
+	ErrInvalidSignature = errors.New("bundle: invalid manifest signature")
+
+	// ErrUnsupportedVersion error indicates that the bundle manifest version
+	// is not supported by this Nebraska version.
+	ErrUnsupportedVersion = errors.New("bundle: unsupported manifest version")
+
+	// ErrPayloadHashMismatch error indicates that a payload in the bundle
+	// doesn't match the hash listed in the manifest.
+	ErrPayloadHashMismatch = errors.New("bundle: payload hash mismatch")
+
+	// ErrMissingPayload error indicates that a payload listed in the bundle
+	// manifest is not included in the bundle.
+	ErrMissingPayload = errors.New("bundle: missing payload")
+)
+
+// Manifest represents the content of a bundle. Channels are referenced by
+// name and arch and packages by version and arch, so that they can be
+// matched against the entities of the instance the bundle is imported into.
+type Manifest struct {
+	Version     int         `json:"version"`
+	CreatedTs   time.Time   `json:"created_ts"`
+	Application Application `json:"application"`
+	Channels    []Channel   `json:"channels"`
+	Packages    []Package   `json:"packages"`
+	Floors      []Floor     `json:"floors"`
+	Payloads    []Payload   `json:"payloads"`
+}
+
+// Application represents the application a bundle was exported from.
+type Application struct {
+	ID          string      `json:"id"`
+	ProductID   null.String `json:"product_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+}
+
+// Channel represents an application's channel in a bundle.
+type Channel struct {
+	Name           string   `json:"name"`
+	Color          string   `json:"color"`
+	Arch           api.Arch `json:"arch"`
+	PackageVersion string   `json:"package_version,omitempty"`
+}
+
+// Package represents an application's package in a bundle. The blacklisted
+// channels are referenced by name, they share the package arch.
+type Package struct {
+	Type              int                `json:"type"`
+	Version           string             `json:"version"`
+	URL               string             `json:"url"`
+	Filename          null.String        `json:"filename"`
+	Description       null.String        `json:"description"`
+	Size              null.String        `json:"size"`
+	Hash              null.String        `json:"hash"`
+	Arch              api.Arch           `json:"arch"`
+	ChannelsBlacklist []string           `json:"channels_blacklist"`
+	FlatcarAction     *api.FlatcarAction `json:"flatcar_action"`
+	ExtraFiles        []api.File         `json:"extra_files"`
+}
+
+// Floor represents a floor package of a channel in a bundle.
+type Floor struct {
+	Channel        string      `json:"channel"`
+	Arch           api.Arch    `json:"arch"`
+	PackageVersion string      `json:"package_version"`
+	Reason         null.String `json:"reason"`
+}
+
+// Payload represents a payload file included in a bundle.
+type Payload struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// LoadPrivateKey reads a PEM encoded (PKCS #8) ed25519 private key from the
+// path provided, as generated by `openssl genpkey -algorithm ed25519`.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key %s: %w", path, err)
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key %s is not an ed25519 key", path)
+	}
+	return privateKey, nil
+}
+
+// LoadPublicKey reads a PEM encoded (PKIX) ed25519 public key from the path
+// provided, as generated by `openssl pkey -pubout`.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key %s: %w", path, err)
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key %s is not an ed25519 key", path)
+	}
+	return publicKey, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key %s: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
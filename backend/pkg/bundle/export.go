@@ -0,0 +1,271 @@
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+const exportPageSize = 100
+
+// ExportOptions represents the options used to export a bundle.
+type ExportOptions struct {
+	// AppID is the id or product id of the application to export.
+	AppID string
+
+	// PrivateKey is used to sign the bundle manifest.
+	PrivateKey ed25519.PrivateKey
+
+	// PayloadsPath is the directory where the packages payloads are stored
+	// (e.g. the Flatcar packages path when hosting Flatcar packages). When
+	// empty, no payloads are included in the bundle.
+	PayloadsPath string
+}
+
+// Export writes a signed bundle with the content of the application
+// requested to the writer provided, returning the bundle manifest.
+func Export(db *api.API, w io.Writer, opts ExportOptions) (*Manifest, error) {
+	if len(opts.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("bundle: a valid ed25519 private key is required")
+	}
+
+	manifest, err := buildManifest(db, opts.AppID)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.PayloadsPath != "" {
+		if manifest.Payloads, err = collectPayloads(manifest.Packages, opts.PayloadsPath); err != nil {
+			return nil, err
+		}
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	signature := ed25519.Sign(opts.PrivateKey, manifestData)
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	if err := writeTarEntry(tw, manifestName, manifestData); err != nil {
+		return nil, err
+	}
+	if err := writeTarEntry(tw, signatureName, signature); err != nil {
+		return nil, err
+	}
+	for _, payload := range manifest.Payloads {
+		if err := writeTarFile(tw, payloadsDir+payload.Name, filepath.Join(opts.PayloadsPath, payload.Name), payload.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// buildManifest builds the manifest of the application identified by the id
+// or product id provided.
+func buildManifest(db *api.API, appIDorProductID string) (*Manifest, error) {
+	appID, err := db.GetAppID(appIDorProductID)
+	if err != nil {
+		return nil, err
+	}
+	app, err := db.GetApp(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:   manifestVersion,
+		CreatedTs: time.Now().UTC(),
+		Application: Application{
+			ID:          app.ID,
+			ProductID:   app.ProductID,
+			Name:        app.Name,
+			Description: app.Description,
+		},
+		Channels: []Channel{},
+		Packages: []Package{},
+		Floors:   []Floor{},
+		Payloads: []Payload{},
+	}
+
+	channelsNames := make(map[string]string, len(app.Channels))
+	for _, c := range app.Channels {
+		channelsNames[c.ID] = c.Name
+
+		channel := Channel{
+			Name:  c.Name,
+			Color: c.Color,
+			Arch:  c.Arch,
+		}
+		if c.Package != nil {
+			channel.PackageVersion = c.Package.Version
+		}
+		manifest.Channels = append(manifest.Channels, channel)
+
+		floors, err := db.GetChannelFloorPackages(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, floor := range floors {
+			manifest.Floors = append(manifest.Floors, Floor{
+				Channel:        c.Name,
+				Arch:           c.Arch,
+				PackageVersion: floor.Version,
+				Reason:         floor.FloorReason,
+			})
+		}
+	}
+
+	for page := uint64(1); ; page++ {
+		pkgs, err := db.GetPackages(app.ID, page, exportPageSize, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range pkgs {
+			manifest.Packages = append(manifest.Packages, newPackage(pkg, channelsNames))
+		}
+		if len(pkgs) < exportPageSize {
+			break
+		}
+	}
+
+	return manifest, nil
+}
+
+// newPackage converts the package provided into its bundle representation.
+func newPackage(pkg *api.Package, channelsNames map[string]string) Package {
+	bundlePkg := Package{
+		Type:              pkg.Type,
+		Version:           pkg.Version,
+		URL:               pkg.URL,
+		Filename:          pkg.Filename,
+		Description:       pkg.Description,
+		Size:              pkg.Size,
+		Hash:              pkg.Hash,
+		Arch:              pkg.Arch,
+		ChannelsBlacklist: []string{},
+		FlatcarAction:     pkg.FlatcarAction,
+		ExtraFiles:        pkg.ExtraFiles,
+	}
+	for _, channelID := range pkg.ChannelsBlacklist {
+		if name, ok := channelsNames[channelID]; ok {
+			bundlePkg.ChannelsBlacklist = append(bundlePkg.ChannelsBlacklist, name)
+		}
+	}
+	return bundlePkg
+}
+
+// collectPayloads returns the payload files of the packages provided that are
+// available in payloadsPath. Packages whose payloads aren't stored locally
+// (e.g. served from their original URL) are skipped.
+func collectPayloads(pkgs []Package, payloadsPath string) ([]Payload, error) {
+	payloads := []Payload{}
+	seen := make(map[string]struct{})
+
+	for _, pkg := range pkgs {
+		names := []string{pkg.Filename.String}
+		for _, file := range pkg.ExtraFiles {
+			names = append(names, file.Name.String)
+		}
+
+		for _, name := range names {
+			if name == "" || name != filepath.Base(name) {
+				continue
+			}
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			payload, err := newPayload(filepath.Join(payloadsPath, name))
+			if errors.Is(err, os.ErrNotExist) {
+				l.Warn().Str("version", pkg.Version).Str("file", name).Msg("collectPayloads - payload not found, skipping")
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			payloads = append(payloads, *payload)
+		}
+	}
+
+	return payloads, nil
+}
+
+// newPayload computes the size and hash of the payload file provided.
+func newPayload(path string) (*Payload, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, fmt.Errorf("hashing payload %s: %w", path, err)
+	}
+
+	return &Payload{
+		Name:   filepath.Base(path),
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now().UTC(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func writeTarFile(tw *tar.Writer, name, path string, size int64) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now().UTC(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(tw, f, size); err != nil {
+		return fmt.Errorf("writing payload %s: %w", path, err)
+	}
+	return nil
+}
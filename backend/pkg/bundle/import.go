@@ -0,0 +1,368 @@
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/api/admin"
+)
+
+const maxManifestSize = 64 * 1024 * 1024
+
+// ImportOptions represents the options used to import a bundle.
+type ImportOptions struct {
+	// AppID is the id or product id of the application the bundle will be
+	// imported into. When empty, the application the bundle was exported
+	// from is used.
+	AppID string
+
+	// PublicKey is used to verify the bundle manifest signature.
+	PublicKey ed25519.PublicKey
+
+	// PayloadsPath is the directory where the payloads included in the
+	// bundle will be stored. When empty, payloads are ignored.
+	PayloadsPath string
+}
+
+// ImportResult represents the changes made while importing a bundle.
+type ImportResult struct {
+	ChannelsCreated int `json:"channels_created"`
+	ChannelsUpdated int `json:"channels_updated"`
+	PackagesCreated int `json:"packages_created"`
+	PackagesSkipped int `json:"packages_skipped"`
+	FloorsSet       int `json:"floors_set"`
+	PayloadsWritten int `json:"payloads_written"`
+}
+
+type channelKey struct {
+	name string
+	arch api.Arch
+}
+
+type packageKey struct {
+	version string
+	arch    api.Arch
+}
+
+// Import verifies and loads the bundle read from the reader provided. Import
+// is idempotent: entities already present in the target application are left
+// untouched, so importing the same bundle twice doesn't change anything.
+func Import(db *api.API, adminSvc *admin.Service, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	if len(opts.PublicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("bundle: a valid ed25519 public key is required")
+	}
+
+	result := &ImportResult{}
+
+	manifest, err := readBundle(r, opts.PublicKey, opts.PayloadsPath, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := importManifest(db, adminSvc, manifest, opts.AppID, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// readBundle reads the bundle manifest verifying its signature, storing the
+// payloads found in the bundle into payloadsPath when provided. All the
+// payloads listed in the manifest must be found in the bundle then.
+func readBundle(r io.Reader, publicKey ed25519.PublicKey, payloadsPath string, result *ImportResult) (*Manifest, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+
+	manifestData, err := readTarEntry(tr, manifestName)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := readTarEntry(tr, signatureName)
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(publicKey, manifestData, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+	if manifest.Version != manifestVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	payloads := make(map[string]Payload, len(manifest.Payloads))
+	for _, payload := range manifest.Payloads {
+		payloads[payload.Name] = payload
+	}
+
+	if payloadsPath == "" && len(payloads) > 0 {
+		l.Warn().Int("count", len(payloads)).Msg("readBundle - no payloads path provided, ignoring bundle payloads")
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+
+		name := strings.TrimPrefix(hdr.Name, payloadsDir)
+		payload, ok := payloads[name]
+		if !ok || !strings.HasPrefix(hdr.Name, payloadsDir) || name != filepath.Base(name) {
+			return nil, fmt.Errorf("%w: unexpected entry %s", ErrInvalidBundle, hdr.Name)
+		}
+		delete(payloads, name)
+		if payloadsPath == "" {
+			continue
+		}
+
+		written, err := storePayload(tr, payloadsPath, payload)
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			result.PayloadsWritten++
+		}
+	}
+
+	if payloadsPath != "" && len(payloads) > 0 {
+		missing := make([]string, 0, len(payloads))
+		for name := range payloads {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: %s", ErrMissingPayload, strings.Join(missing, ", "))
+	}
+
+	return &manifest, nil
+}
+
+func readTarEntry(tr *tar.Reader, name string) ([]byte, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading %s: %v", ErrInvalidBundle, name, err)
+	}
+	if hdr.Name != name {
+		return nil, fmt.Errorf("%w: expected %s, found %s", ErrInvalidBundle, name, hdr.Name)
+	}
+	data, err := io.ReadAll(io.LimitReader(tr, maxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading %s: %v", ErrInvalidBundle, name, err)
+	}
+	if len(data) > maxManifestSize {
+		return nil, fmt.Errorf("%w: %s too large", ErrInvalidBundle, name)
+	}
+	return data, nil
+}
+
+// storePayload writes the payload read from r into payloadsPath, verifying it
+// matches the hash in the manifest. Payloads already present with the same
+// hash are not written again, in which case false is returned.
+func storePayload(r io.Reader, payloadsPath string, payload Payload) (bool, error) {
+	dst := filepath.Join(payloadsPath, payload.Name)
+
+	if existing, err := newPayload(dst); err == nil && existing.SHA256 == payload.SHA256 {
+		return false, nil
+	}
+
+	tmpFile, err := os.CreateTemp(payloadsPath, "tmp_bundle_payload_")
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmpFile, hash), r); err != nil {
+		return false, fmt.Errorf("writing payload %s: %w", payload.Name, err)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != payload.SHA256 {
+		return false, fmt.Errorf("%w: %s", ErrPayloadHashMismatch, payload.Name)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmpFile.Name(), dst); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// importManifest loads the content of the manifest provided into the target
+// application using the admin service.
+func importManifest(db *api.API, adminSvc *admin.Service, manifest *Manifest, appIDorProductID string, result *ImportResult) error {
+	appID, err := resolveAppID(db, manifest, appIDorProductID)
+	if err != nil {
+		return err
+	}
+	app, err := db.GetApp(appID)
+	if err != nil {
+		return err
+	}
+
+	channels := make(map[channelKey]*api.Channel, len(app.Channels))
+	for _, c := range app.Channels {
+		channels[channelKey{c.Name, c.Arch}] = c
+	}
+
+	for _, c := range manifest.Channels {
+		key := channelKey{c.Name, c.Arch}
+		if _, ok := channels[key]; ok {
+			continue
+		}
+		channel, err := adminSvc.AddChannel(&api.Channel{
+			Name:          c.Name,
+			Color:         c.Color,
+			ApplicationID: appID,
+			Arch:          c.Arch,
+		})
+		if err != nil {
+			return fmt.Errorf("adding channel %s (%s): %w", c.Name, c.Arch, err)
+		}
+		channels[key] = channel
+		result.ChannelsCreated++
+	}
+
+	pkgIDs := make(map[packageKey]string, len(manifest.Packages))
+	for _, p := range manifest.Packages {
+		key := packageKey{p.Version, p.Arch}
+
+		existing, err := db.GetPackageByVersionAndArch(appID, p.Version, p.Arch)
+		if err == nil {
+			pkgIDs[key] = existing.ID
+			result.PackagesSkipped++
+			continue
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		pkg, err := addPackage(adminSvc, newAPIPackage(p, appID, channels))
+		if err != nil {
+			return fmt.Errorf("adding package %s (%s): %w", p.Version, p.Arch, err)
+		}
+		pkgIDs[key] = pkg.ID
+		result.PackagesCreated++
+	}
+
+	for _, c := range manifest.Channels {
+		if c.PackageVersion == "" {
+			continue
+		}
+		pkgID, ok := pkgIDs[packageKey{c.PackageVersion, c.Arch}]
+		if !ok {
+			return fmt.Errorf("%w: channel %s (%s) points to unknown package %s", ErrInvalidBundle, c.Name, c.Arch, c.PackageVersion)
+		}
+		channel := channels[channelKey{c.Name, c.Arch}]
+		if channel.PackageID.String == pkgID {
+			continue
+		}
+		channel.PackageID = null.StringFrom(pkgID)
+		if err := adminSvc.UpdateChannel(channel); err != nil {
+			return fmt.Errorf("updating channel %s (%s): %w", c.Name, c.Arch, err)
+		}
+		result.ChannelsUpdated++
+	}
+
+	for _, f := range manifest.Floors {
+		pkgID, ok := pkgIDs[packageKey{f.PackageVersion, f.Arch}]
+		channel, found := channels[channelKey{f.Channel, f.Arch}]
+		if !ok || !found {
+			return fmt.Errorf("%w: unknown floor %s for channel %s (%s)", ErrInvalidBundle, f.PackageVersion, f.Channel, f.Arch)
+		}
+		if err := adminSvc.AddChannelPackageFloor(channel.ID, pkgID, f.Reason); err != nil {
+			return fmt.Errorf("adding floor %s to channel %s (%s): %w", f.PackageVersion, f.Channel, f.Arch, err)
+		}
+		result.FloorsSet++
+	}
+
+	return nil
+}
+
+// resolveAppID returns the id of the application the bundle will be imported
+// into, falling back to the bundle application id or product id.
+func resolveAppID(db *api.API, manifest *Manifest, appIDorProductID string) (string, error) {
+	if appIDorProductID != "" {
+		return db.GetAppID(appIDorProductID)
+	}
+	appID, err := db.GetAppID(manifest.Application.ID)
+	if err != nil && manifest.Application.ProductID.Valid {
+		return db.GetAppID(manifest.Application.ProductID.String)
+	}
+	return appID, err
+}
+
+// newAPIPackage converts the bundle package provided into a package of the
+// application provided.
+func newAPIPackage(p Package, appID string, channels map[channelKey]*api.Channel) *api.Package {
+	pkg := &api.Package{
+		Type:              p.Type,
+		Version:           p.Version,
+		URL:               p.URL,
+		Filename:          p.Filename,
+		Description:       p.Description,
+		Size:              p.Size,
+		Hash:              p.Hash,
+		ApplicationID:     appID,
+		Arch:              p.Arch,
+		ChannelsBlacklist: api.StringArray{},
+	}
+	for _, name := range p.ChannelsBlacklist {
+		if c, ok := channels[channelKey{name, p.Arch}]; ok {
+			pkg.ChannelsBlacklist = append(pkg.ChannelsBlacklist, c.ID)
+		}
+	}
+	for _, f := range p.ExtraFiles {
+		pkg.ExtraFiles = append(pkg.ExtraFiles, api.File{
+			Name:    f.Name,
+			Size:    f.Size,
+			Hash:    f.Hash,
+			Hash256: f.Hash256,
+		})
+	}
+	if p.FlatcarAction != nil {
+		action := *p.FlatcarAction
+		action.ID = ""
+		action.PackageID = ""
+		pkg.FlatcarAction = &action
+	}
+	return pkg
+}
+
+// addPackage registers the package provided. Flatcar packages created
+// manually only carry the action sha256, so they can't go through
+// AddPackageWithMetadata.
+func addPackage(adminSvc *admin.Service, pkg *api.Package) (*api.Package, error) {
+	if pkg.Type == api.PkgTypeFlatcar && pkg.FlatcarAction != nil && pkg.FlatcarAction.Event == "" {
+		return adminSvc.AddPackage(pkg)
+	}
+	return adminSvc.AddPackageWithMetadata(pkg)
+}
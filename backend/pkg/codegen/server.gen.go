@@ -17,6 +17,9 @@ type ServerInterface interface {
 	// (GET /api/activity)
 	PaginateActivity(ctx echo.Context, params PaginateActivityParams) error
 
+	// (POST /api/activity/acknowledge)
+	AcknowledgeActivity(ctx echo.Context) error
+
 	// (GET /api/apps)
 	PaginateApps(ctx echo.Context, params PaginateAppsParams) error
 
@@ -47,6 +50,27 @@ type ServerInterface interface {
 	// (PUT /api/apps/{appIDorProductID}/channels/{channelID})
 	UpdateChannel(ctx echo.Context, appIDorProductID string, channelID string) error
 
+	// (GET /api/apps/{appIDorProductID}/channels/{channelID}/version_timeline)
+	GetChannelVersionTimeline(ctx echo.Context, appIDorProductID string, channelID string, params GetChannelVersionTimelineParams) error
+
+	// (GET /api/apps/{appIDorProductID}/email-notifications)
+	PaginateEmailNotifications(ctx echo.Context, appIDorProductID string, params PaginateEmailNotificationsParams) error
+
+	// (POST /api/apps/{appIDorProductID}/email-notifications)
+	CreateEmailNotification(ctx echo.Context, appIDorProductID string) error
+
+	// (DELETE /api/apps/{appIDorProductID}/email-notifications/{notificationID})
+	DeleteEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error
+
+	// (GET /api/apps/{appIDorProductID}/email-notifications/{notificationID})
+	GetEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error
+
+	// (PUT /api/apps/{appIDorProductID}/email-notifications/{notificationID})
+	UpdateEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error
+
+	// (POST /api/apps/{appIDorProductID}/email-notifications/{notificationID}/test)
+	TestEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error
+
 	// (GET /api/apps/{appIDorProductID}/groups)
 	PaginateGroups(ctx echo.Context, appIDorProductID string, params PaginateGroupsParams) error
 
@@ -68,6 +92,9 @@ type ServerInterface interface {
 	// (GET /api/apps/{appIDorProductID}/groups/{groupID}/instances/{instanceID})
 	GetInstance(ctx echo.Context, appIDorProductID string, groupID string, instanceID string) error
 
+	// (GET /api/apps/{appIDorProductID}/groups/{groupID}/instances/{instanceID}/events)
+	GetInstanceEvents(ctx echo.Context, appIDorProductID string, groupID string, instanceID string, params GetInstanceEventsParams) error
+
 	// (GET /api/apps/{appIDorProductID}/groups/{groupID}/instances/{instanceID}/status_history)
 	GetInstanceStatusHistory(ctx echo.Context, appIDorProductID string, groupID string, instanceID string, params GetInstanceStatusHistoryParams) error
 
@@ -86,6 +113,18 @@ type ServerInterface interface {
 	// (GET /api/apps/{appIDorProductID}/groups/{groupID}/version_timeline)
 	GetGroupVersionTimeline(ctx echo.Context, appIDorProductID string, groupID string, params GetGroupVersionTimelineParams) error
 
+	// (DELETE /api/apps/{appIDorProductID}/instances)
+	DeleteInstances(ctx echo.Context, appIDorProductID string, params DeleteInstancesParams) error
+
+	// (GET /api/apps/{appIDorProductID}/instances/export)
+	ExportInstances(ctx echo.Context, appIDorProductID string, params ExportInstancesParams) error
+
+	// (PUT /api/apps/{appIDorProductID}/instances/group)
+	SetInstancesGroupOverride(ctx echo.Context, appIDorProductID string, params SetInstancesGroupOverrideParams) error
+
+	// (PUT /api/apps/{appIDorProductID}/instances/{instanceID}/group)
+	SetInstanceGroupOverride(ctx echo.Context, appIDorProductID string, instanceID string) error
+
 	// (GET /api/apps/{appIDorProductID}/packages)
 	PaginatePackages(ctx echo.Context, appIDorProductID string, params PaginatePackagesParams) error
 
@@ -104,6 +143,21 @@ type ServerInterface interface {
 	// (GET /api/apps/{appIDorProductID}/packages/{packageID}/floor-channels)
 	GetPackageFloorChannels(ctx echo.Context, appIDorProductID string, packageID string) error
 
+	// (GET /api/apps/{appIDorProductID}/role-bindings)
+	PaginateRoleBindings(ctx echo.Context, appIDorProductID string, params PaginateRoleBindingsParams) error
+
+	// (POST /api/apps/{appIDorProductID}/role-bindings)
+	CreateRoleBinding(ctx echo.Context, appIDorProductID string) error
+
+	// (DELETE /api/apps/{appIDorProductID}/role-bindings/{bindingID})
+	DeleteRoleBinding(ctx echo.Context, appIDorProductID string, bindingID string) error
+
+	// (GET /api/apps/{appIDorProductID}/role-bindings/{bindingID})
+	GetRoleBinding(ctx echo.Context, appIDorProductID string, bindingID string) error
+
+	// (GET /api/audit)
+	PaginateAudit(ctx echo.Context, params PaginateAuditParams) error
+
 	// (GET /api/channels/{channelID}/floors)
 	PaginateChannelFloors(ctx echo.Context, channelID string, params PaginateChannelFloorsParams) error
 
@@ -113,15 +167,96 @@ type ServerInterface interface {
 	// (PUT /api/channels/{channelID}/floors/{packageID})
 	SetChannelFloor(ctx echo.Context, channelID string, packageID string) error
 
+	// (GET /api/events/stream)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
+
+	// (DELETE /api/instances/{instanceID})
+	DeleteInstance(ctx echo.Context, instanceID string) error
+
 	// (PUT /api/instances/{instanceID})
 	UpdateInstance(ctx echo.Context, instanceID string) error
 
+	// (PUT /api/instances/{instanceID}/labels)
+	UpdateInstanceLabels(ctx echo.Context, instanceID string) error
+
+	// (POST /api/retention/dry-run)
+	RetentionDryRun(ctx echo.Context) error
+
+	// (POST /api/syncer/run)
+	RunSyncer(ctx echo.Context) error
+
+	// (GET /api/syncer/status)
+	GetSyncerStatus(ctx echo.Context) error
+
+	// (GET /api/teams)
+	GetTeams(ctx echo.Context) error
+
+	// (POST /api/teams)
+	CreateTeam(ctx echo.Context) error
+
+	// (DELETE /api/teams/{teamID})
+	DeleteTeam(ctx echo.Context, teamID string) error
+
+	// (GET /api/teams/{teamID})
+	GetTeam(ctx echo.Context, teamID string) error
+
+	// (PUT /api/teams/{teamID})
+	UpdateTeam(ctx echo.Context, teamID string) error
+
+	// (GET /api/tokens)
+	PaginateAPITokens(ctx echo.Context, params PaginateAPITokensParams) error
+
+	// (POST /api/tokens)
+	CreateAPIToken(ctx echo.Context) error
+
+	// (DELETE /api/tokens/{tokenID})
+	RevokeAPIToken(ctx echo.Context, tokenID string) error
+
+	// (GET /api/tokens/{tokenID})
+	GetAPIToken(ctx echo.Context, tokenID string) error
+
+	// (GET /api/users)
+	GetUsers(ctx echo.Context) error
+
+	// (POST /api/users)
+	CreateUser(ctx echo.Context) error
+
+	// (DELETE /api/users/{userID})
+	DeleteUser(ctx echo.Context, userID string) error
+
+	// (GET /api/users/{userID})
+	GetUser(ctx echo.Context, userID string) error
+
+	// (PUT /api/users/{userID})
+	UpdateUser(ctx echo.Context, userID string) error
+
+	// (GET /api/webhooks)
+	PaginateWebhooks(ctx echo.Context, params PaginateWebhooksParams) error
+
+	// (POST /api/webhooks)
+	CreateWebhook(ctx echo.Context) error
+
+	// (DELETE /api/webhooks/{webhookID})
+	DeleteWebhook(ctx echo.Context, webhookID string) error
+
+	// (GET /api/webhooks/{webhookID})
+	GetWebhook(ctx echo.Context, webhookID string) error
+
+	// (PUT /api/webhooks/{webhookID})
+	UpdateWebhook(ctx echo.Context, webhookID string) error
+
+	// (GET /api/webhooks/{webhookID}/deliveries)
+	PaginateWebhookDeliveries(ctx echo.Context, webhookID string, params PaginateWebhookDeliveriesParams) error
+
 	// (GET /config)
 	GetConfig(ctx echo.Context) error
 
 	// (GET /health)
 	Health(ctx echo.Context) error
 
+	// (POST /login)
+	Login(ctx echo.Context) error
+
 	// (GET /login/cb)
 	LoginCb(ctx echo.Context) error
 
@@ -131,6 +266,9 @@ type ServerInterface interface {
 	// (POST /login/webhook)
 	LoginWebhook(ctx echo.Context, params LoginWebhookParams) error
 
+	// (POST /logout)
+	Logout(ctx echo.Context) error
+
 	// (POST /v1/update)
 	Omaha(ctx echo.Context) error
 }
@@ -150,6 +288,8 @@ func (w *ServerInterfaceWrapper) PaginateActivity(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PaginateActivityParams
 	// ------------- Optional query parameter "appIDorProductID" -------------
@@ -194,6 +334,13 @@ func (w *ServerInterfaceWrapper) PaginateActivity(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter severity: %s", err))
 	}
 
+	// ------------- Optional query parameter "unacknowledged" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unacknowledged", ctx.QueryParams(), &params.Unacknowledged)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unacknowledged: %s", err))
+	}
+
 	// ------------- Required query parameter "start" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "start", ctx.QueryParams(), &params.Start)
@@ -227,6 +374,23 @@ func (w *ServerInterfaceWrapper) PaginateActivity(ctx echo.Context) error {
 	return err
 }
 
+// AcknowledgeActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) AcknowledgeActivity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AcknowledgeActivity(ctx)
+	return err
+}
+
 // PaginateApps converts echo context to params.
 func (w *ServerInterfaceWrapper) PaginateApps(ctx echo.Context) error {
 	var err error
@@ -237,6 +401,8 @@ func (w *ServerInterfaceWrapper) PaginateApps(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PaginateAppsParams
 	// ------------- Optional query parameter "page" -------------
@@ -268,6 +434,8 @@ func (w *ServerInterfaceWrapper) CreateApp(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params CreateAppParams
 	// ------------- Optional query parameter "clone_from" -------------
@@ -299,6 +467,8 @@ func (w *ServerInterfaceWrapper) DeleteApp(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteApp(ctx, appIDorProductID)
 	return err
@@ -321,6 +491,8 @@ func (w *ServerInterfaceWrapper) GetApp(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApp(ctx, appIDorProductID)
 	return err
@@ -343,6 +515,8 @@ func (w *ServerInterfaceWrapper) UpdateApp(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateApp(ctx, appIDorProductID)
 	return err
@@ -365,6 +539,8 @@ func (w *ServerInterfaceWrapper) PaginateChannels(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params PaginateChannelsParams
 	// ------------- Optional query parameter "page" -------------
@@ -403,6 +579,8 @@ func (w *ServerInterfaceWrapper) CreateChannel(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateChannel(ctx, appIDorProductID)
 	return err
@@ -433,6 +611,8 @@ func (w *ServerInterfaceWrapper) DeleteChannel(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteChannel(ctx, appIDorProductID, channelID)
 	return err
@@ -463,6 +643,8 @@ func (w *ServerInterfaceWrapper) GetChannel(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetChannel(ctx, appIDorProductID, channelID)
 	return err
@@ -493,13 +675,15 @@ func (w *ServerInterfaceWrapper) UpdateChannel(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateChannel(ctx, appIDorProductID, channelID)
 	return err
 }
 
-// PaginateGroups converts echo context to params.
-func (w *ServerInterfaceWrapper) PaginateGroups(ctx echo.Context) error {
+// GetChannelVersionTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChannelVersionTimeline(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -509,14 +693,71 @@ func (w *ServerInterfaceWrapper) PaginateGroups(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
+	// ------------- Path parameter "channelID" -------------
+	var channelID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
+	}
+
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
 	ctx.Set(OidcCookieAuthScopes, []string{})
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PaginateGroupsParams
+	var params GetChannelVersionTimelineParams
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", ctx.QueryParams(), &params.Start)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start: %s", err))
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", ctx.QueryParams(), &params.End)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end: %s", err))
+	}
+
+	// ------------- Optional query parameter "resolution" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resolution", ctx.QueryParams(), &params.Resolution)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resolution: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetChannelVersionTimeline(ctx, appIDorProductID, channelID, params)
+	return err
+}
+
+// PaginateEmailNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateEmailNotifications(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateEmailNotificationsParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -532,12 +773,12 @@ func (w *ServerInterfaceWrapper) PaginateGroups(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PaginateGroups(ctx, appIDorProductID, params)
+	err = w.Handler.PaginateEmailNotifications(ctx, appIDorProductID, params)
 	return err
 }
 
-// CreateGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateGroup(ctx echo.Context) error {
+// CreateEmailNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateEmailNotification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -553,13 +794,15 @@ func (w *ServerInterfaceWrapper) CreateGroup(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateGroup(ctx, appIDorProductID)
+	err = w.Handler.CreateEmailNotification(ctx, appIDorProductID)
 	return err
 }
 
-// DeleteGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteGroup(ctx echo.Context) error {
+// DeleteEmailNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEmailNotification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -569,12 +812,12 @@ func (w *ServerInterfaceWrapper) DeleteGroup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationID", ctx.Param("notificationID"), &notificationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -583,13 +826,15 @@ func (w *ServerInterfaceWrapper) DeleteGroup(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteGroup(ctx, appIDorProductID, groupID)
+	err = w.Handler.DeleteEmailNotification(ctx, appIDorProductID, notificationID)
 	return err
 }
 
-// GetGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroup(ctx echo.Context) error {
+// GetEmailNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEmailNotification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -599,12 +844,12 @@ func (w *ServerInterfaceWrapper) GetGroup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationID", ctx.Param("notificationID"), &notificationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -613,13 +858,15 @@ func (w *ServerInterfaceWrapper) GetGroup(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroup(ctx, appIDorProductID, groupID)
+	err = w.Handler.GetEmailNotification(ctx, appIDorProductID, notificationID)
 	return err
 }
 
-// UpdateGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
+// UpdateEmailNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateEmailNotification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -629,12 +876,12 @@ func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationID", ctx.Param("notificationID"), &notificationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -643,13 +890,15 @@ func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateGroup(ctx, appIDorProductID, groupID)
+	err = w.Handler.UpdateEmailNotification(ctx, appIDorProductID, notificationID)
 	return err
 }
 
-// GetGroupInstances converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupInstances(ctx echo.Context) error {
+// TestEmailNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) TestEmailNotification(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -659,12 +908,12 @@ func (w *ServerInterfaceWrapper) GetGroupInstances(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationID", ctx.Param("notificationID"), &notificationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -673,15 +922,34 @@ func (w *ServerInterfaceWrapper) GetGroupInstances(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupInstancesParams
-	// ------------- Required query parameter "status" -------------
+	ctx.Set(LocalCookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "status", ctx.QueryParams(), &params.Status)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TestEmailNotification(ctx, appIDorProductID, notificationID)
+	return err
+}
+
+// PaginateGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateGroupsParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -696,55 +964,37 @@ func (w *ServerInterfaceWrapper) GetGroupInstances(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
 	}
 
-	// ------------- Optional query parameter "sortFilter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sortFilter", ctx.QueryParams(), &params.SortFilter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortFilter: %s", err))
-	}
-
-	// ------------- Optional query parameter "sortOrder" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", ctx.QueryParams(), &params.SortOrder)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortOrder: %s", err))
-	}
-
-	// ------------- Optional query parameter "searchFilter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "searchFilter", ctx.QueryParams(), &params.SearchFilter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchFilter: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginateGroups(ctx, appIDorProductID, params)
+	return err
+}
 
-	// ------------- Optional query parameter "searchValue" -------------
+// CreateGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
 
-	err = runtime.BindQueryParameter("form", true, false, "searchValue", ctx.QueryParams(), &params.SearchValue)
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchValue: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Required query parameter "duration" -------------
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
-	}
+	ctx.Set(OidcCookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "version" -------------
+	ctx.Set(GithubCookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
-	}
+	ctx.Set(LocalCookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupInstances(ctx, appIDorProductID, groupID, params)
+	err = w.Handler.CreateGroup(ctx, appIDorProductID)
 	return err
 }
 
-// GetInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstance(ctx echo.Context) error {
+// DeleteGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGroup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -762,27 +1012,21 @@ func (w *ServerInterfaceWrapper) GetInstance(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
 	}
 
-	// ------------- Path parameter "instanceID" -------------
-	var instanceID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
-	}
-
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
 	ctx.Set(OidcCookieAuthScopes, []string{})
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstance(ctx, appIDorProductID, groupID, instanceID)
+	err = w.Handler.DeleteGroup(ctx, appIDorProductID, groupID)
 	return err
 }
 
-// GetInstanceStatusHistory converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstanceStatusHistory(ctx echo.Context) error {
+// GetGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -800,12 +1044,36 @@ func (w *ServerInterfaceWrapper) GetInstanceStatusHistory(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
 	}
 
-	// ------------- Path parameter "instanceID" -------------
-	var instanceID string
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroup(ctx, appIDorProductID, groupID)
+	return err
+}
+
+// UpdateGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -814,22 +1082,15 @@ func (w *ServerInterfaceWrapper) GetInstanceStatusHistory(ctx echo.Context) erro
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInstanceStatusHistoryParams
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
+	ctx.Set(LocalCookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstanceStatusHistory(ctx, appIDorProductID, groupID, instanceID, params)
+	err = w.Handler.UpdateGroup(ctx, appIDorProductID, groupID)
 	return err
 }
 
-// GetGroupInstanceStats converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupInstanceStats(ctx echo.Context) error {
+// GetGroupInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupInstances(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -853,8 +1114,59 @@ func (w *ServerInterfaceWrapper) GetGroupInstanceStats(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupInstanceStatsParams
+	var params GetGroupInstancesParams
+	// ------------- Required query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortFilter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortFilter", ctx.QueryParams(), &params.SortFilter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortFilter: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", ctx.QueryParams(), &params.SortOrder)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortOrder: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchFilter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchFilter", ctx.QueryParams(), &params.SearchFilter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchFilter: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchValue", ctx.QueryParams(), &params.SearchValue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchValue: %s", err))
+	}
+
 	// ------------- Required query parameter "duration" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
@@ -862,13 +1174,109 @@ func (w *ServerInterfaceWrapper) GetGroupInstanceStats(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
 	}
 
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "ipCidr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ipCidr", ctx.QueryParams(), &params.IpCidr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ipCidr: %s", err))
+	}
+
+	// ------------- Optional query parameter "oem" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "oem", ctx.QueryParams(), &params.Oem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oem: %s", err))
+	}
+
+	// ------------- Optional query parameter "alephVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "alephVersion", ctx.QueryParams(), &params.AlephVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alephVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "versionRange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "versionRange", ctx.QueryParams(), &params.VersionRange)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionRange: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckAfter", ctx.QueryParams(), &params.LastCheckAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckBefore", ctx.QueryParams(), &params.LastCheckBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "labels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labels", ctx.QueryParams(), &params.Labels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labels: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupInstanceStats(ctx, appIDorProductID, groupID, params)
+	err = w.Handler.GetGroupInstances(ctx, appIDorProductID, groupID, params)
 	return err
 }
 
-// GetGroupInstancesCount converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupInstancesCount(ctx echo.Context) error {
+// GetInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstance(ctx, appIDorProductID, groupID, instanceID)
+	return err
+}
+
+// GetInstanceEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceEvents(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -886,28 +1294,66 @@ func (w *ServerInterfaceWrapper) GetGroupInstancesCount(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
 	}
 
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
 	ctx.Set(OidcCookieAuthScopes, []string{})
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupInstancesCountParams
-	// ------------- Required query parameter "duration" -------------
+	var params GetInstanceEventsParams
+	// ------------- Optional query parameter "start" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	err = runtime.BindQueryParameter("form", true, false, "start", ctx.QueryParams(), &params.Start)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start: %s", err))
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", ctx.QueryParams(), &params.End)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end: %s", err))
+	}
+
+	// ------------- Optional query parameter "minGap" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minGap", ctx.QueryParams(), &params.MinGap)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minGap: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupInstancesCount(ctx, appIDorProductID, groupID, params)
+	err = w.Handler.GetInstanceEvents(ctx, appIDorProductID, groupID, instanceID, params)
 	return err
 }
 
-// GetGroupStatusTimeline converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupStatusTimeline(ctx echo.Context) error {
+// GetInstanceStatusHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceStatusHistory(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -925,28 +1371,38 @@ func (w *ServerInterfaceWrapper) GetGroupStatusTimeline(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
 	}
 
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
 	ctx.Set(OidcCookieAuthScopes, []string{})
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupStatusTimelineParams
-	// ------------- Required query parameter "duration" -------------
+	var params GetInstanceStatusHistoryParams
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupStatusTimeline(ctx, appIDorProductID, groupID, params)
+	err = w.Handler.GetInstanceStatusHistory(ctx, appIDorProductID, groupID, instanceID, params)
 	return err
 }
 
-// GetGroupVersionBreakdown converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupVersionBreakdown(ctx echo.Context) error {
+// GetGroupInstanceStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupInstanceStats(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "appIDorProductID" -------------
 	var appIDorProductID string
@@ -956,12 +1412,1307 @@ func (w *ServerInterfaceWrapper) GetGroupVersionBreakdown(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupInstanceStatsParams
+	// ------------- Required query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupInstanceStats(ctx, appIDorProductID, groupID, params)
+	return err
+}
+
+// GetGroupInstancesCount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupInstancesCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupInstancesCountParams
+	// ------------- Required query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupInstancesCount(ctx, appIDorProductID, groupID, params)
+	return err
+}
+
+// GetGroupStatusTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupStatusTimeline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupStatusTimelineParams
+	// ------------- Required query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupStatusTimeline(ctx, appIDorProductID, groupID, params)
+	return err
+}
+
+// GetGroupVersionBreakdown converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupVersionBreakdown(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupVersionBreakdown(ctx, appIDorProductID, groupID)
+	return err
+}
+
+// GetGroupVersionTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupVersionTimeline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupVersionTimelineParams
+	// ------------- Required query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupVersionTimeline(ctx, appIDorProductID, groupID, params)
+	return err
+}
+
+// DeleteInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInstances(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteInstancesParams
+	// ------------- Optional query parameter "groupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupID", ctx.QueryParams(), &params.GroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchFilter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchFilter", ctx.QueryParams(), &params.SearchFilter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchFilter: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchValue", ctx.QueryParams(), &params.SearchValue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchValue: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// ------------- Optional query parameter "ipCidr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ipCidr", ctx.QueryParams(), &params.IpCidr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ipCidr: %s", err))
+	}
+
+	// ------------- Optional query parameter "oem" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "oem", ctx.QueryParams(), &params.Oem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oem: %s", err))
+	}
+
+	// ------------- Optional query parameter "alephVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "alephVersion", ctx.QueryParams(), &params.AlephVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alephVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "versionRange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "versionRange", ctx.QueryParams(), &params.VersionRange)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionRange: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckAfter", ctx.QueryParams(), &params.LastCheckAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckBefore", ctx.QueryParams(), &params.LastCheckBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "labels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labels", ctx.QueryParams(), &params.Labels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labels: %s", err))
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInstances(ctx, appIDorProductID, params)
+	return err
+}
+
+// ExportInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportInstances(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportInstancesParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupID", ctx.QueryParams(), &params.GroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchFilter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchFilter", ctx.QueryParams(), &params.SearchFilter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchFilter: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchValue", ctx.QueryParams(), &params.SearchValue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchValue: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// ------------- Optional query parameter "ipCidr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ipCidr", ctx.QueryParams(), &params.IpCidr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ipCidr: %s", err))
+	}
+
+	// ------------- Optional query parameter "oem" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "oem", ctx.QueryParams(), &params.Oem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oem: %s", err))
+	}
+
+	// ------------- Optional query parameter "alephVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "alephVersion", ctx.QueryParams(), &params.AlephVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alephVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "versionRange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "versionRange", ctx.QueryParams(), &params.VersionRange)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionRange: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckAfter", ctx.QueryParams(), &params.LastCheckAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckBefore", ctx.QueryParams(), &params.LastCheckBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "labels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labels", ctx.QueryParams(), &params.Labels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labels: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportInstances(ctx, appIDorProductID, params)
+	return err
+}
+
+// SetInstancesGroupOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) SetInstancesGroupOverride(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetInstancesGroupOverrideParams
+	// ------------- Optional query parameter "groupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupID", ctx.QueryParams(), &params.GroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchFilter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchFilter", ctx.QueryParams(), &params.SearchFilter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchFilter: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchValue", ctx.QueryParams(), &params.SearchValue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchValue: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// ------------- Optional query parameter "ipCidr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ipCidr", ctx.QueryParams(), &params.IpCidr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ipCidr: %s", err))
+	}
+
+	// ------------- Optional query parameter "oem" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "oem", ctx.QueryParams(), &params.Oem)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oem: %s", err))
+	}
+
+	// ------------- Optional query parameter "alephVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "alephVersion", ctx.QueryParams(), &params.AlephVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter alephVersion: %s", err))
+	}
+
+	// ------------- Optional query parameter "versionRange" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "versionRange", ctx.QueryParams(), &params.VersionRange)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter versionRange: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckAfter", ctx.QueryParams(), &params.LastCheckAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "lastCheckBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCheckBefore", ctx.QueryParams(), &params.LastCheckBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastCheckBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "labels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labels", ctx.QueryParams(), &params.Labels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labels: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetInstancesGroupOverride(ctx, appIDorProductID, params)
+	return err
+}
+
+// SetInstanceGroupOverride converts echo context to params.
+func (w *ServerInterfaceWrapper) SetInstanceGroupOverride(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetInstanceGroupOverride(ctx, appIDorProductID, instanceID)
+	return err
+}
+
+// PaginatePackages converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginatePackages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginatePackagesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
+	// ------------- Optional query parameter "searchVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "searchVersion", ctx.QueryParams(), &params.SearchVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchVersion: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginatePackages(ctx, appIDorProductID, params)
+	return err
+}
+
+// CreatePackage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePackage(ctx, appIDorProductID)
+	return err
+}
+
+// DeletePackage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePackage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePackage(ctx, appIDorProductID, packageID)
+	return err
+}
+
+// GetPackage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPackage(ctx, appIDorProductID, packageID)
+	return err
+}
+
+// UpdatePackage converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePackage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePackage(ctx, appIDorProductID, packageID)
+	return err
+}
+
+// GetPackageFloorChannels converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPackageFloorChannels(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPackageFloorChannels(ctx, appIDorProductID, packageID)
+	return err
+}
+
+// PaginateRoleBindings converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateRoleBindings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateRoleBindingsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginateRoleBindings(ctx, appIDorProductID, params)
+	return err
+}
+
+// CreateRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateRoleBinding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateRoleBinding(ctx, appIDorProductID)
+	return err
+}
+
+// DeleteRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRoleBinding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "bindingID" -------------
+	var bindingID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bindingID", ctx.Param("bindingID"), &bindingID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bindingID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRoleBinding(ctx, appIDorProductID, bindingID)
+	return err
+}
+
+// GetRoleBinding converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoleBinding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "appIDorProductID" -------------
+	var appIDorProductID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Path parameter "bindingID" -------------
+	var bindingID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bindingID", ctx.Param("bindingID"), &bindingID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bindingID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoleBinding(ctx, appIDorProductID, bindingID)
+	return err
+}
+
+// PaginateAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateAuditParams
+	// ------------- Optional query parameter "appIDorProductID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "appIDorProductID", ctx.QueryParams(), &params.AppIDorProductID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "targetType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetType", ctx.QueryParams(), &params.TargetType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetType: %s", err))
+	}
+
+	// ------------- Optional query parameter "targetID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetID", ctx.QueryParams(), &params.TargetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", ctx.QueryParams(), &params.Start)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start: %s", err))
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", ctx.QueryParams(), &params.End)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginateAudit(ctx, params)
+	return err
+}
+
+// PaginateChannelFloors converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateChannelFloors(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelID" -------------
+	var channelID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateChannelFloorsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginateChannelFloors(ctx, channelID, params)
+	return err
+}
+
+// RemoveChannelFloor converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveChannelFloor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelID" -------------
+	var channelID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveChannelFloor(ctx, channelID, packageID)
+	return err
+}
+
+// SetChannelFloor converts echo context to params.
+func (w *ServerInterfaceWrapper) SetChannelFloor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelID" -------------
+	var channelID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
+	}
+
+	// ------------- Path parameter "packageID" -------------
+	var packageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetChannelFloor(ctx, channelID, packageID)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+	// ------------- Optional query parameter "appIDorProductID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "appIDorProductID", ctx.QueryParams(), &params.AppIDorProductID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupID", ctx.QueryParams(), &params.GroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
+// DeleteInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInstance(ctx, instanceID)
+	return err
+}
+
+// UpdateInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateInstance(ctx, instanceID)
+	return err
+}
+
+// UpdateInstanceLabels converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateInstanceLabels(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "instanceID" -------------
+	var instanceID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+	}
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateInstanceLabels(ctx, instanceID)
+	return err
+}
+
+// RetentionDryRun converts echo context to params.
+func (w *ServerInterfaceWrapper) RetentionDryRun(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RetentionDryRun(ctx)
+	return err
+}
+
+// RunSyncer converts echo context to params.
+func (w *ServerInterfaceWrapper) RunSyncer(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RunSyncer(ctx)
+	return err
+}
+
+// GetSyncerStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSyncerStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSyncerStatus(ctx)
+	return err
+}
+
+// GetTeams converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeams(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeams(ctx)
+	return err
+}
+
+// CreateTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTeam(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTeam(ctx)
+	return err
+}
+
+// DeleteTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTeam(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", ctx.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter teamID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -970,28 +2721,46 @@ func (w *ServerInterfaceWrapper) GetGroupVersionBreakdown(ctx echo.Context) erro
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupVersionBreakdown(ctx, appIDorProductID, groupID)
+	err = w.Handler.DeleteTeam(ctx, teamID)
 	return err
 }
 
-// GetGroupVersionTimeline converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGroupVersionTimeline(ctx echo.Context) error {
+// GetTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeam(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", ctx.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter teamID: %s", err))
 	}
 
-	// ------------- Path parameter "groupID" -------------
-	var groupID string
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupID", ctx.Param("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeam(ctx, teamID)
+	return err
+}
+
+// UpdateTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTeam(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "teamID" -------------
+	var teamID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", ctx.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter teamID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1000,30 +2769,16 @@ func (w *ServerInterfaceWrapper) GetGroupVersionTimeline(ctx echo.Context) error
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupVersionTimelineParams
-	// ------------- Required query parameter "duration" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
-	}
+	ctx.Set(LocalCookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGroupVersionTimeline(ctx, appIDorProductID, groupID, params)
+	err = w.Handler.UpdateTeam(ctx, teamID)
 	return err
 }
 
-// PaginatePackages converts echo context to params.
-func (w *ServerInterfaceWrapper) PaginatePackages(ctx echo.Context) error {
+// PaginateAPITokens converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateAPITokens(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
-	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
@@ -1031,8 +2786,10 @@ func (w *ServerInterfaceWrapper) PaginatePackages(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PaginatePackagesParams
+	var params PaginateAPITokensParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -1047,27 +2804,37 @@ func (w *ServerInterfaceWrapper) PaginatePackages(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
 	}
 
-	// ------------- Optional query parameter "searchVersion" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PaginateAPITokens(ctx, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "searchVersion", ctx.QueryParams(), &params.SearchVersion)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter searchVersion: %s", err))
-	}
+// CreateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAPIToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OidcBearerAuthScopes, []string{})
+
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PaginatePackages(ctx, appIDorProductID, params)
+	err = w.Handler.CreateAPIToken(ctx)
 	return err
 }
 
-// CreatePackage converts echo context to params.
-func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
+// RevokeAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeAPIToken(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
+	// ------------- Path parameter "tokenID" -------------
+	var tokenID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenID", ctx.Param("tokenID"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1076,28 +2843,22 @@ func (w *ServerInterfaceWrapper) CreatePackage(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreatePackage(ctx, appIDorProductID)
+	err = w.Handler.RevokeAPIToken(ctx, tokenID)
 	return err
 }
 
-// DeletePackage converts echo context to params.
-func (w *ServerInterfaceWrapper) DeletePackage(ctx echo.Context) error {
+// GetAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAPIToken(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
-	}
+	// ------------- Path parameter "tokenID" -------------
+	var tokenID string
 
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenID", ctx.Param("tokenID"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1106,29 +2867,33 @@ func (w *ServerInterfaceWrapper) DeletePackage(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeletePackage(ctx, appIDorProductID, packageID)
+	err = w.Handler.GetAPIToken(ctx, tokenID)
 	return err
 }
 
-// GetPackage converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPackage(ctx echo.Context) error {
+// GetUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
-	}
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
+	ctx.Set(OidcCookieAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
-	}
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsers(ctx)
+	return err
+}
+
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
@@ -1136,28 +2901,22 @@ func (w *ServerInterfaceWrapper) GetPackage(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPackage(ctx, appIDorProductID, packageID)
+	err = w.Handler.CreateUser(ctx)
 	return err
 }
 
-// UpdatePackage converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdatePackage(ctx echo.Context) error {
+// DeleteUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUser(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
-	}
-
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
+	// ------------- Path parameter "userID" -------------
+	var userID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", ctx.Param("userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1166,28 +2925,46 @@ func (w *ServerInterfaceWrapper) UpdatePackage(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdatePackage(ctx, appIDorProductID, packageID)
+	err = w.Handler.DeleteUser(ctx, userID)
 	return err
 }
 
-// GetPackageFloorChannels converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPackageFloorChannels(ctx echo.Context) error {
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "appIDorProductID" -------------
-	var appIDorProductID string
+	// ------------- Path parameter "userID" -------------
+	var userID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "appIDorProductID", ctx.Param("appIDorProductID"), &appIDorProductID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", ctx.Param("userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appIDorProductID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userID: %s", err))
 	}
 
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUser(ctx, userID)
+	return err
+}
+
+// UpdateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userID" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userID", ctx.Param("userID"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1196,21 +2973,16 @@ func (w *ServerInterfaceWrapper) GetPackageFloorChannels(ctx echo.Context) error
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPackageFloorChannels(ctx, appIDorProductID, packageID)
+	err = w.Handler.UpdateUser(ctx, userID)
 	return err
 }
 
-// PaginateChannelFloors converts echo context to params.
-func (w *ServerInterfaceWrapper) PaginateChannelFloors(ctx echo.Context) error {
+// PaginateWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateWebhooks(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "channelID" -------------
-	var channelID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
-	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
 
@@ -1218,8 +2990,10 @@ func (w *ServerInterfaceWrapper) PaginateChannelFloors(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PaginateChannelFloorsParams
+	var params PaginateWebhooksParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -1235,27 +3009,36 @@ func (w *ServerInterfaceWrapper) PaginateChannelFloors(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PaginateChannelFloors(ctx, channelID, params)
+	err = w.Handler.PaginateWebhooks(ctx, params)
 	return err
 }
 
-// RemoveChannelFloor converts echo context to params.
-func (w *ServerInterfaceWrapper) RemoveChannelFloor(ctx echo.Context) error {
+// CreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "channelID" -------------
-	var channelID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
-	}
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
+	ctx.Set(OidcCookieAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWebhook(ctx)
+	return err
+}
+
+// DeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1264,28 +3047,46 @@ func (w *ServerInterfaceWrapper) RemoveChannelFloor(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RemoveChannelFloor(ctx, channelID, packageID)
+	err = w.Handler.DeleteWebhook(ctx, webhookID)
 	return err
 }
 
-// SetChannelFloor converts echo context to params.
-func (w *ServerInterfaceWrapper) SetChannelFloor(ctx echo.Context) error {
+// GetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "channelID" -------------
-	var channelID string
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "channelID", ctx.Param("channelID"), &channelID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
 	}
 
-	// ------------- Path parameter "packageID" -------------
-	var packageID string
+	ctx.Set(OidcBearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "packageID", ctx.Param("packageID"), &packageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(OidcCookieAuthScopes, []string{})
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhook(ctx, webhookID)
+	return err
+}
+
+// UpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter packageID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1294,20 +3095,22 @@ func (w *ServerInterfaceWrapper) SetChannelFloor(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SetChannelFloor(ctx, channelID, packageID)
+	err = w.Handler.UpdateWebhook(ctx, webhookID)
 	return err
 }
 
-// UpdateInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateInstance(ctx echo.Context) error {
+// PaginateWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) PaginateWebhookDeliveries(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "instanceID" -------------
-	var instanceID string
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "instanceID", ctx.Param("instanceID"), &instanceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter instanceID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
 	}
 
 	ctx.Set(OidcBearerAuthScopes, []string{})
@@ -1316,8 +3119,26 @@ func (w *ServerInterfaceWrapper) UpdateInstance(ctx echo.Context) error {
 
 	ctx.Set(GithubCookieAuthScopes, []string{})
 
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaginateWebhookDeliveriesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perpage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perpage", ctx.QueryParams(), &params.Perpage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perpage: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateInstance(ctx, instanceID)
+	err = w.Handler.PaginateWebhookDeliveries(ctx, webhookID, params)
 	return err
 }
 
@@ -1339,6 +3160,15 @@ func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	return err
 }
 
+// Login converts echo context to params.
+func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Login(ctx)
+	return err
+}
+
 // LoginCb converts echo context to params.
 func (w *ServerInterfaceWrapper) LoginCb(ctx echo.Context) error {
 	var err error
@@ -1405,6 +3235,19 @@ func (w *ServerInterfaceWrapper) LoginWebhook(ctx echo.Context) error {
 	return err
 }
 
+// Logout converts echo context to params.
+func (w *ServerInterfaceWrapper) Logout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(GithubCookieAuthScopes, []string{})
+
+	ctx.Set(LocalCookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Logout(ctx)
+	return err
+}
+
 // Omaha converts echo context to params.
 func (w *ServerInterfaceWrapper) Omaha(ctx echo.Context) error {
 	var err error
@@ -1443,6 +3286,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/api/activity", wrapper.PaginateActivity)
+	router.POST(baseURL+"/api/activity/acknowledge", wrapper.AcknowledgeActivity)
 	router.GET(baseURL+"/api/apps", wrapper.PaginateApps)
 	router.POST(baseURL+"/api/apps", wrapper.CreateApp)
 	router.DELETE(baseURL+"/api/apps/:appIDorProductID", wrapper.DeleteApp)
@@ -1453,6 +3297,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/apps/:appIDorProductID/channels/:channelID", wrapper.DeleteChannel)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/channels/:channelID", wrapper.GetChannel)
 	router.PUT(baseURL+"/api/apps/:appIDorProductID/channels/:channelID", wrapper.UpdateChannel)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/channels/:channelID/version_timeline", wrapper.GetChannelVersionTimeline)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/email-notifications", wrapper.PaginateEmailNotifications)
+	router.POST(baseURL+"/api/apps/:appIDorProductID/email-notifications", wrapper.CreateEmailNotification)
+	router.DELETE(baseURL+"/api/apps/:appIDorProductID/email-notifications/:notificationID", wrapper.DeleteEmailNotification)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/email-notifications/:notificationID", wrapper.GetEmailNotification)
+	router.PUT(baseURL+"/api/apps/:appIDorProductID/email-notifications/:notificationID", wrapper.UpdateEmailNotification)
+	router.POST(baseURL+"/api/apps/:appIDorProductID/email-notifications/:notificationID/test", wrapper.TestEmailNotification)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups", wrapper.PaginateGroups)
 	router.POST(baseURL+"/api/apps/:appIDorProductID/groups", wrapper.CreateGroup)
 	router.DELETE(baseURL+"/api/apps/:appIDorProductID/groups/:groupID", wrapper.DeleteGroup)
@@ -1460,27 +3311,65 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/api/apps/:appIDorProductID/groups/:groupID", wrapper.UpdateGroup)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instances", wrapper.GetGroupInstances)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instances/:instanceID", wrapper.GetInstance)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instances/:instanceID/events", wrapper.GetInstanceEvents)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instances/:instanceID/status_history", wrapper.GetInstanceStatusHistory)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instances_stats", wrapper.GetGroupInstanceStats)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/instancescount", wrapper.GetGroupInstancesCount)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/status_timeline", wrapper.GetGroupStatusTimeline)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/version_breakdown", wrapper.GetGroupVersionBreakdown)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/groups/:groupID/version_timeline", wrapper.GetGroupVersionTimeline)
+	router.DELETE(baseURL+"/api/apps/:appIDorProductID/instances", wrapper.DeleteInstances)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/instances/export", wrapper.ExportInstances)
+	router.PUT(baseURL+"/api/apps/:appIDorProductID/instances/group", wrapper.SetInstancesGroupOverride)
+	router.PUT(baseURL+"/api/apps/:appIDorProductID/instances/:instanceID/group", wrapper.SetInstanceGroupOverride)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/packages", wrapper.PaginatePackages)
 	router.POST(baseURL+"/api/apps/:appIDorProductID/packages", wrapper.CreatePackage)
 	router.DELETE(baseURL+"/api/apps/:appIDorProductID/packages/:packageID", wrapper.DeletePackage)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/packages/:packageID", wrapper.GetPackage)
 	router.PUT(baseURL+"/api/apps/:appIDorProductID/packages/:packageID", wrapper.UpdatePackage)
 	router.GET(baseURL+"/api/apps/:appIDorProductID/packages/:packageID/floor-channels", wrapper.GetPackageFloorChannels)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/role-bindings", wrapper.PaginateRoleBindings)
+	router.POST(baseURL+"/api/apps/:appIDorProductID/role-bindings", wrapper.CreateRoleBinding)
+	router.DELETE(baseURL+"/api/apps/:appIDorProductID/role-bindings/:bindingID", wrapper.DeleteRoleBinding)
+	router.GET(baseURL+"/api/apps/:appIDorProductID/role-bindings/:bindingID", wrapper.GetRoleBinding)
+	router.GET(baseURL+"/api/audit", wrapper.PaginateAudit)
 	router.GET(baseURL+"/api/channels/:channelID/floors", wrapper.PaginateChannelFloors)
 	router.DELETE(baseURL+"/api/channels/:channelID/floors/:packageID", wrapper.RemoveChannelFloor)
 	router.PUT(baseURL+"/api/channels/:channelID/floors/:packageID", wrapper.SetChannelFloor)
+	router.GET(baseURL+"/api/events/stream", wrapper.StreamEvents)
+	router.DELETE(baseURL+"/api/instances/:instanceID", wrapper.DeleteInstance)
 	router.PUT(baseURL+"/api/instances/:instanceID", wrapper.UpdateInstance)
+	router.PUT(baseURL+"/api/instances/:instanceID/labels", wrapper.UpdateInstanceLabels)
+	router.POST(baseURL+"/api/retention/dry-run", wrapper.RetentionDryRun)
+	router.POST(baseURL+"/api/syncer/run", wrapper.RunSyncer)
+	router.GET(baseURL+"/api/syncer/status", wrapper.GetSyncerStatus)
+	router.GET(baseURL+"/api/teams", wrapper.GetTeams)
+	router.POST(baseURL+"/api/teams", wrapper.CreateTeam)
+	router.DELETE(baseURL+"/api/teams/:teamID", wrapper.DeleteTeam)
+	router.GET(baseURL+"/api/teams/:teamID", wrapper.GetTeam)
+	router.PUT(baseURL+"/api/teams/:teamID", wrapper.UpdateTeam)
+	router.GET(baseURL+"/api/tokens", wrapper.PaginateAPITokens)
+	router.POST(baseURL+"/api/tokens", wrapper.CreateAPIToken)
+	router.DELETE(baseURL+"/api/tokens/:tokenID", wrapper.RevokeAPIToken)
+	router.GET(baseURL+"/api/tokens/:tokenID", wrapper.GetAPIToken)
+	router.GET(baseURL+"/api/users", wrapper.GetUsers)
+	router.POST(baseURL+"/api/users", wrapper.CreateUser)
+	router.DELETE(baseURL+"/api/users/:userID", wrapper.DeleteUser)
+	router.GET(baseURL+"/api/users/:userID", wrapper.GetUser)
+	router.PUT(baseURL+"/api/users/:userID", wrapper.UpdateUser)
+	router.GET(baseURL+"/api/webhooks", wrapper.PaginateWebhooks)
+	router.POST(baseURL+"/api/webhooks", wrapper.CreateWebhook)
+	router.DELETE(baseURL+"/api/webhooks/:webhookID", wrapper.DeleteWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookID", wrapper.GetWebhook)
+	router.PUT(baseURL+"/api/webhooks/:webhookID", wrapper.UpdateWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookID/deliveries", wrapper.PaginateWebhookDeliveries)
 	router.GET(baseURL+"/config", wrapper.GetConfig)
 	router.GET(baseURL+"/health", wrapper.Health)
+	router.POST(baseURL+"/login", wrapper.Login)
 	router.GET(baseURL+"/login/cb", wrapper.LoginCb)
 	router.GET(baseURL+"/login/validate_token", wrapper.ValidateToken)
 	router.POST(baseURL+"/login/webhook", wrapper.LoginWebhook)
+	router.POST(baseURL+"/logout", wrapper.Logout)
 	router.POST(baseURL+"/v1/update", wrapper.Omaha)
 
 }
@@ -18,87 +18,201 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW3PctpL+KyzuPjhVo0ucOA96WluOZZ11jlWWnWyVj2oKQ/bMICIBBgBlya7571u4",
-	"kSAJcDhXjRI/pCIPgQbQ/fUNaILf4oTmBSVABI/PvsU8mUOO1J8oEfgOiwf5d8FoAUxg0E+K4vK1/EM8",
-	"FBCfxVwwTGbxKL4/oqjARwlNYQbkCO4FQ0cCzVSvPzkl8ZnsPMZpvFiM5J8ZTpDAlPwb5bABRUtmTCQd",
-	"STuZI0Ig24SuIeHQzBDnDjVMBMyAxfIRAyQg/ageTynLkYjP4hQJOBI4h3i02gzSiRxf0xwLHo+qOdW/",
-	"yRnNGC03kIXqbqWh/rEJvzS1ils47RKSPxMuEElg/VlbCnbiHO6AGaB2JXMHjGPZrzOXxShm8FeJGaTx",
-	"2Wc535GBds1YV7IWAM6INfkuml2ONvHYYMJNBQ06+RMSIedsVe8KzcCjfvqp+RcWkKs//pvBND6L/+uk",
-	"1ugTo84nlS4vqtEQY0j9O6ElEX7eCSpQdh563uKf09gSHblz9S60KM4pmeJZd5Up8IThQvhlN4qJF6mL",
-	"kSSTlokYa/yRMsvQJIP4TLASRkswoIgGJmqF252qke1wcZgOXmls15D4zcYy3irgDl+Oau5bzBITwD2s",
-	"HAg23c4nqWW4uEzXtTsOsBZe40G0crvcbdoPw9dRjRiXGe4UAxjkAYtQo3MFm+BAeu9mwZ2wd60smQc8",
-	"rdGdPiZsFJ1UsYSNUsxUenkp2yimZZR50bcXvQ7om1WJHN2/AzIT8/jsxenIoyEouTX46lusbVb3WJ/f",
-	"hsBSndKMbWpTU+DuZIzQOsAaFHVZ21xjLeSiWmDxsT6AYzmRGT0yv5aYiH70hI1azb+lAU5rumZyNW+D",
-	"zs+wwW97tur8dmlzqol6lxgScZIA578hgmaQAxGfWLa2aVGkxnlFa1wyDTNUivlvNF0/ASrFfJxLApLa",
-	"HFAK7Fo8ZGsT1CTGXNGQNDM6w2SDtav+1XozOqMbUKKKCIEJQ/wW/R6K74fRs2TGNpCXtClOk5dlioEk",
-	"a/NQ0hgjS8RSPc8wkA1iEEU1UUSsf5I/XXJeAttAQIouVlQqMcnf3tEZLcWmhDNFpUH4OqEF8I2ock1C",
-	"UhRYrI923bnjfnyq34WdQbOdQlP9HMVxtNxnf4Axyj4ALyjhsDQZavwzflvmiBwxQKlMdCJFKmoGoR2/",
-	"kdKkSzh+h8ltJGiU0qSUS1a+InpG1XOU/eCjpIbrkvpVzULyPcIpEIGnWPm9fheliTVDaC+7pBzf4KyV",
-	"AjeZNkd87nWa8sHzF7/4c5PU73+CHpjjr+D3vZ1ZN9zdMGyqH8dTtVJJc5ohkSD2Mglloozm8P56Q6Oo",
-	"yVDeMIp7ykxRmmHiZ3WKuUT4FXrIKEpfoeSWTqdOywmlGSAycGRDbVxocuOJoSenAXfQCDQC+HB+5q8h",
-	"E2jtyWA+ThUBOXoOAqVIoGs8I0iUDD5wtK4oLa0xt8TGjLeH+QpbIP8VjF+GlL9Mc0zWZoYiMUaKhtrg",
-	"myO/uvoSBi26UUcVKjKNKdaSC8ErII4W+xzkupqyagZiGGC0fIy0mncU/6pO1Jr6H0BnHwO3ND+9B7S3",
-	"pNzZBRiYa5g/15+FPQ6wMziEjbolCX83XaQZTh5+Q/efCjlTfgXsChimikyOCc7LPD6r9wbcfHVAMq+o",
-	"j3N0Py41/XEBTP4nR1hU47+fTnECb2nJ+No2woxFFanxXNGqR9CLuiQC2B1aO3Y1Y+j5j7GlVg9zjabQ",
-	"StzWWgVHU6gTOP3jR5zDV0pgw8kLS6amrGUv6dNSbEhey1mNIom1B+G/EmlS000ZZOEEhpyy/DTLaCku",
-	"yRWjMwZ8fSwZSmNMxoWlpUwjQ8ntQLezdO+3O93uDlZtowJs7ADPp1IdBAW0os8c+LFiWeILyZUDCO2U",
-	"ObbTG9g1zZyzT/ncu1E5eEOz1yB543yfXeniqm7YNg5hq+toeR9BEdT7uk1L65a2rBXHO3KF8wbjfxl0",
-	"WLaMyUFGdRccWl4QbZfm6ORaIOE9S8qLDAT4BZ3SL0TGeZD2P5cr9zaoEt/uI3Wmk2Uh0pS8pVnas8/p",
-	"f1SSFKaYhKhqrl0wRIS3yTBbaHg/M2QW3q3V2J1Me+RRlcVXAnA50uB8k80VZ4ISD2xBh7eNt3WSufn2",
-	"s5lJcGkSxCVXvaS5bSXBTnhenxvkqPgsleRYdriR/8JEqP9rjb0pMRG//FwNYVKhVwzQreT7YLbctTr+",
-	"SgTz1hK4w2y4lM4S7EGpJ8nIMOL+45fmsX3fGi35l81j0cc8t8PFwOADF/1Zp8O9l32FDDvL1vbBxG3W",
-	"Qm2/SClDXJzPIbl9Q5mJtrbKDEl/nMgBxlPKrCeuhv7kmuiPOxi66TesTOqhN9yRdMdwNyW5spp9DnEL",
-	"+YEZt50e3G22pHoZbZWuwddNEerCtLryzE0zDEP8gPNjwScmD/fW3MtqlJk4qlU502G+vHtiLr3C4Kog",
-	"O6o/gGgUJA3yiJUzCsUKQ8OERgVQ38x1fPAWc0EZXmOmbn+v7/Y37ApoH7ZcRZDn6xyJqxoO1X2c6ATZ",
-	"nqTUvy0zHHfAMB9SMlopm+3R1MV6FT7B0hzN0Qf4qwQuuuFRsxznACudTDXFqwwltxnWS6gA2Qll1jj6",
-	"siOMJ9UQB7Pv2jx/7OOX03Ixiqc4g+DmbOdsr49us7HqTSn7AIj7joj179GX+UMk5phHBloR5lGO2C2k",
-	"EeIRihSNqHYsjf2V09GSYtqhZweUsjHTE12Y09jVjtreSBLdRV6SVAIaeISnnWW2FhdNKYvEHKKkZAyI",
-	"iBJKBNyLGj4rn98p+iOaSyUoxIO2Mv7DYTuIN2ph/k2k1erYVRM3RihVDUKFv/ZOJdeHWEoWrZr3jqJ3",
-	"gxJ/tV1twrZfQOdYIMc+DDdBT0/Hr+qqy6DGPAbcglWFXfG0Qeeg0QDPwNCgV2PWzqQHXytvC5l+w6Mo",
-	"p+Z1+1tD1Wx8S7RxuI2aA5oU2AJpC0s18w3j3+Lpj5Q9fAWWABFGHLV3puUkc1wzKfPJim/n1JasCr0b",
-	"w3XXpN4MSkqGxcO1lKKe8wyLeTk5p/QWw8tSzPWiZASgfrInOWemYT1nVOD/BSVwitPkFSAGzBKYqH+9",
-	"scv91x8fJZbVoNKTqKc1pbkQhaUzYCKyWXcaOpWaUg10IlCi4Ac5wpnaT8NEIEyA8f8xZuQow6S8P6Zs",
-	"VtN+ox9F59S0jt7JRkb39FTPTqwhUn3bljP+t6mIU16WRBqvkS5zZcdVZVzd0NHps/j0+PT4R8WNAggq",
-	"cHwW/3R8enyq1ELMlcxOUIFP3NcRZyC67r9AM0zk0FVLRZRpN5XGZ/GVafGyblAghnIQwHh89tkI4K8S",
-	"2EPNI/VKGGVX5t2M11a0yAtaP406a1+5q3smuHLnxlbCyr1rnVu5q/OGXKevYxsDnQVi0i7W+q/DzZpS",
-	"ZVmq0HPgvICkuyFcSCPkUuqppggTARam86OP0I1cjK4aVary/PTUWgRTweb455M/TXJQUx/y0qDyrotO",
-	"WhS/w1xUyhbxUtXLRnY6Uqd/Pv25q6dW+yJCRTSlJUkbfV7oFfQNpStc606OpVda3DbQn28kw9vmVv/a",
-	"9QefbxY3kqS2OoU+PfJanBmI6GWhXugKGBr9cICR+TvCx76u5oHOheZcADQeALxCEiVqr2QAwoqCe9G1",
-	"A6CM4oJyDzZ0ChWhouig41w9eqmeDIBGklEC4ymjea8hvtFmDbh4RdOHbUrRxJweMZrSWZVMNxbcNLCL",
-	"3aLM2V7uzFDzejtY81omZ4A9m6WTb+3IZKGnZ2semhPVv3sB+Vo9CgNSBmK98VDYn3pR2oVCc6p6PkGh",
-	"vVjSZw9yGIUdgo/BFyAek7v7ULQLvfQVwoCiWCUCsB5jL8ItSo9wTWLjk68+O9ufiB/RzDe4cDBmXgtg",
-	"h2b+U7XsAzDzJ+47t/25sG0Z0alMzn3gtcHqeX0nwY4x/M9Jodw3pwNGs5LQYMtpBLWq9awG2o8J7YuK",
-	"7WsZ/sj4vHr6NG1p89aAYWFzzZH92dT6poNQ2Gxa7DZ0toMckF09+Vbtug2Jqe0KJg+ROgHxRdd7A/XI",
-	"S9LdRdxNtN6HlRcD+j1u1N4vwgsQfyf57dp6ON5mX17t8fOCfgTp+PHpgui7lwxnHVvzktvSi9a8DsCx",
-	"1q9h9Kcrut2AZOXC3qT2PVXZEs7r92s8SJcuUrN8uEE37VfArTPK4+coih8RJj1b+GquTzZNcV/YHGZ+",
-	"Z2a9+zO+5n2sYIKiJLDb9EQPcTA29OSbqSUYkphoDM/wHZAICx6ZrhEiaWRvuvUlK3sCtj9KqWsldpOo",
-	"9GEmZMZWsWKNYR43q1ld/hcg/i7C360BurC+aodIqsd49OxmdSTZt4yeIpge1dm6HD8MZ2vyiRWdbaPX",
-	"SinPyorSGOoAXfVJo2w2aK6rVioB2sB6X7p3Sj8VzQsXA+p3rJZSWp5wPU7WFloYZeINzoQqDV6dLZSJ",
-	"9yxdszMglsw3GFz1/x1lJazTPS01ZrcBjyE1qrsMEBovlgbihMumXoftaDAK8FI4aEN38q2uPV4Msnob",
-	"GL1L55WAp2bumpQaBduHEQLXrzr70V3Jb3AgbMW1aixcjfR0kH+i3dd4Xr9OvVwRmn2O+xDffFn7nwr/",
-	"gG/IcI7FcIe/DyVqv8W/VKdU+8hAYY8q1hr4oDVuzO0FYMN0a2vxtb547OnH2GsFZDvfdmly2aMpFciv",
-	"rVBnO96RCY140OpRvQe7XDtU061ln+fV+63f1WMn3kQzeEnW4Qp1g9SjS+YQQW9CJ+FcOxdEvXFxtu2m",
-	"sNeuvbrw7jvsd+MVfPckejRAN4s+OsLdtXMIDnmIimL2TcYT9zLIoKrYW1Oq1psqS+cqyu/nTTXEO8zx",
-	"4Nu0iV65Etk1wnsGPWSMD/IGFuLbcgeGV9/9wX6UZalDsNjdp0cIj3kA6uLegdNflWdbDqjLu7JE/2GV",
-	"ef0HJY98RuHekhQo7rNyG64MVY/DeNHeXtDkr9S7qp4+zVq95j1qw6r1ao7sr4SguiYrWLFnJDG8jKDV",
-	"b7JGCZ+9BvCAjO7Jt+prtUMK+ewK6kCk6r60mG9v2PcHIO5XeXdT0NcPqbDpWqOob49I6inrWw8NFyD+",
-	"TlDYtb26qL3iTpHljvPoZX7rIUuXXz1dcD2yv27y/lD8tamp24cKfGqC70Dd9Im64Pdo6d0L6hKYLKvf",
-	"t/8yBwYR6r10ucdaq9uO9385wyGY7gFfmvc2WeGzkI3rqM++Lbnievlnffu+Yt/t3O92lOSj85UviOio",
-	"YM95pgBGUBZxYHfAtOrtVON8r9hrzRqwEaGv8G5sRwTvkmhda/JGDzFEfdZ7TXbofsQmexBbL1lpaY++",
-	"C31ctO/vxUT89DweeS78Vd9lWaH91i9eHlfatnT01o3CRb1V1Vi4u6jmIDcD9PeqdfOOxixf7cy11XeP",
-	"DrFHPYemrAxyeld7c8SN2spQJ6yuH1QvV1n3oKt7y1T16ppyHW7PtR9gkCl28TkuBph275CPl2CYrRjn",
-	"BrGmNW8u7xlOIS+oNGJRhZMfOqC5ru7leHKIWS/naNrrduzS5Ph79QfKIt1A6Z+MOzGZNb+O4Xz64xkc",
-	"z45H0X9kYKCiR+ARAUghjaY4A/7ABeQRL4uCMhEJGs0RSTOICHwxcuURmgpgegRzpvafWIoO7lFeqIvA",
-	"HeITwaa8Iojt9eFHQGaYwOARVv5WiTcWWwxR5Tdh1JrPZtQgT62OT8ssewhucV6SO5Thel/zGWLJHAtI",
-	"RMkgyjHPkUjmo+gLo2QWOQgZRSCS4x+W3h3hTHi54bhuO6B9+p/w+wN92xbOJwF8OxMrvR6wSQX+9jcS",
-	"vF9/GLaf4DBlfxsKfS8LfGrOa3hI1O64Jzwm1bc2+i57jUwz351R9snuLqcJAkJdaqWeBhmlVzkHlOmv",
-	"T3hXqR9HQNKCYlXN2VzmW919iOU0pPqnk9EZJifJJDihCyzelpPovRRWlKAsm6DkNnpmfs5pChEl2UM3",
-	"WHgnKZ9PBk21Rb1gVCLVY89/On3uC71SzCBRDpIyLBOCLHuw5h3S6NOHd0PuqNBtfvQoBEGlmFOGv8LK",
-	"ub1s/ePWAKiIfqgl2v06CBURln4/ByKXLg3U+8vX55FcgRJXHw6UX1TfAqe3EC5Rs82if/3xMULaqqge",
-	"0TM1Vg8qfjddP6oBhmCjGkyP0IzePcLCxrtLR3pfSDOsex6EKIzaDBLGF5jMKVUfivcfxRtipt1gnfzD",
-	"0PV75zkg/Zar8c//d/S2nBxd4xlBMjxaK4rv0rxQbuDo1zsgYvvpn+JfxZemv3sCunj3o4lDwqJXH7MM",
-	"e4n38nHcFyIJuBcn93k2fLmN72cuCYk60xsUEbV8gqLxYcgdQ2ZWEZ5WMT3HX9XOv6A0yhCb+Vi9WPx/",
-	"AAAA//9APITEj6EAAA==",
+	"H4sIAAAAAAAC/+y9/2/cNtIw/q8Q+/kA1wPk2Embu+fJgwPe1Gkc35teAjtpH6ANFrQ0u8uzltSRlJ1t",
+	"4P/9Bb9JlERqpfXuep3mlzZekcPhfONwOBx+maRsWTAKVIrJiy8TkS5gifU/cSrJDZEr9e+CswK4JGC/",
+	"XJ+y5RKoVH/RMs/xVQ6TF5KXkEzkqoDJi4mQnND5JJl8PmK4IEcpy2AO9Ag+S46PJJ5rUP8WjE5eKIjT",
+	"1IK8u0vU35Td5pDNIftxtcVRKqjTq1VnpA+68YzxJZaTF5MMSziSZAmTZPvDS2GGL4rzV6rNRgCLYkoy",
+	"BycnKZaE0X/hJdwDogMzpQqOgp0uMKWQ3weuBeHBzLEQHjRCJcyBT9QnDlj2MmMUBtnV5IWDqaieVDjV",
+	"vymM5pyV9+CF7u64of+4D70MtIpaJOsCUj9TITFNYXOsHQSHOAfB8puda4IbxtFewA1wa2q6AnEDXBBG",
+	"vY+OBBrj/5SEQzZ58Zsik9Oomp++QDm580aswXeVyGdkUw0atP9UEYFd/RtSOblLKuP5stZ5Zy+btjSt",
+	"DekSf34LdC4XkxdPT579sI7UWi6MiINIOSmkJtLkPBOIzZBcAHJYIKCSExAJugJ5C0DRU4Rphp6enJyo",
+	"uUhYiqCE2R8w53g1qeXDDDrDZS4nL2Y4F5C0kLgwDSNY1JaQ0Llqs0REY7xCt8CB/kWiFcha068YywHT",
+	"AMPFGOpfgCjzAA/KQgl41iXlv8rlFXBFzfYckG/MEePIiXSC5IIJQDjngLMVIhTJBZZISCwBYQ6IMolS",
+	"VlI1YlLrGKHybz9Mko78t6bscO2b9ns8h9Cqrb/avyqe//8cZpMXk//vuHYEjq0XcOwAhkRBTyGssJJJ",
+	"nJ/Gvrcm5DV2QBMf1+BEC/KBXQMNqJM16leroDx7Nn/Y4nKXTOBzQTiIvj4DFDWITo6FnJYCsnsBp8GF",
+	"RpP5hl3fEzhneRi4dAxoqsyHBSD9KUGM5ivEQZacQoZuF0ARkX8RyDIhRGvdc1pwmJHPXdg/wpxQqkyG",
+	"tW92IMkQh5TNKfkDEJFdwKF1gho7rufXGjjxxaghNH3CeMrojMy7ItmUn+aMflLfVq3pEImoWp6Q7WlI",
+	"pzg1STbkohMRb4l5fpJMloRWK04P75s4/0LgFrhBVqAUU8PoJb4GxAFnSJEahHa1gJZLRfEb3WeSTHC2",
+	"JHTyqTNYi0M+c/poHrZ0vaZJYT3cANpxQgbw/lbOIhOeYBGTpwY3vowwBwVnWZnKqTFGa2QmxJAIos5n",
+	"CjDCuEzD6W07BNeb7W4LwpuAdbTV/uDw6ejmocmscejFCJluMcq0C3FqnVycZ5vuIjzBuuuztT51m265",
+	"pWtSS4xPDB/FiAyKiM9TS+cYpa867d/x8REOzpWni/DguMyI/IlKvgr7fkaknUU21J8k1qHU3MlBQsA2",
+	"JxM8k8A1nCwjChLO33vwjQFprhKX2t91Cxvmc5BIg9E/KDbPwboI6ocZgTwTxlU2HzM0YxwZ7ER8jQta",
+	"omEmLplcwYxxuP/EDJydzWwTrzViYQzClj7tfWNzVgmCZSFXGlmDuN5X6p0zzlGlnghLxGgaxMIOZ34P",
+	"oFMK4M4utbEBKtWGy+KkWqLbBUNLnDUpbbDUPhIu5UJ1M1KAiEAZEYq02TCfsBEmqnBLnPo05+MTsyN9",
+	"lWw55QnqstLY0S6M3YEON2e1XdiJF+PwCc3Qred9hvle8U+f5neeeewliGqjDXnOeN82cbe+RkRDI656",
+	"p12B02srOn2Tdc3qHpvT2wJYu84bwjZX+CbDfWQs0zriMyiu6/zFWtZibnN3eeguc+G1VSEyZ0f215JQ",
+	"2S89cUerpt/aWGbHnmjkatpGHXJLhohZ2aZDvks/qEK0Z4q/mMDtB7KEnNDAbHVMrmx7PgtW8nw1SSYZ",
+	"Jvr/twDX+Sro+EgPdlc6a6FY4uI31faJQuaT+svA+FSasF57zh5m3iDBucbEOU1BiJ8xxSa2+ZHnG5tR",
+	"DWq6rGBNS25USq2nP7Ns8+OkUi6mSwVAQVsAzoBfylW+MUADYio0DAUzZ3NC7zF33b+ab87m7B6QmAZC",
+	"4YpjcY1/iR1bDIPnwEzd+YSCzUiWviwzAjTdmIYKxhQ7IA7qaU6A3mMPqKGmGohbi9VP50KUwO/BIA2X",
+	"aCgVm9Rvb9mclfK+gHMNpQH4MmUFiHtBFQbEnTYgcnNpN507S21I9btiZ6XZodBUP09xPC0P2R9YYpL/",
+	"i0kyi0Z5Bqysm+xgtHmeZmQOwl9GqrOgZALU+PbBj30hdwM0HJGl1TaoAE5YhlJ2AxwydGX2cwoA0rgh",
+	"i1sycEIcUlIQl2kx4tCN5bmS0hkm0dlWbQglYhFuFTktbboZHpIBqB1kWmyqedKh9No4ekfSogHQlmD0",
+	"n0ReAs0QbnCs2lPqLThSUyk5CMShYFzWrK62uAnSASrzBThXO2KlMEm/UFaINfb1PtcaEtESREUOhLOM",
+	"gxAg1LEhmRHI2me4z0ee4HaEqYVl6wRUjWoDGboHsgBEcPIhMRwK3wFGrjMSpbZ0szLPV4HhOj5VRcxB",
+	"4jV+492GMNyN7nTd0Va8i2GQFEqCL0AUjApYe8DQ+HPyplxiesQBZ0rMrTI0A7tdS87SLuDJW0Kv1dFd",
+	"xtJSLWMmZvMdK0wc7q8hSHq4gK5UKomIjhvNCPBu/xYJDbCmRAbJpdbm1yRvxVyaRFtgsQgqoPrw7Pnf",
+	"gt9IFuJ0zw5SkD8gvHfsYN2QrmH+hv5xOtMzVTBnOZYp5i/T2OkOZ0t4d3lPR9eAYaLh6O7ptAdnrd2d",
+	"J7Qmcvger3KGsx9xes1ms8CyOmxkC21aGHDTKwtPoQE3QGUQh9gxkXgFucQbI0PENNMA1OhLkDjDEl+S",
+	"OcWy5HAh8KasdLCmwgGbctEe5g/YAvg/wO61IBMv9ZnypsTQIKbmXFqBFAscVteQ+2RYl3RUoQLTQLHm",
+	"XEy8Iuxokc+TXF9TxkbQLAGslk9tkLuj+O/rQGNT/yPS2UfALeFnzlX3FlT2otgDY2X2n5tjYQFUGBzC",
+	"4feagHXnQ8Fykq5+xp8/mqOu98Df6y2Var0klCxVQO4kCcVb1yNvoE+X+PPUHqVNC+BTs2nT8zEt3s1m",
+	"JIU3rORiYxthx2Ia1HShYdUjmEmdUwn8Bm8cj7BjGPynxEGrh7nEM2gF4zaahcAzqINy5kcVtvyDUbgn",
+	"8tKBqSEb3iv4rJT3BG/4PJUWWHsQ8VM0HrDBMGLqdnJ39cbmnL7nbM5BbC5LbotEVLqZhaVGkByn1wOX",
+	"nbX5FF10uycwtY2KkLEjeCGV6khQRCv6zEFYVhxJQi65XgBi8QHPdgYdu6aZ887ZngUP2gYfyPUapKCf",
+	"H7IrwfBOxDjEra6n5X0AZVTv6zYtrVvbslac4MiVnDcI/7eBGYH9RI4Sqjvh2PSi0nZug0Eq/yOYn7Us",
+	"cpAQZnTGbqny8yDr/65mHmxQbXy7n3SUKs9joBl9w/Ks55wu/KmkGcwIjUE1VDvjmMpgk2G20NJ+bsHc",
+	"BYMcEx+Z9shJtYuvGOBTpEH5JpkrykQ5PjpAtK3swPsHgiwm0akpIS6F7vVh4yNOQmXoqNMOYbdCP3LA",
+	"14rug8ly0+oYTV3xh7nnVDpTcKHfwCYjJzgcam2lwvbN0YF/2Uw13I+bX5ox4bIUBaTVeM2Qmj7mUIPq",
+	"2Oz5e3XHxXLGhc/dJNAsx0XRvM2y7RtjFdJT4bBek0dDisj5z5XNfxjCn7emtdrVumFfOUy6NLtdgFzY",
+	"DMeKOESgqq+inFhghQsiUqAlTheEAiIZuiVygZjubn8NRNiH0aqmUEW1SL4OKfoDCJ4ivOzL897Zxnsf",
+	"+uBd/Gyy80x9aDJzDlJozlnnA804W26IRuPG6PavcioFPl1Aev2acetxb5WK+ngvVQNMZ4w7b6wa+qO/",
+	"TH/YwdBN38Exsx76nlFpfww/MG0vt0B21i82SyzThb3diLTPGz1eTNDtgqQLlJHZDLiRKWSl0uS1NmRw",
+	"gQW6AqAICxVmhUwdomBqbIfutrVbunaq04aoCu079LmFW9glW8q3N8k392Nqzci2NazVr7tRrm8S11eF",
+	"/c22JUhY5cLaEBLUAPU2jOg2LjB4xkXjdk7PcCB2qg3Z4NyMa0IDGeSUTXVAXEwrUe+Kb/WJMmRaI8o4",
+	"MkSsEs2J6TXHRaUdDIS6GLwETNUVuVsskHIPEVa9IL0Wflq9Xk9ZKRGmK2n0kNmhETY3jDmkjGfqQJ0y",
+	"a8gIddcUlZapRvr3ZsY+EkQ7Pcbyu6zC7twnycQDHEwvZHTO7JavSclfQ57EUJQCe242lLUtvXAQ2aRG",
+	"1nK/z1mo9i7Dtk7dBNtAymT8YpMb9aeb8A37Q4id613qqQ3HbMM4a4DT1IVxYyfJBYcbwkrhrYbbGN2B",
+	"bS2N7np9F4/WDZDYZta/hfGhcoAr0OvP6huyIOKpwUZXB+9JG2CDe1FcjIfm2eMAyDEO3DZ42uPQ6RjD",
+	"TxXJNor1aBjWRkYiPXaExHHHkrWPz9rZencDnJMMYgFhs4BnQzx8oUs0eJ5Voi9eIw5LdgPqGhcQbr8v",
+	"gUokmKlbMWdInemrZUYvXB0nkHDjBq6/rt0ijcO+jwxvq11t+CJbPDGsCysc9mpcTR0l5dEI19DgVuMu",
+	"aB8VTFTrDRGSjbohFeofjDiFGz7MitNYUcZXRPLWjySypvQ5+jfAiRhSk6dyjl2Ppn2vZ9HHWJU1ATbQ",
+	"3M5Vqz7ESrfUqm0bJyqWpe9j3rIyz9AVuC/K7cQo4yvESzpJ+v2UKlaY8dVFSQdk3Dpkqy69c/ZNWxaq",
+	"dSKuSVEMnTtlsrFhrI3UFaS4FFD/8hfjhlPIkQCurJ69uKTvf7qdprqPRCSksuQwmFQDKu3UKAfR1cy7",
+	"NSV2aiusNgPGRGcDcYmU1UkqqoZ4o5PmY6tMgYW4ZTxbe9G1X2GqlkkNMYrLOZ2xLibxmi2Al7Fj0Y0w",
+	"dNVTLNwQnmyJF/jChBK6gfnmRcYDvCNq76H9mOP0OidCNhaV/mzrUZk+YnpVDXEwGT/NzNc+enktVf4Y",
+	"ySGaFtTJKu2D22ysezOVu4xFKDnZ/I5uFyrARiq7pWLwS8zVphkLhJGGgepgTuNk/2Q73rQeY8oNonc2",
+	"D3hckudrBSJwM55mSqBBmJppzWm2JqeDBPqyfMm5MpQpoxI+y02PFoiYavgJWyolKKQpohlJS45v+9RK",
+	"ICQHvPx48bY7xY8Xb+s7Gqadu6yhVyQzJzdtZfzFiqYqJmLiFdtgoBu4uhxW8nDCxbjyhHZDWwtfqe9g",
+	"VRrTzuoRJuFTS0+rlGHHNHVDl+Gb1bXR3f5l6bskZNFG3Xd6ZFbpfX3DPqrjmyjIfcUteoO8y5620HnS",
+	"aAXPiqGVXiOzDpMe+RqdQmH7Dd+7efUNtp9GUWETmiIHCVQR7FXl/zenKZUJGj6TCt4H1c+g0plVG2sz",
+	"RC96HrguJ0ppbzW0FlJ2KxDLM21pMTXLjM4GqLZMtbs9sIxiX1JYhWwAFfeprk6jLh5xhaFezbOSayFP",
+	"EDyZP0F/f3ayOFmeiEmwoN1t05+KFd1MDGXXK5lp5l97rKdixwsyh+XwI6Eu32v8fdpGiuPaOkc7qIpZ",
+	"HQkOGT8yi+g+RZSGUj3fYjWFBl1yrZBvULIFu/qz3ueMKQvpsXhYompb7NWMUumuRoI+5a025lYXvJkN",
+	"KQPpM23QcLrDhoOFq0e+K4BjybipHIlzFT9l83lu4g86J5NAVcC4qgvXKSTJLJyempINOWphcf7qVE9T",
+	"hxPOiHxTXiGp3Mzvfp8wPj9W//598lf1lfE5puQPLDubhWfPn6+todkWVzcRXSjAitWcyEV5NVVjri+N",
+	"2S+jayRx9Hrs9R2xktWddrMuN7AKTVlvB/ipUZbLKo7ZsrNND7ZjXaP1A/SpzI6Saaoslp9aGb/j4ZnM",
+	"WAfvEoBuIydGANBpNJHCka7yNmt6ddHwJxrnYox9o2sZhYQiIJ4UPu+Awwqqx2Fe6kLGYW/IbT3HTu2j",
+	"7RebW4tXDgd/yo0KnDUece60xgzYFiogLSW5gde2zsLGR4gerKkr2uAqC+VysQpTcxfKZKeyfRtgp1Vr",
+	"rSmBsP2BbG2FnshGO+qr93yO0kmQsU2cm6Rap+x69YvWcx/lmprllPH5yEIrYy842ppHmCxHDdRXOa+e",
+	"bhN8c1Yx+kUPwJsUaR2CG+/Hd3OEPWFZgjqVMWfiS53j7c5iNLfG1B0J3KJ6tt57alG4VYgc52XtKmqX",
+	"TqElkO6gEXYJmKVQ09gM96GVsF32nkuBCrMidn2gNYpptn4Yc/AfG2yzZPcWKhZIEBcBvDvqFsvVuj1E",
+	"H/oKiQvVbmsHXFoj46dca7d+quuQU0JPF/7+rKEL/5VslRatJxrUPscrq2uLFQuQLjNZ76gqzbIFfLTe",
+	"/I/7q6FNriW2ZQ56aT/GAgw4G+3Z+FTUePGlu4GM7xpVN5Nw9dBMDKXphK9H9efrBOKswFOg0m4Hax1l",
+	"pYln2R5UH8uPCjvXJxsOhUljuBCjbuFqwdj1kFDY+lhXjoWA4ILcyUfsvC4wymb1RjS3ER+LlwGClEMg",
+	"rHGpf1dKrRdqQebUnpTpKiOi/SYLo+YxlkjdJvtIFhlNzUEOpe/3GOeyN05n2drAyo+7rrHJVsSGH3i1",
+	"AkaKbrQuVtZ5D4rZs9iRQTJPWjcZEAQgCyJBOHdxwaVJYzcHtEmUc5EknTY3x1S0i0cYB1Nw2BWWERfz",
+	"N9eWOVDgWDY1pUnbNUqzKVNrKLvjq9XSJooLKQsV9VT/F6jkeRhRzFWwVkhNusnAy/tqwB7dfAU5uYHY",
+	"8xVq+OhZtNTJCP0PSm7gjzZJ4/BDJEtM5FoAle6qyv8e/ctWYD2qGpqSqyH4eg9u0b7X+1xecGLISqPD",
+	"UAPG7XS0ShF4lItlq1oUNCksPxNk8/e6FKqqWx09e/43SyZ1mmdqR/3j9/Lk5Pt0AZ8R0JRlkKE3P788",
+	"Pbp881I1v4YV2Auz3mjIqLnuCpMkIkFjXryr6rI537H6GPIaLRqDirsbyI0+SQi6f5prqjVO66TcCK89",
+	"wa/buhkUQG3xg8zIqDnANbVA7zsrPYvglMxfToS8G3OV4nYlc+iK7pRt9NGGpcCYRPHWkLs53PDw6pn2",
+	"Bi+f9SFWwR1NjPU5CqFJVqN1p2hW7JITubpUQ/khrFPGrgm8LKU+uCEmKKx+cq7kC9uwNmG4IP8XzIUa",
+	"lipE1kLQ7UIAVDTqR8AcuOt/pf967SzoP3/9oKRbYz15Yb/WkNSa6uAMwEM166JhLnSatN+UUYnN+aYu",
+	"/aprWxAqMaHAxf+xaUpHOaHl5yeMz2vYr80ndMpsa/RWNbKLtEH1xbFLdNJ97zq1e60113mH1CXomWcE",
+	"+JOq8njd0MsZejE5eXLy5KmmRgEUF2TyYvL9k5MnJ9pQyIVmunp/79g33fOQH1fgOaFq6KqlOx5WaXDZ",
+	"5MXkvW3xsm5QYI6XIIGLyYvfLAP+UyqlrmikXxJm/L19e+yVYy0OmsIwjPru8Oiufn2u0Z0bF5pH9673",
+	"8KO7eg8rd/p6ZjDoGZt9adjh1PcX/Dd3zQPBIRRK6jcMIeJd0IjMQmIuJ74dM2tsDanymqqjl4EEAprt",
+	"BnCB59CYbE+JxTgQ4HE4T0OAPtXOidbZZycnzjRZ98nbEh//2+Zt19CHeGN6sbvrZKxP3hIha1GxR1rI",
+	"oaOMyw8nP3QNhjMDWqhmrKRZo8/zk5Nun+ZQpux13clbs7Q5aa8Uv326S8yvvt03v3ZXNvN7Z7n67dPd",
+	"JzVSwyoee7Ku/QEmAibSaxTbdJpALqHoqsyvE+9Ja/Vx2TGp3tPanlW1JSPUnmDrQtB+Sj0gD9bD1Fnv",
+	"oSl3FO9uD8IbfoQ8gP3LEJPCQr09LJu14ANYndMbnJOsxgg357NedYLzOgANKgoR9SnmINHLohAdua9c",
+	"CfNxgBvxNdpl9+BqQF7ODOX6BLcVQKifjB5guotCBM32/uQnidhYs11VAeCO0JzqTy+LYpjEpDmjMLV3",
+	"WeIe2Kcd2dvq6ek1FrYx4T3a1UZ1mw6GhtbbEcGgOfMGOAwjdvylvVO5q69Gd/E3vwfl1Fyzjsup2pj1",
+	"7o/ibm1QeLsS0om4Qg8vn6/p83DsSeKrSojuZyAfkuj7UMszM/URTnpRjPHP3bLzkDwvygDPbVgkxHaT",
+	"ZLA/zj/gWtGgwsGsFYYBO1wrPlbTPty14thPpO4PsLmWpg5EUKadf3xapxHvWLT/POEQ/2nfiImtODTY",
+	"zlpGjbW11UAPanD7HPH6KkLIGT+tvj5Oy9t87XqYp15TZH8WuH6hO+apV7Vmdumtu0EO3woff6kC/0Pc",
+	"eDexK5WWEHHo9ybrSRCkf5Cxmw1Cnwg9H9DvIDcK/Zw9A/k1sXXXtsZbsva1NB7sVqRfsGzm86OVrW9L",
+	"bXyjs7Wldlvq0sLrca3O7qWSqfTKzEbNuTpio4Eqd/YWJgKcLvwXNry7/rrgEVlCYkrDK0jqrah8VYHR",
+	"5bMFEhQXYsGkMBUOgXD77jWmGboFuFaH7CzPy0L0LCj2em5VPPdR2YCk6yfV91W+P0EZXqny+TPGAZmj",
+	"+L7z/8CxfG/x7L7BKbuNjGbwuO9Y+ri29Cul1OJQCsgSVBBTONwJkYKMOKZzMHnFbEmkhAx9Z6VL1cZl",
+	"6O+aZomVJPPb0/86sb9aqbqCFaPZXyMTrFFrzNNlJZrh3FvyOjtMAQ3VQtiD+9AW/4A5tU2QU3xF8NG2",
+	"1R2selxwh+6lqWejTrHr2UTs6rZMcd+cDtcu67S3I9p+lL0/jKVkX3dEjY51VMu7wRGObv3UfWj9W5xr",
+	"a4oYfqo/lv8T4uRgJXy5Vr/i2UChgQ82GoZpAF+3UNjnQhErZcqWMEQTTFylowePNp7WkblR7n6XtHv1",
+	"/DvI94TbAlJwMDlGIQkdsgb2Rf4CMB/Vcnb8xf9zWFAwMOe++OADaHHY5W/O9J5xpR+iYb/BKhAA8VO3",
+	"7wiZjCNwkCHIwXJ0BvLrFKI92+gzkA8onZHRDzWOOVg6TZTpqxHQg3F+onw4POfnY1RkDtn52bG+x4ny",
+	"uD2kY2nfmgjviATQDGGkGtm523vLHFJSEMU9uwEKSnbTtnwAIf80/tOHmmSinfq/A/n0huuVTtX92f4U",
+	"9tJcovYFKITR94FXbhi6/PnDe/eIQaqtbMkhO0hVq5+z7w+nmXYDcsLODMBvkbKtyaomfSw6pjx4Q/Lh",
+	"DqRtP0JJvVEONvilyYRIWDpNnODMVp15nNErPcFREStXZWd/fpoZMR6Y0hzYbRaYGeJwnRtjSY+/2Fvj",
+	"Q0I9RrTn5EY/Wyyqd8PVia8eIBLz2ZO8h/2U+lb8bvLB+kQpZvQ2COM8tDj1RG7Gi8UZyK9FJnZrrs5A",
+	"7lzA6jEONfgyXsDcG/iPUcYedMX2KX4YK7YNHIxcsRu9RmWWjdafxlCPZ70/blRtjRr3qpXJE9vc1p9X",
+	"wz0ihYyXbDE1xdZCWr/He5iNYmxijMvXJJfAN6rHw7h8x7MNOwPm6eIeg+v+uk77Jt3dy17bEI+BJY2a",
+	"yqar5dbaZorin79HV5AzOrclsIlAp+evLnS6FvynxLkwP56/j2TDkeKUZBvRk8Fy3AREgVNAApRyS8hU",
+	"xElIjokOcdpKSzkUVeJpgnJyDej3iSmj+I/vnz9VdbpOkP47/f5vJ/rP3yeRqWlgv2xC6gGYbglHC+YC",
+	"0/lGUlm9aPNy1laLoYmbvWB/1MmpW0gITdlyiT2SmkL+mpS1TC9LIdEC30BFVqA3/yg4yxKO0+t/8KfP",
+	"opQ0ACcP5aG7SfTdhzxvLpVxjyXqhgchPEaX4vhLXRTubpB/cQ/34tyr/f7YHIsmpEYlvcPYmjqUYkJf",
+	"8W/wBtWxa+wetRrp0SvEsa7JK9Ze4TDNEIeCcWVUr9TliooOqi40o/O6OPEcF+q6gbwFqz36CbAjQsWT",
+	"PrX5ySDzZ1WesCPWJj2WOjtQrcL1A7VJ4JKHYpKrXL/b+x5BPC0iHoqU3a7HaTu3QsRCISEkKoATZspm",
+	"q1TfSg49eqrXdOe4sJ7AswX6zmIYu9exJPQMF5OtnsU93dYWa89HcaShun23R5qG0wjLPW+NzHGxDyNv",
+	"cH38tt7ECqYLIiTjq2G+ULNPr/U2byG+sdD/xEY8uGkgSyKHR1f2obI+vwiItTprRAFZUdijl9Ua+DEq",
+	"on7AQAxXua3FOC/1uI8/zrlRUGznB2VNKgezHC1TLx1T5zs+Q4uN+Bi1pnrXYb3S6KZbOxhw7zZ805od",
+	"rT2GwGuiVz5T7xHC6oJ5RLpg/a9BtRbsOulfZb6PNhj/4KHrIXz1a4ghs1aIvu2TaYY+eMzd9VISHfIR",
+	"6Y8rVnLlHg7t1SDbGlWt76tDv7SeLf2WZuRJfoc4PTUnfvQ5smvB7xn0EYr+oLXjJlAHYwuC/2312I8O",
+	"rV0+nEjvc/2Ij3m4WtRIRVqThKwrCjULRuhXS5WmeEfNWKYL9yL/TCeVCFRwdkMyyBIVzs8BC4kYdZ/V",
+	"y2JOxJ6gD42Daw5zIiRw874kkwtoVAowT5ReQ6GPbTg8ieRBP0AK1PaeCKtynsbnON3ria9DyQqq+1YP",
+	"E0++P8kGn9R8S+35ltrzLbVnw9SesEqZEEMTEbnAEt2yMldnocisGbHKexlfXZQR3Z7hXAReHd9LnEa8",
+	"smgHXArzyZvw4NO8n4kQakVkHBF7sGdXvs3r3nSweQQ+xjF8LhiPRziF5ICX2qdoilanUNVaL0Og08tf",
+	"FHkp3GpfLAN9JAUZ+uflu391/YSfNGoP7ydYSxFe9VJxM0mqgormL5ppUf+UfPNEvnki3zyRb57ItyRj",
+	"tf5/PqJZ1wfoTHQi4bM8Vpa0t13HGTDLxSbOgEvtMYy4hwfQQeExeAB68VFzCd46xEKQOb2/A6CsqY3m",
+	"JSqEgHmWK+a4N3CVOKp/rWxanH0Id8nMO7iEI4PJEqj8n7Uhi44vcVknCQkdRXp3A5yTDL5FH76t+d/W",
+	"/G9r/rc1f8yav/1r2Q7/hnEedk3bLAxq4amJUK82e724TUJrTAbBc8WXZmVt4Dwfd7E7GsVIqr8NxM1d",
+	"mjiWj8G3aSQfD3N06rl6QtT1duJODJHWhRG+D0Ok8DyYPv/kgdyT7V2+OlDjEObsAPPww1qt2EB1z339",
+	"TGxv445UD1qoYnUCFTi9xnPncdvzNp4uiIRUlhzu81xFIDGaWCfCF3XGdQNDS+vL05VFeqwJeQwWxNF8",
+	"fQ2+Jnd6q/C9d0D/ZHX4+vcDA1zLXR4zWP71lfJzfBuep1D1CKniwRTns1OP1OV7X319nJX57OxG1ear",
+	"KbI/l9GNGa/PZzkxfG1p9bvaoGCfxeoRmOjjL/Zfw8r2uYnVGWVV97Wl+/amEmGfrEJ0Z+X7+iUtbug2",
+	"OCV9eAHrKeK3mZCcgfyaJGTX1u0M5F4Ezh/nUIv6bSZwptja45W5B170m7Q/lEXfMHUvmvGxKXyPa60/",
+	"nuWM8SP3Pmxvark6vXIN1YubHBCupk1Ugiy/dnUYNNge2/5afT91o/6pDH3BFVEkMb19yhMJSxFtMvj9",
+	"5GSiqT/lgO3gtMxzfJWDm1zgtNj8wq7+Damc1D9gzvFK/V1d4exuTNud+xcpzXnkWH8Pzey5OSuBU5y7",
+	"dwq0Rh6SInKWw9EV0c8wDHzvU3VBrsuIlz4vWA4/upG+vVywtaWH13Ttfd2zybctvuv5Q/iBDqkFI1uS",
+	"6nFMb44DHwZt4ny4T4I2EEVzjqlU/7C/M9o5a2Bc/crMBZbqGpR+bTytl6JQGMdTo0cbyvFEdlQ4x6fy",
+	"Xt07D+GeuE5DCA7mNawGVgNPFnah0CEiHa6H2lgYj7/Yfw0LSTWm2Pdc6F51Oex4VhPb2RuhA7TinuIW",
+	"dNIu/GHHh7MOREx7YloDpOwM5FckYnsz52cgH5vUdlA+AMtaZkQO21Lopihnc0cz956boZryiOb62mum",
+	"2nNWzk1N0JfvzxN1+wSERDPChYxuPl5qZMIK0E6Q62rA6G1GKYDrf27QF6edrMjqNopeQieJjTJOErcS",
+	"Db+ZIjGfg/ygGodGaG7kXEKF288nkzq2Zn+buvAKqesUE++Gzy1cLRi7niQT/b7ftPUEJC7IVLJrUP9W",
+	"8jutvTsJeKmmKkwG34qm+h+KPDdKisfOeePU3A3rmW6zEumfZCur7UDvJra2FFvcwMb3n/VwD29P3b7w",
+	"+Iv9VxUzHRC40e2auS2o1uqwzbSBsddmiCHOQ4XYTkI195HprYtxKy5acg5UTvW4vl4TKr9/NumqUaKT",
+	"ice09zOYqujsoCOJbgRVMonzaRVHXTv6nc/O32pUkubE/Uk1B/k0IDLrxK5KlzOyPa5AW6vvQWvt0EQH",
+	"m3BqG6tDDaPNOhsyqsUXupevw3tQ4b3lN5jZNdk9PIBvAv8cck0usSDFgFh+cMiDO3+2UZb6NBQ3bX9z",
+	"1t+RDJYFk0AlqsTnr6Fk5scqSJsFL5vWvX2G1aT4O/0PnCPTQKulOn80F+eI8BXXMeM7eDJ/kqDf1QGR",
+	"3meAQBRAXaubkRzESkhYIlEW+vqhZGiBaZaD2utYvgq/XL+9L/P7RLEOPuNlkcPkhQ/8SvKZqAASaqEc",
+	"AZ0TCoNHmCSTJf78FuhcyeHzk5Nk/IHe3d3dEA1/HZdasw3KaiHPnOrPyjxfrU3Rdrlz3/lZ12hJhL7w",
+	"mKBbzlTw3o/Xg0yf/DVqT6xyIA/h9fbkEuTBGBO3Wpm69MemSMS6GhJyYcTF7cgQUMmJkhuata4w2TKi",
+	"VRlq9dWeeJgU+bqp2ezbK6K2HxboUh+gHl0qS2UeBtAVKPQF0wUuCqBP0MsOIhzM8/pK9aqPrjfNvGEb",
+	"GOK62NbUfjC9AvdQNS16XxnZQXxh4F3TVnTaxVMsAyKTVwRL0NMBb30Eb3C6SMJTZb+fZpNk8nf1H3Wd",
+	"81MSttG9bri+PK6pf1SL5Zhb5PZZCCOzGz+GPbJ83f5VN/461ZrTitijO0Q6wUis9GuVaVXOJ+YquWcs",
+	"xZr6cIO8h629FfXD2rI+u311oD3YoSYseuG7UE7i3pi3/cPmsjGBUZmEHlH2f+W0J5ewV3T7MgMP6JWx",
+	"yJ1Oe2E5dqmTQ5Hj1JwamKY2A2mg/L5196EftxSbaWwky6i6En6YIm25Ovrepe23EyMewfHhlYiDYo5i",
+	"SMZXR7zUPImkKlXlBDm7NSUGqt6o4KW+UvtvdmXLC9qgk65HCzhdIKk2eYjM9HVoTNXLa0n1Bppube7k",
+	"ruTCnN+0w1F2rFeuLOHuTndbQwWErsIGWbqtl4Ful4dnvzkUO+7lu+RkPgeubeRyCRkxIVZIrz3rINwt",
+	"4dc5limuf66O3VrcLOml+9Li47PATlc3RYpm/ymhhOA95v0kRVlUlO4DVRLdxuW/e9HHOQecrbrTeDDe",
+	"G3d87WObppnb+Q3k8hlIM/fL+kX4Hems8MeJpGSYNm4qPV77AYjS/uVBHZfH5SAnwgiCbpYoTCUnqTR3",
+	"/9UHu9VH9ti9IwkfNPx7isCggyuNQefUKnIgrOczMkEHLWF5Bdxpgz/zgefCZtQDzkdWCIa4rDOTRGjm",
+	"kXTjD+bTLlxaNeqo9F9Z47Ifv9VIYjTfV30+nDxf2Y1vDcxQG6cA/uQf3gXSmnj8Rf1vWLhL463TJZM6",
+	"zlUK4CJR+WRIZyOZUJfNXRL3VCQTArKKtH7faaays7DXYQjtK1/kGDf/F5LkOWK3prpPPbjYslgH94WK",
+	"PxsE9h5YDXryg31B78qvWQIrAdaNlcyz2WBvYH/SvHuLfgayTzMGehK7kbQKt0ONHfdL2ghLaeIsO5et",
+	"h3RlPJIdhCvz0WPh1+zKbEUTfWIdgOujPZVhGfaeZ+PZqni6/PvzDwb6oCPtrzEVuSCaAr3ZyB5VB68b",
+	"Xp8U079ItMRUJYx4vw/bg3odDncjSms0tflzkpeY42VIOUhEBNJlnDnIklOwJQuJR4jw3tRJ6Y72p04E",
+	"Ru1Rq+nu1bo7VHs2qzUfDsbM1yjtRWs6hDgUE378Rf9/bRrwDbv20Q/f+bvQzTzVGOBHmdG3v+W8aKO8",
+	"KysZXN3rYeslnvEqhm/omQ05+WnN4iC3e+vk4gzkAwjFfkzcGchDErJBO7pDMkM6/LX+7EADMbEy34uM",
+	"b/pCQvhRj7WPcwSF6OBzBDOrzS76DvPXzAgHfGZQszdxx8EmPDerWWrcHF2aX6KS6mLp9cfGoxuDjhc+",
+	"CuA7ct/UREa5bmWNy368NiOgUY9NfT4cZ01js2W98Cd6IFbw+Iv637CjhFphhkS/iBR95wNWE9YvzAa/",
+	"nZ0PHIbUvcVCNiM7G8SE1i7eiuYbxPwfWF57nMAtSaRdpvcnjru3qcrl6hHtvYtShc/BBvVdqT8btSmw",
+	"ELeMZ+bu+JYEzQRUdy5ru3EuDPKjwv4H42J8rPH5alyMreilT5iHd0lcBsKwIL9rPSjE/6sD/WeN8Ftq",
+	"9Qb4K4oOvmjR7HbAuz2LIzIFeaA6+e/c5qxeAVVW3L4CGtnN/VoV+9mFzbUYj9rT3TYw2o/NdWPGd3aO",
+	"9m2pSjQH9FkIo4AITfMycw+wVp30ocmDGWmHxtDNnWt/OMb0+Iv917BdnpuA9XNaNyQzyMkNaEXJ2Tyy",
+	"t6v1Yr2PU+G2sx1eTPqia+ivtakYuVN6eOb3bJYajA3tgR6CbXsxQ2cg9yIF/jiHus9pqXfgWNrVmLhd",
+	"AEVYlzzQ1rl+Czuyr9mT/DzwStuk46GstB+b3D2YPU5o+dyWurXmfJgr7nG9Yg7b1TRXWBN+sOAGFv+0",
+	"pHxVD7w7jUz+bPsnS9XVkH2Uz8tdrjuxMR9WI0xR2/53bYrC1r4N+SOn7svOeJpGLb7CznyN0s/McgE4",
+	"l4voLM1nBDQrGAm8m/zGdB9SIcqC6kcnZ3PScztWWRRCjTsvFybuo7StqmEUC3smSFeGNa88CBCCMIq+",
+	"M9+XLDO7uG4ltbcand2s2nqqw9bs3ENjP0u1HvGczljQRqiPkd2wo22qVUp5XaKx+w0/oF7xkfGahU1T",
+	"83T/i3/KIQMqCW4XKnj23/tD5gNjKtFlhWaYqEurmjNtqtsfbUxIR0MxVz+n12BESP1OS3f7QUDKaFZ1",
+	"uADJV0cvdf24BeAMeNxWa963LbNq/DQc+yWqut0SqLSIvDt/dZqgMyLflFdabSljBcKlXGhFFD124Ti9",
+	"ihoqC/CdMuIoxXl+pd6C/87+vE7FT68GmbAW9IIzpQGBenbfh+7VX0BGOKS6QCDjRDk7uU6j1bYFMvTx",
+	"4u2QQleePrQcSqrIyDj5A0a/cVVxcD9SHZOMWhD65ECrJ5Zga5HHZMI1Q//89QPCxlrpHug7PVaPVPxi",
+	"u9YJy+tkoxoslCIbYBaxRoZxBJ8LZdVNz4NghVPPIcxwu7room2B2XaDdbJ/O27NVOVs/+/Rm/Lq6JLM",
+	"KZYlh402AV2YZ9prPNJF6bZfFVfTLxKhfRy6yEoZ57ve/pUyQRkIydnKRcZb7pdaAzyREHGZUKMNCZya",
+	"poMdlDRXe4gsqqmKPjmbz81Nh40XvSGL3Sb7lJunttpUnBNsiRc47sS/U597vVxd1fHzMh8udXrIC7dg",
+	"9bu3HfQG+bmtpVnDuBhSL9JipYoj2RkjQf7QoiAZQ7l6fiIg8Xd3/28ALhkjrDSIAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -9,23 +9,149 @@ import (
 
 const (
 	GithubCookieAuthScopes = "githubCookieAuth.Scopes"
+	LocalCookieAuthScopes  = "localCookieAuth.Scopes"
 	OidcBearerAuthScopes   = "oidcBearerAuth.Scopes"
 	OidcCookieAuthScopes   = "oidcCookieAuth.Scopes"
 )
 
+// Defines values for ApiTokenConfigRole.
+const (
+	ApiTokenConfigRoleAdmin  ApiTokenConfigRole = "admin"
+	ApiTokenConfigRoleViewer ApiTokenConfigRole = "viewer"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	AuditEntryActionCreate AuditEntryAction = "create"
+	AuditEntryActionDelete AuditEntryAction = "delete"
+	AuditEntryActionUpdate AuditEntryAction = "update"
+)
+
+// Defines values for ChannelVersionTimelineResolution.
+const (
+	ChannelVersionTimelineResolutionDaily  ChannelVersionTimelineResolution = "daily"
+	ChannelVersionTimelineResolutionHourly ChannelVersionTimelineResolution = "hourly"
+	ChannelVersionTimelineResolutionWeekly ChannelVersionTimelineResolution = "weekly"
+)
+
+// Defines values for InstanceCheckInGapKind.
+const (
+	NoCheckIn        InstanceCheckInGapKind = "no_check_in"
+	NoEventsReported InstanceCheckInGapKind = "no_events_reported"
+)
+
+// Defines values for RoleBindingConfigRole.
+const (
+	RoleBindingConfigRoleAdmin    RoleBindingConfigRole = "admin"
+	RoleBindingConfigRoleOperator RoleBindingConfigRole = "operator"
+	RoleBindingConfigRoleViewer   RoleBindingConfigRole = "viewer"
+)
+
+// Defines values for RoleBindingConfigSubjectType.
+const (
+	GithubTeam RoleBindingConfigSubjectType = "github_team"
+	OidcRole   RoleBindingConfigSubjectType = "oidc_role"
+)
+
+// Defines values for UserRole.
+const (
+	Admin  UserRole = "admin"
+	Viewer UserRole = "viewer"
+)
+
+// Defines values for WebhookDeliveryPayloadEvent.
+const (
+	WebhookDeliveryPayloadEventActivity WebhookDeliveryPayloadEvent = "activity"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for GetChannelVersionTimelineParamsResolution.
+const (
+	GetChannelVersionTimelineParamsResolutionDaily  GetChannelVersionTimelineParamsResolution = "daily"
+	GetChannelVersionTimelineParamsResolutionHourly GetChannelVersionTimelineParamsResolution = "hourly"
+	GetChannelVersionTimelineParamsResolutionWeekly GetChannelVersionTimelineParamsResolution = "weekly"
+)
+
+// Defines values for ExportInstancesParamsFormat.
+const (
+	Csv    ExportInstancesParamsFormat = "csv"
+	Ndjson ExportInstancesParamsFormat = "ndjson"
+)
+
+// Defines values for PaginateAuditParamsAction.
+const (
+	PaginateAuditParamsActionCreate PaginateAuditParamsAction = "create"
+	PaginateAuditParamsActionDelete PaginateAuditParamsAction = "delete"
+	PaginateAuditParamsActionUpdate PaginateAuditParamsAction = "update"
+)
+
+// Defines values for PaginateAuditParamsTargetType.
+const (
+	PaginateAuditParamsTargetTypeActivity          PaginateAuditParamsTargetType = "activity"
+	PaginateAuditParamsTargetTypeApiToken          PaginateAuditParamsTargetType = "api_token"
+	PaginateAuditParamsTargetTypeApplication       PaginateAuditParamsTargetType = "application"
+	PaginateAuditParamsTargetTypeChannel           PaginateAuditParamsTargetType = "channel"
+	PaginateAuditParamsTargetTypeChannelFloor      PaginateAuditParamsTargetType = "channel_floor"
+	PaginateAuditParamsTargetTypeEmailNotification PaginateAuditParamsTargetType = "email_notification"
+	PaginateAuditParamsTargetTypeGroup             PaginateAuditParamsTargetType = "group"
+	PaginateAuditParamsTargetTypeInstance          PaginateAuditParamsTargetType = "instance"
+	PaginateAuditParamsTargetTypeInstances         PaginateAuditParamsTargetType = "instances"
+	PaginateAuditParamsTargetTypePackage           PaginateAuditParamsTargetType = "package"
+	PaginateAuditParamsTargetTypeRoleBinding       PaginateAuditParamsTargetType = "role_binding"
+	PaginateAuditParamsTargetTypeSyncer            PaginateAuditParamsTargetType = "syncer"
+	PaginateAuditParamsTargetTypeTeam              PaginateAuditParamsTargetType = "team"
+	PaginateAuditParamsTargetTypeUser              PaginateAuditParamsTargetType = "user"
+	PaginateAuditParamsTargetTypeWebhook           PaginateAuditParamsTargetType = "webhook"
+)
+
+// Defines values for StreamEventsParamsDuration.
+const (
+	N1d  StreamEventsParamsDuration = "1d"
+	N1h  StreamEventsParamsDuration = "1h"
+	N30d StreamEventsParamsDuration = "30d"
+	N7d  StreamEventsParamsDuration = "7d"
+)
+
 // Activity defines model for activity.
 type Activity struct {
-	AppID           string    `json:"app_id"`
-	ApplicationName string    `json:"application_name"`
-	ChannelName     string    `json:"channel_name"`
-	Class           int       `json:"class"`
-	CreatedTs       time.Time `db:"created_ts" json:"created_ts"`
-	GroupID         string    `json:"group_id"`
-	GroupName       string    `json:"group_name"`
-	Id              string    `json:"id"`
-	InstanceID      string    `json:"instance_id"`
-	Severity        int       `json:"severity"`
-	Version         string    `json:"version"`
+	AckComment      *string    `json:"ack_comment"`
+	AcknowledgedBy  *string    `json:"acknowledged_by"`
+	AcknowledgedTs  *time.Time `json:"acknowledged_ts"`
+	AppID           string     `json:"app_id"`
+	ApplicationName string     `json:"application_name"`
+	ChannelName     string     `json:"channel_name"`
+	Class           int        `json:"class"`
+	CreatedTs       time.Time  `db:"created_ts" json:"created_ts"`
+	GroupID         string     `json:"group_id"`
+	GroupName       string     `json:"group_name"`
+	Id              string     `json:"id"`
+	InstanceID      string     `json:"instance_id"`
+	ResolvedTs      *time.Time `json:"resolved_ts"`
+	Severity        int        `json:"severity"`
+	Version         string     `json:"version"`
+}
+
+// ActivityAcknowledgement defines model for activityAcknowledgement.
+type ActivityAcknowledgement struct {
+	Comment *string `json:"comment"`
+
+	// Ids Ids of the activity entries, between 1 and 1000
+	Ids []string `json:"ids"`
+
+	// Resolve Resolve the activity entries, acknowledging them if they weren't yet
+	Resolve *bool `json:"resolve,omitempty"`
+}
+
+// ActivityAcknowledgementResult defines model for activityAcknowledgementResult.
+type ActivityAcknowledgementResult struct {
+	// Updated Number of activity entries acknowledged or resolved, those already in that state are not counted
+	Updated int64 `json:"updated"`
 }
 
 // ActivityPage defines model for activityPage.
@@ -35,6 +161,44 @@ type ActivityPage struct {
 	TotalCount int        `json:"totalCount"`
 }
 
+// ApiToken defines model for apiToken.
+type ApiToken struct {
+	CreatedBy  string     `json:"created_by"`
+	CreatedTs  time.Time  `json:"created_ts"`
+	ExpiresTs  *time.Time `json:"expires_ts"`
+	Id         string     `json:"id"`
+	LastUsedTs *time.Time `json:"last_used_ts"`
+	Name       string     `json:"name"`
+	RevokedTs  *time.Time `json:"revoked_ts"`
+	Role       string     `json:"role"`
+
+	// Token The token, only returned when it's created
+	Token *string `json:"token,omitempty"`
+
+	// TokenPrefix Beginning of the token, to recognize it
+	TokenPrefix string `json:"token_prefix"`
+}
+
+// ApiTokenConfig defines model for apiTokenConfig.
+type ApiTokenConfig struct {
+	// ExpiresTs Expiry of the token, it never expires when null
+	ExpiresTs *time.Time `json:"expires_ts"`
+	Name      string     `json:"name"`
+
+	// Role Viewer tokens can only make read requests
+	Role ApiTokenConfigRole `json:"role"`
+}
+
+// ApiTokenConfigRole Viewer tokens can only make read requests
+type ApiTokenConfigRole string
+
+// ApiTokenPage defines model for apiTokenPage.
+type ApiTokenPage struct {
+	Count      int        `json:"count"`
+	Tokens     []ApiToken `json:"tokens"`
+	TotalCount int        `json:"totalCount"`
+}
+
 // AppConfig defines model for appConfig.
 type AppConfig struct {
 	Description *string `json:"description,omitempty"`
@@ -66,6 +230,37 @@ type AppsPage struct {
 // Arch defines model for arch.
 type Arch = int
 
+// AuditEntry defines model for auditEntry.
+type AuditEntry struct {
+	Action AuditEntryAction `json:"action"`
+
+	// After State of the target after the change, only the fields that changed for updates
+	After         *map[string]interface{} `json:"after"`
+	ApplicationId *string                 `json:"application_id"`
+
+	// Before State of the target before the change, only the fields that changed for updates
+	Before    *map[string]interface{} `json:"before"`
+	CreatedTs time.Time               `json:"created_ts"`
+	Id        string                  `json:"id"`
+
+	// TargetId Id of the target, empty for changes of several instances at once
+	TargetId   string `json:"target_id"`
+	TargetType string `json:"target_type"`
+
+	// Username Identity of the user who made the change, empty when authentication is disabled
+	Username string `json:"username"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuditPage defines model for auditPage.
+type AuditPage struct {
+	Count      int          `json:"count"`
+	Entries    []AuditEntry `json:"entries"`
+	TotalCount int          `json:"totalCount"`
+}
+
 // Channel defines model for channel.
 type Channel struct {
 	ApplicationID string    `json:"application_id"`
@@ -94,6 +289,15 @@ type ChannelPage struct {
 	TotalCount int       `json:"totalCount"`
 }
 
+// ChannelVersionTimeline defines model for channelVersionTimeline.
+type ChannelVersionTimeline struct {
+	Resolution ChannelVersionTimelineResolution `json:"resolution"`
+	Timeline   map[time.Time]map[string]uint64  `json:"timeline"`
+}
+
+// ChannelVersionTimelineResolution defines model for ChannelVersionTimeline.Resolution.
+type ChannelVersionTimelineResolution string
+
 // Config defines model for config.
 type Config struct {
 	AccessManagementUrl string  `json:"access_management_url"`
@@ -110,6 +314,44 @@ type Config struct {
 	Title               string  `json:"title"`
 }
 
+// EmailNotification defines model for emailNotification.
+type EmailNotification struct {
+	ApplicationId string    `json:"application_id"`
+	CreatedTs     time.Time `json:"created_ts"`
+	DailyDigest   bool      `json:"daily_digest"`
+	Enabled       bool      `json:"enabled"`
+	Id            string    `json:"id"`
+
+	// LastDigestTs End of the period covered by the last daily digest
+	LastDigestTs    time.Time `json:"last_digest_ts"`
+	Recipients      []string  `json:"recipients"`
+	RolloutFailed   bool      `json:"rollout_failed"`
+	RolloutFinished bool      `json:"rollout_finished"`
+}
+
+// EmailNotificationConfig defines model for emailNotificationConfig.
+type EmailNotificationConfig struct {
+	// DailyDigest Send a daily digest of the update failures reported by the instances, grouped by error code
+	DailyDigest *bool `json:"daily_digest,omitempty"`
+	Enabled     *bool `json:"enabled,omitempty"`
+
+	// Recipients Email addresses notified, between 1 and 50
+	Recipients []string `json:"recipients"`
+
+	// RolloutFailed Notify the failed rollouts
+	RolloutFailed *bool `json:"rollout_failed,omitempty"`
+
+	// RolloutFinished Notify the rollouts finished successfully
+	RolloutFinished *bool `json:"rollout_finished,omitempty"`
+}
+
+// EmailNotificationPage defines model for emailNotificationPage.
+type EmailNotificationPage struct {
+	Count              int                 `json:"count"`
+	EmailNotifications []EmailNotification `json:"emailNotifications"`
+	TotalCount         int                 `json:"totalCount"`
+}
+
 // ErrorResponse defines model for errorResponse.
 type ErrorResponse struct {
 	// Description Human-readable error description
@@ -221,29 +463,84 @@ type Instance struct {
 	Alias       *string              `json:"alias,omitempty"`
 	Application *InstanceApplication `json:"application"`
 	CreatedTs   time.Time            `json:"created_ts"`
-	Id          string               `json:"id"`
-	Ip          string               `json:"ip"`
+
+	// DuplicateSuspectedTs last time the IP or version of the instance flapped
+	DuplicateSuspectedTs *time.Time      `json:"duplicate_suspected_ts"`
+	Id                   string          `json:"id"`
+	Ip                   string          `json:"ip"`
+	Labels               *InstanceLabels `json:"labels,omitempty"`
+
+	// SuspectedDuplicate whether the instance is suspected of sharing its machine id with other machines
+	SuspectedDuplicate *bool `json:"suspected_duplicate"`
 }
 
 // InstanceApplication defines model for instanceApplication.
 type InstanceApplication struct {
-	ApplicationID       string    `json:"application_id"`
-	CreatedTs           time.Time `json:"created_ts"`
+	ApplicationID string    `json:"application_id"`
+	CreatedTs     time.Time `json:"created_ts"`
+
+	// GroupID Group the instance gets its updates from
 	GroupID             string    `json:"group_id"`
 	InstanceID          string    `json:"instance_id"`
 	LastCheckForUpdates time.Time `json:"last_check_for_updates"`
 	LastUpdateGrantedTs time.Time `json:"last_update_granted_ts"`
 	LastUpdateVersion   string    `json:"last_update_version"`
-	Status              int       `json:"status"`
-	UpdateInProgress    bool      `json:"update_in_progress"`
-	Version             string    `json:"version"`
+
+	// RequestedGroupID Group matching the track reported by the instance, which differs from groupID when the instance has been assigned to another group
+	RequestedGroupID *string `json:"requested_group_id"`
+	Status           int     `json:"status"`
+	UpdateInProgress bool    `json:"update_in_progress"`
+	Version          string  `json:"version"`
 }
 
+// InstanceCheckInGap defines model for instanceCheckInGap.
+type InstanceCheckInGap struct {
+	From time.Time `json:"from"`
+
+	// Kind no_events_reported when the instance reported no events nor status changes in the gap, which doesn't mean it was down as checks for updates without anything to report aren't recorded, no_check_in when it hasn't checked for updates since from
+	Kind InstanceCheckInGapKind `json:"kind"`
+
+	// Ongoing Whether the instance hasn't checked for updates since from
+	Ongoing bool      `json:"ongoing"`
+	To      time.Time `json:"to"`
+}
+
+// InstanceCheckInGapKind no_events_reported when the instance reported no events nor status changes in the gap, which doesn't mean it was down as checks for updates without anything to report aren't recorded, no_check_in when it hasn't checked for updates since from
+type InstanceCheckInGapKind string
+
 // InstanceCount defines model for instanceCount.
 type InstanceCount struct {
 	Count uint64 `json:"count"`
 }
 
+// InstanceEvent defines model for instanceEvent.
+type InstanceEvent struct {
+	CreatedTs       time.Time `json:"created_ts"`
+	Description     string    `json:"description"`
+	ErrorCode       *string   `json:"error_code"`
+	Id              int       `json:"id"`
+	PreviousVersion *string   `json:"previous_version"`
+	Result          int       `json:"result"`
+	Type            int       `json:"type"`
+}
+
+// InstanceEventsTimeline defines model for instanceEventsTimeline.
+type InstanceEventsTimeline struct {
+	Events              []InstanceEvent      `json:"events"`
+	Gaps                []InstanceCheckInGap `json:"gaps"`
+	LastCheckForUpdates *time.Time           `json:"last_check_for_updates"`
+	TotalEvents         int                  `json:"total_events"`
+}
+
+// InstanceGroupOverrideConfig defines model for instanceGroupOverrideConfig.
+type InstanceGroupOverrideConfig struct {
+	// GroupId Group the instances are assigned to, null removes their assignment so they go back to the group matching their track
+	GroupId *string `json:"groupId"`
+}
+
+// InstanceLabels defines model for instanceLabels.
+type InstanceLabels map[string]string
+
 // InstancePage defines model for instancePage.
 type InstancePage struct {
 	Instances []Instance `json:"instances"`
@@ -261,6 +558,35 @@ type InstanceStatusHistory struct {
 	Verison   string    `json:"verison"`
 }
 
+// InstancesDeleted defines model for instancesDeleted.
+type InstancesDeleted struct {
+	// Deleted Number of instances deleted, or that would be deleted in a dry run
+	Deleted int64 `json:"deleted"`
+	DryRun  bool  `json:"dryRun"`
+}
+
+// InstancesGroupOverridden defines model for instancesGroupOverridden.
+type InstancesGroupOverridden struct {
+	// Skipped Number of instances not assigned to the group because the group's channel serves packages of another architecture
+	Skipped int64 `json:"skipped"`
+
+	// Updated Number of instances assigned to the group, or whose assignment was removed
+	Updated int64 `json:"updated"`
+}
+
+// LoginConfig defines model for loginConfig.
+type LoginConfig struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// LoginInfo defines model for loginInfo.
+type LoginInfo struct {
+	Role     string `json:"role"`
+	TeamId   string `json:"team_id"`
+	Username string `json:"username"`
+}
+
 // OmahaRequest defines model for omahaRequest.
 type OmahaRequest = map[string]interface{}
 
@@ -284,8 +610,11 @@ type Package struct {
 	IsFloor *bool  `json:"is_floor,omitempty"`
 	Size    string `json:"size"`
 	Type    int    `json:"type"`
-	Url     string `json:"url"`
-	Version string `json:"version"`
+
+	// UpstreamURL URL of the upstream update server the package was synced from
+	UpstreamURL *string `json:"upstream_url"`
+	Url         string  `json:"url"`
+	Version     string  `json:"version"`
 }
 
 // PackageConfig defines model for packageConfig.
@@ -311,11 +640,149 @@ type PackagePage struct {
 	TotalCount int       `json:"totalCount"`
 }
 
+// RetentionDryRun defines model for retentionDryRun.
+type RetentionDryRun struct {
+	Tables []RetentionTableCount `json:"tables"`
+}
+
+// RetentionTableCount defines model for retentionTableCount.
+type RetentionTableCount struct {
+	// Cutoff Rows older than this time would be removed
+	Cutoff  *time.Time `json:"cutoff,omitempty"`
+	Enabled bool       `json:"enabled"`
+
+	// Retention Retention of the table rows as a duration, e.g. 720h0m0s
+	Retention string `json:"retention"`
+	Rows      int64  `json:"rows"`
+	Table     string `json:"table"`
+}
+
+// RoleBinding defines model for roleBinding.
+type RoleBinding struct {
+	ApplicationId string    `json:"application_id"`
+	ChannelId     *string   `json:"channel_id"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedTs     time.Time `json:"created_ts"`
+	GroupId       *string   `json:"group_id"`
+	Id            string    `json:"id"`
+	Role          string    `json:"role"`
+	Subject       string    `json:"subject"`
+	SubjectType   string    `json:"subject_type"`
+}
+
+// RoleBindingConfig defines model for roleBindingConfig.
+type RoleBindingConfig struct {
+	// ChannelId Restrict the role to a channel of the application
+	ChannelId *string `json:"channel_id"`
+
+	// GroupId Restrict the role to a group of the application
+	GroupId *string `json:"group_id"`
+
+	// Role Operators can also toggle the policies of the groups
+	Role RoleBindingConfigRole `json:"role"`
+
+	// Subject OIDC role, or GitHub team ("org/team") or organization
+	Subject     string                       `json:"subject"`
+	SubjectType RoleBindingConfigSubjectType `json:"subject_type"`
+}
+
+// RoleBindingConfigRole Operators can also toggle the policies of the groups
+type RoleBindingConfigRole string
+
+// RoleBindingConfigSubjectType defines model for RoleBindingConfig.SubjectType.
+type RoleBindingConfigSubjectType string
+
+// RoleBindingPage defines model for roleBindingPage.
+type RoleBindingPage struct {
+	Count        int           `json:"count"`
+	RoleBindings []RoleBinding `json:"roleBindings"`
+	TotalCount   int           `json:"totalCount"`
+}
+
+// SyncerChannelStatus defines model for syncerChannelStatus.
+type SyncerChannelStatus struct {
+	Arch            string    `json:"arch"`
+	Channel         string    `json:"channel"`
+	LastCheck       time.Time `json:"last_check"`
+	LastError       string    `json:"last_error"`
+	LastSeenVersion string    `json:"last_seen_version"`
+}
+
+// SyncerStatus defines model for syncerStatus.
+type SyncerStatus struct {
+	Channels  []SyncerChannelStatus  `json:"channels"`
+	NextCheck time.Time              `json:"next_check"`
+	Running   bool                   `json:"running"`
+	Upstreams []SyncerUpstreamStatus `json:"upstreams"`
+}
+
+// SyncerUpstreamStatus defines model for syncerUpstreamStatus.
+type SyncerUpstreamStatus struct {
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	Healthy             bool      `json:"healthy"`
+	LastError           string    `json:"last_error"`
+	LastFailure         time.Time `json:"last_failure"`
+	LastSuccess         time.Time `json:"last_success"`
+	Url                 string    `json:"url"`
+}
+
+// Team defines model for team.
+type Team struct {
+	CreatedTs  time.Time `json:"created_ts"`
+	GithubOrgs []string  `json:"github_orgs"`
+	Id         string    `json:"id"`
+	Name       string    `json:"name"`
+	OidcClaims []string  `json:"oidc_claims"`
+}
+
+// TeamConfig defines model for teamConfig.
+type TeamConfig struct {
+	// GithubOrgs GitHub organizations whose members are mapped to the team
+	GithubOrgs *[]string `json:"github_orgs,omitempty"`
+	Name       string    `json:"name"`
+
+	// OidcClaims Values of the OIDC teams claim mapping the users to the team
+	OidcClaims *[]string `json:"oidc_claims,omitempty"`
+}
+
 // UpdateInstanceConfig defines model for updateInstanceConfig.
 type UpdateInstanceConfig struct {
 	Alias string `json:"alias"`
 }
 
+// UpdateInstanceLabelsConfig defines model for updateInstanceLabelsConfig.
+type UpdateInstanceLabelsConfig struct {
+	Labels InstanceLabels `json:"labels"`
+}
+
+// User defines model for user.
+type User struct {
+	CreatedTs time.Time `json:"created_ts"`
+	Id        string    `json:"id"`
+	Role      UserRole  `json:"role"`
+	TeamId    string    `json:"team_id"`
+	Username  string    `json:"username"`
+}
+
+// UserConfig defines model for userConfig.
+type UserConfig struct {
+	Password string   `json:"password"`
+	Role     UserRole `json:"role"`
+
+	// TeamId Team of the user, only set by the admins of the default team; defaults to the team of the admin
+	TeamId   *string `json:"team_id,omitempty"`
+	Username string  `json:"username"`
+}
+
+// UserRole defines model for userRole.
+type UserRole string
+
+// UserUpdateConfig defines model for userUpdateConfig.
+type UserUpdateConfig struct {
+	Password *string   `json:"password,omitempty"`
+	Role     *UserRole `json:"role,omitempty"`
+}
+
 // VersionBreakdownEntry defines model for versionBreakdownEntry.
 type VersionBreakdownEntry struct {
 	Instances  *int    `json:"instances,omitempty"`
@@ -323,6 +790,88 @@ type VersionBreakdownEntry struct {
 	Version    string  `json:"version"`
 }
 
+// Webhook defines model for webhook.
+type Webhook struct {
+	ApplicationId *string   `json:"application_id"`
+	Classes       []int     `json:"classes"`
+	CreatedTs     time.Time `json:"created_ts"`
+	Enabled       bool      `json:"enabled"`
+	GroupId       *string   `json:"group_id"`
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+
+	// Secret Secret used to sign the payloads, only returned on creation
+	Secret     *string `json:"secret,omitempty"`
+	Severities []int   `json:"severities"`
+	Url        string  `json:"url"`
+}
+
+// WebhookConfig defines model for webhookConfig.
+type WebhookConfig struct {
+	// ApplicationId Only notify the activity entries of this application
+	ApplicationId *string `json:"application_id"`
+
+	// Classes Only notify the activity entries of these classes, all of them when empty
+	Classes *[]int64 `json:"classes,omitempty"`
+	Enabled *bool    `json:"enabled,omitempty"`
+
+	// GroupId Only notify the activity entries of this group
+	GroupId *string `json:"group_id"`
+	Name    string  `json:"name"`
+
+	// Secret Secret used to sign the payloads, generated on creation when empty
+	Secret *string `json:"secret,omitempty"`
+
+	// Severities Only notify the activity entries of these severities, all of them when empty
+	Severities *[]int64 `json:"severities,omitempty"`
+
+	// Url http or https url the activity entries are posted to
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for webhookDelivery.
+type WebhookDelivery struct {
+	ActivityId string    `json:"activity_id"`
+	Attempts   int       `json:"attempts"`
+	CreatedTs  time.Time `json:"created_ts"`
+
+	// Id Delivery id, also sent in the X-Nebraska-Delivery header
+	Id            string     `json:"id"`
+	LastAttemptTs *time.Time `json:"last_attempt_ts"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptTs time.Time  `json:"next_attempt_ts"`
+
+	// Payload Body posted to the webhook, signed in the X-Nebraska-Signature-256 header as sha256=<hex encoded HMAC-SHA256 keyed with the webhook secret>
+	Payload struct {
+		Activity  Activity                    `json:"activity"`
+		Event     WebhookDeliveryPayloadEvent `json:"event"`
+		WebhookId string                      `json:"webhook_id"`
+	} `json:"payload"`
+	ResponseStatus *int                  `json:"response_status"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      string                `json:"webhook_id"`
+}
+
+// WebhookDeliveryPayloadEvent defines model for WebhookDelivery.Payload.Event.
+type WebhookDeliveryPayloadEvent string
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryPage defines model for webhookDeliveryPage.
+type WebhookDeliveryPage struct {
+	Count      int               `json:"count"`
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// WebhookPage defines model for webhookPage.
+type WebhookPage struct {
+	Count      int       `json:"count"`
+	TotalCount int       `json:"totalCount"`
+	Webhooks   []Webhook `json:"webhooks"`
+}
+
 // PaginateActivityParams defines parameters for PaginateActivity.
 type PaginateActivityParams struct {
 	AppIDorProductID *string `form:"appIDorProductID,omitempty" json:"appIDorProductID,omitempty"`
@@ -331,10 +880,13 @@ type PaginateActivityParams struct {
 	InstanceID       *string `form:"instanceID,omitempty" json:"instanceID,omitempty"`
 	Version          *string `form:"version,omitempty" json:"version,omitempty"`
 	Severity         *int    `form:"severity,omitempty" json:"severity,omitempty"`
-	Start            string  `form:"start" json:"start"`
-	End              string  `form:"end" json:"end"`
-	Page             *int    `form:"page,omitempty" json:"page,omitempty"`
-	Perpage          *int    `form:"perpage,omitempty" json:"perpage,omitempty"`
+
+	// Unacknowledged Only return the activity entries not acknowledged yet
+	Unacknowledged *bool  `form:"unacknowledged,omitempty" json:"unacknowledged,omitempty"`
+	Start          string `form:"start" json:"start"`
+	End            string `form:"end" json:"end"`
+	Page           *int   `form:"page,omitempty" json:"page,omitempty"`
+	Perpage        *int   `form:"perpage,omitempty" json:"perpage,omitempty"`
 }
 
 // PaginateAppsParams defines parameters for PaginateApps.
@@ -354,6 +906,27 @@ type PaginateChannelsParams struct {
 	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
 }
 
+// GetChannelVersionTimelineParams defines parameters for GetChannelVersionTimeline.
+type GetChannelVersionTimelineParams struct {
+	// Start defaults to 30 days before end
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End defaults to now
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// Resolution resolution of the snapshots used, picked from the time range when omitted (hourly up to 7 days, daily up to 180 days, weekly beyond)
+	Resolution *GetChannelVersionTimelineParamsResolution `form:"resolution,omitempty" json:"resolution,omitempty"`
+}
+
+// GetChannelVersionTimelineParamsResolution defines parameters for GetChannelVersionTimeline.
+type GetChannelVersionTimelineParamsResolution string
+
+// PaginateEmailNotificationsParams defines parameters for PaginateEmailNotifications.
+type PaginateEmailNotificationsParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
 // PaginateGroupsParams defines parameters for PaginateGroups.
 type PaginateGroupsParams struct {
 	Page    *int `form:"page,omitempty" json:"page,omitempty"`
@@ -371,6 +944,35 @@ type GetGroupInstancesParams struct {
 	SearchValue  *string `form:"searchValue,omitempty" json:"searchValue,omitempty"`
 	Duration     string  `form:"duration" json:"duration"`
 	Version      *string `form:"version,omitempty" json:"version,omitempty"`
+
+	// IpCidr only instances whose IP belongs to this CIDR, or equals this IP
+	IpCidr *string `form:"ipCidr,omitempty" json:"ipCidr,omitempty"`
+	Oem    *string `form:"oem,omitempty" json:"oem,omitempty"`
+
+	// AlephVersion space separated constraints on the aleph version, like ">=3510.0.0 <3600.0.0"
+	AlephVersion *string `form:"alephVersion,omitempty" json:"alephVersion,omitempty"`
+
+	// VersionRange space separated constraints on the version, like ">=3510.0.0 <3600.0.0"
+	VersionRange    *string    `form:"versionRange,omitempty" json:"versionRange,omitempty"`
+	LastCheckAfter  *time.Time `form:"lastCheckAfter,omitempty" json:"lastCheckAfter,omitempty"`
+	LastCheckBefore *time.Time `form:"lastCheckBefore,omitempty" json:"lastCheckBefore,omitempty"`
+
+	// Labels comma separated labels the instances must have, like "env=prod,rack=r12"
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// GetInstanceEventsParams defines parameters for GetInstanceEvents.
+type GetInstanceEventsParams struct {
+	// Start only events reported at or after this time, 30 days before end by default
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End only events reported before this time, now by default
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// MinGap shortest period without check-ins reported as a gap, like 2h (default)
+	MinGap  *string `form:"minGap,omitempty" json:"minGap,omitempty"`
+	Page    *int    `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int    `form:"perpage,omitempty" json:"perpage,omitempty"`
 }
 
 // GetInstanceStatusHistoryParams defines parameters for GetInstanceStatusHistory.
@@ -398,6 +1000,88 @@ type GetGroupVersionTimelineParams struct {
 	Duration string `form:"duration" json:"duration"`
 }
 
+// DeleteInstancesParams defines parameters for DeleteInstances.
+type DeleteInstancesParams struct {
+	GroupID      *string `form:"groupID,omitempty" json:"groupID,omitempty"`
+	Status       *int    `form:"status,omitempty" json:"status,omitempty"`
+	Version      *string `form:"version,omitempty" json:"version,omitempty"`
+	SearchFilter *string `form:"searchFilter,omitempty" json:"searchFilter,omitempty"`
+	SearchValue  *string `form:"searchValue,omitempty" json:"searchValue,omitempty"`
+	Duration     *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// IpCidr only instances whose IP belongs to this CIDR, or equals this IP
+	IpCidr *string `form:"ipCidr,omitempty" json:"ipCidr,omitempty"`
+	Oem    *string `form:"oem,omitempty" json:"oem,omitempty"`
+
+	// AlephVersion space separated constraints on the aleph version, like ">=3510.0.0 <3600.0.0"
+	AlephVersion *string `form:"alephVersion,omitempty" json:"alephVersion,omitempty"`
+
+	// VersionRange space separated constraints on the version, like ">=3510.0.0 <3600.0.0"
+	VersionRange    *string    `form:"versionRange,omitempty" json:"versionRange,omitempty"`
+	LastCheckAfter  *time.Time `form:"lastCheckAfter,omitempty" json:"lastCheckAfter,omitempty"`
+	LastCheckBefore *time.Time `form:"lastCheckBefore,omitempty" json:"lastCheckBefore,omitempty"`
+
+	// Labels comma separated labels the instances must have, like "env=prod,rack=r12"
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+
+	// DryRun only count the instances that would be deleted
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ExportInstancesParams defines parameters for ExportInstances.
+type ExportInstancesParams struct {
+	Format       *ExportInstancesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	GroupID      *string                      `form:"groupID,omitempty" json:"groupID,omitempty"`
+	Status       *int                         `form:"status,omitempty" json:"status,omitempty"`
+	Version      *string                      `form:"version,omitempty" json:"version,omitempty"`
+	SearchFilter *string                      `form:"searchFilter,omitempty" json:"searchFilter,omitempty"`
+	SearchValue  *string                      `form:"searchValue,omitempty" json:"searchValue,omitempty"`
+	Duration     *string                      `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// IpCidr only instances whose IP belongs to this CIDR, or equals this IP
+	IpCidr *string `form:"ipCidr,omitempty" json:"ipCidr,omitempty"`
+	Oem    *string `form:"oem,omitempty" json:"oem,omitempty"`
+
+	// AlephVersion space separated constraints on the aleph version, like ">=3510.0.0 <3600.0.0"
+	AlephVersion *string `form:"alephVersion,omitempty" json:"alephVersion,omitempty"`
+
+	// VersionRange space separated constraints on the version, like ">=3510.0.0 <3600.0.0"
+	VersionRange    *string    `form:"versionRange,omitempty" json:"versionRange,omitempty"`
+	LastCheckAfter  *time.Time `form:"lastCheckAfter,omitempty" json:"lastCheckAfter,omitempty"`
+	LastCheckBefore *time.Time `form:"lastCheckBefore,omitempty" json:"lastCheckBefore,omitempty"`
+
+	// Labels comma separated labels the instances must have, like "env=prod,rack=r12"
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// ExportInstancesParamsFormat defines parameters for ExportInstances.
+type ExportInstancesParamsFormat string
+
+// SetInstancesGroupOverrideParams defines parameters for SetInstancesGroupOverride.
+type SetInstancesGroupOverrideParams struct {
+	GroupID      *string `form:"groupID,omitempty" json:"groupID,omitempty"`
+	Status       *int    `form:"status,omitempty" json:"status,omitempty"`
+	Version      *string `form:"version,omitempty" json:"version,omitempty"`
+	SearchFilter *string `form:"searchFilter,omitempty" json:"searchFilter,omitempty"`
+	SearchValue  *string `form:"searchValue,omitempty" json:"searchValue,omitempty"`
+	Duration     *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// IpCidr only instances whose IP belongs to this CIDR, or equals this IP
+	IpCidr *string `form:"ipCidr,omitempty" json:"ipCidr,omitempty"`
+	Oem    *string `form:"oem,omitempty" json:"oem,omitempty"`
+
+	// AlephVersion space separated constraints on the aleph version, like ">=3510.0.0 <3600.0.0"
+	AlephVersion *string `form:"alephVersion,omitempty" json:"alephVersion,omitempty"`
+
+	// VersionRange space separated constraints on the version, like ">=3510.0.0 <3600.0.0"
+	VersionRange    *string    `form:"versionRange,omitempty" json:"versionRange,omitempty"`
+	LastCheckAfter  *time.Time `form:"lastCheckAfter,omitempty" json:"lastCheckAfter,omitempty"`
+	LastCheckBefore *time.Time `form:"lastCheckBefore,omitempty" json:"lastCheckBefore,omitempty"`
+
+	// Labels comma separated labels the instances must have, like "env=prod,rack=r12"
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+}
+
 // PaginatePackagesParams defines parameters for PaginatePackages.
 type PaginatePackagesParams struct {
 	Page          *int    `form:"page,omitempty" json:"page,omitempty"`
@@ -405,6 +1089,31 @@ type PaginatePackagesParams struct {
 	SearchVersion *string `form:"searchVersion,omitempty" json:"searchVersion,omitempty"`
 }
 
+// PaginateRoleBindingsParams defines parameters for PaginateRoleBindings.
+type PaginateRoleBindingsParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
+// PaginateAuditParams defines parameters for PaginateAudit.
+type PaginateAuditParams struct {
+	AppIDorProductID *string                        `form:"appIDorProductID,omitempty" json:"appIDorProductID,omitempty"`
+	Username         *string                        `form:"username,omitempty" json:"username,omitempty"`
+	Action           *PaginateAuditParamsAction     `form:"action,omitempty" json:"action,omitempty"`
+	TargetType       *PaginateAuditParamsTargetType `form:"targetType,omitempty" json:"targetType,omitempty"`
+	TargetID         *string                        `form:"targetID,omitempty" json:"targetID,omitempty"`
+	Start            *time.Time                     `form:"start,omitempty" json:"start,omitempty"`
+	End              *time.Time                     `form:"end,omitempty" json:"end,omitempty"`
+	Page             *int                           `form:"page,omitempty" json:"page,omitempty"`
+	Perpage          *int                           `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
+// PaginateAuditParamsAction defines parameters for PaginateAudit.
+type PaginateAuditParamsAction string
+
+// PaginateAuditParamsTargetType defines parameters for PaginateAudit.
+type PaginateAuditParamsTargetType string
+
 // PaginateChannelFloorsParams defines parameters for PaginateChannelFloors.
 type PaginateChannelFloorsParams struct {
 	Page    *int `form:"page,omitempty" json:"page,omitempty"`
@@ -417,12 +1126,45 @@ type SetChannelFloorJSONBody struct {
 	FloorReason *string `json:"floor_reason"`
 }
 
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	AppIDorProductID *string `form:"appIDorProductID,omitempty" json:"appIDorProductID,omitempty"`
+	GroupID          *string `form:"groupID,omitempty" json:"groupID,omitempty"`
+
+	// Duration duration of the instances status stats sent, 1d by default
+	Duration *StreamEventsParamsDuration `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
+// StreamEventsParamsDuration defines parameters for StreamEvents.
+type StreamEventsParamsDuration string
+
+// PaginateAPITokensParams defines parameters for PaginateAPITokens.
+type PaginateAPITokensParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
+// PaginateWebhooksParams defines parameters for PaginateWebhooks.
+type PaginateWebhooksParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
+// PaginateWebhookDeliveriesParams defines parameters for PaginateWebhookDeliveries.
+type PaginateWebhookDeliveriesParams struct {
+	Page    *int `form:"page,omitempty" json:"page,omitempty"`
+	Perpage *int `form:"perpage,omitempty" json:"perpage,omitempty"`
+}
+
 // LoginWebhookParams defines parameters for LoginWebhook.
 type LoginWebhookParams struct {
 	XHubSignature string `json:"X-Hub-Signature"`
 	XGithubEvent  string `json:"X-Github-Event"`
 }
 
+// AcknowledgeActivityJSONRequestBody defines body for AcknowledgeActivity for application/json ContentType.
+type AcknowledgeActivityJSONRequestBody = ActivityAcknowledgement
+
 // CreateAppJSONRequestBody defines body for CreateApp for application/json ContentType.
 type CreateAppJSONRequestBody = AppConfig
 
@@ -435,20 +1177,62 @@ type CreateChannelJSONRequestBody = ChannelConfig
 // UpdateChannelJSONRequestBody defines body for UpdateChannel for application/json ContentType.
 type UpdateChannelJSONRequestBody = ChannelConfig
 
+// CreateEmailNotificationJSONRequestBody defines body for CreateEmailNotification for application/json ContentType.
+type CreateEmailNotificationJSONRequestBody = EmailNotificationConfig
+
+// UpdateEmailNotificationJSONRequestBody defines body for UpdateEmailNotification for application/json ContentType.
+type UpdateEmailNotificationJSONRequestBody = EmailNotificationConfig
+
 // CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
 type CreateGroupJSONRequestBody = GroupConfig
 
 // UpdateGroupJSONRequestBody defines body for UpdateGroup for application/json ContentType.
 type UpdateGroupJSONRequestBody = GroupConfig
 
+// SetInstancesGroupOverrideJSONRequestBody defines body for SetInstancesGroupOverride for application/json ContentType.
+type SetInstancesGroupOverrideJSONRequestBody = InstanceGroupOverrideConfig
+
+// SetInstanceGroupOverrideJSONRequestBody defines body for SetInstanceGroupOverride for application/json ContentType.
+type SetInstanceGroupOverrideJSONRequestBody = InstanceGroupOverrideConfig
+
 // CreatePackageJSONRequestBody defines body for CreatePackage for application/json ContentType.
 type CreatePackageJSONRequestBody = PackageConfig
 
 // UpdatePackageJSONRequestBody defines body for UpdatePackage for application/json ContentType.
 type UpdatePackageJSONRequestBody = PackageConfig
 
+// CreateRoleBindingJSONRequestBody defines body for CreateRoleBinding for application/json ContentType.
+type CreateRoleBindingJSONRequestBody = RoleBindingConfig
+
 // SetChannelFloorJSONRequestBody defines body for SetChannelFloor for application/json ContentType.
 type SetChannelFloorJSONRequestBody SetChannelFloorJSONBody
 
 // UpdateInstanceJSONRequestBody defines body for UpdateInstance for application/json ContentType.
 type UpdateInstanceJSONRequestBody = UpdateInstanceConfig
+
+// UpdateInstanceLabelsJSONRequestBody defines body for UpdateInstanceLabels for application/json ContentType.
+type UpdateInstanceLabelsJSONRequestBody = UpdateInstanceLabelsConfig
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = TeamConfig
+
+// UpdateTeamJSONRequestBody defines body for UpdateTeam for application/json ContentType.
+type UpdateTeamJSONRequestBody = TeamConfig
+
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = ApiTokenConfig
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = UserConfig
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UserUpdateConfig
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookConfig
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookConfig
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginConfig
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net/mail"
 	"net/url"
 	"os"
 
@@ -15,23 +16,47 @@ import (
 )
 
 type Config struct {
-	EnableSyncer        bool   `koanf:"enable-syncer"`
-	HostFlatcarPackages bool   `koanf:"host-flatcar-packages"`
-	FlatcarPackagesPath string `koanf:"flatcar-packages-path"`
-	NebraskaURL         string `koanf:"nebraska-url"`
-	SyncerPkgsURL       string `koanf:"syncer-packages-url"`
-	HTTPLog             bool   `koanf:"http-log"`
-	HTTPStaticDir       string `koanf:"http-static-dir"`
-	AuthMode            string `koanf:"auth-mode"`
-	FlatcarUpdatesURL   string `koanf:"sync-update-url"`
-	CheckFrequencyVal   string `koanf:"sync-interval"`
-	AppLogoPath         string `koanf:"client-logo"`
-	AppTitle            string `koanf:"client-title"`
-	AppHeaderStyle      string `koanf:"client-header-style"`
-	APIEndpointSuffix   string `koanf:"api-endpoint-suffix"`
-	Debug               bool   `koanf:"debug"`
-	ServerPort          uint   `koanf:"port"`
-	RollbackDBTo        string `koanf:"rollback-db-to"`
+	EnableSyncer             bool   `koanf:"enable-syncer"`
+	HostFlatcarPackages      bool   `koanf:"host-flatcar-packages"`
+	FlatcarPackagesPath      string `koanf:"flatcar-packages-path"`
+	NebraskaURL              string `koanf:"nebraska-url"`
+	SyncerPkgsURL            string `koanf:"syncer-packages-url"`
+	HTTPLog                  bool   `koanf:"http-log"`
+	HTTPStaticDir            string `koanf:"http-static-dir"`
+	AuthMode                 string `koanf:"auth-mode"`
+	FlatcarUpdatesURL        string `koanf:"sync-update-url"`
+	CheckFrequencyVal        string `koanf:"sync-interval"`
+	SyncerTrustedKeys        string `koanf:"sync-trusted-keys"`
+	SyncerSignatureSuffix    string `koanf:"sync-signature-suffix"`
+	SyncerRetryAttempts      int    `koanf:"sync-retry-attempts"`
+	SyncerRetryDelayVal      string `koanf:"sync-retry-delay"`
+	RetentionInstances       string `koanf:"retention-instances"`
+	RetentionEvents          string `koanf:"retention-events"`
+	RetentionHistory         string `koanf:"retention-status-history"`
+	RetentionStats           string `koanf:"retention-instance-stats"`
+	RetentionStatsDaily      string `koanf:"retention-instance-stats-daily"`
+	RetentionStatsWeekly     string `koanf:"retention-instance-stats-weekly"`
+	RetentionInterval        string `koanf:"retention-interval"`
+	RetentionBatchSize       int    `koanf:"retention-batch-size"`
+	RetentionWebhookDelivery string `koanf:"retention-webhook-deliveries"`
+	WebhookInterval          string `koanf:"webhook-interval"`
+	WebhookTimeout           string `koanf:"webhook-timeout"`
+	WebhookMaxAttempts       int    `koanf:"webhook-max-attempts"`
+	SMTPHost                 string `koanf:"smtp-host"`
+	SMTPPort                 int    `koanf:"smtp-port"`
+	SMTPUsername             string `koanf:"smtp-username"`
+	SMTPPassword             string `koanf:"smtp-password"`
+	SMTPFrom                 string `koanf:"smtp-from"`
+	SMTPTLS                  string `koanf:"smtp-tls"`
+	EmailInterval            string `koanf:"email-interval"`
+	RefuseDuplicateInstances bool   `koanf:"refuse-duplicate-instances"`
+	AppLogoPath              string `koanf:"client-logo"`
+	AppTitle                 string `koanf:"client-title"`
+	AppHeaderStyle           string `koanf:"client-header-style"`
+	APIEndpointSuffix        string `koanf:"api-endpoint-suffix"`
+	Debug                    bool   `koanf:"debug"`
+	ServerPort               uint   `koanf:"port"`
+	RollbackDBTo             string `koanf:"rollback-db-to"`
 
 	GhClientID        string `koanf:"gh-client-id"`
 	GhClientSecret    string `koanf:"gh-client-secret"`
@@ -42,11 +67,16 @@ type Config struct {
 	GhReadOnlyTeams   string `koanf:"gh-ro-teams"`
 	GhEnterpriseURL   string `koanf:"gh-enterprise-url"`
 
+	LocalSessionAuthKey  string `koanf:"local-session-secret"`
+	LocalSessionCryptKey string `koanf:"local-session-crypt-key"`
+	LocalAdminPassword   string `koanf:"local-admin-password"`
+
 	OidcClientID      string `koanf:"oidc-client-id"`
 	OidcIssuerURL     string `koanf:"oidc-issuer-url"`
 	OidcAdminRoles    string `koanf:"oidc-admin-roles"`
 	OidcViewerRoles   string `koanf:"oidc-viewer-roles"`
 	OidcRolesPath     string `koanf:"oidc-roles-path"`
+	OidcTeamsPath     string `koanf:"oidc-teams-path"`
 	OidcScopes        string `koanf:"oidc-scopes"`
 	OidcManagementURL string `koanf:"oidc-management-url"`
 	OidcLogoutURL     string `koanf:"oidc-logout-url"`
@@ -64,6 +94,11 @@ const (
 	ghSessionCryptKeyEnvName = "NEBRASKA_GITHUB_SESSION_CRYPT_KEY"
 	ghWebhookSecretEnvName   = "NEBRASKA_GITHUB_WEBHOOK_SECRET"
 	ghEnterpriseURLEnvName   = "NEBRASKA_GITHUB_ENTERPRISE_URL"
+	smtpPasswordEnvName      = "NEBRASKA_SMTP_PASSWORD"
+
+	localSessionAuthKeyEnvName  = "NEBRASKA_LOCAL_SESSION_SECRET"
+	localSessionCryptKeyEnvName = "NEBRASKA_LOCAL_SESSION_CRYPT_KEY"
+	localAdminPasswordEnvName   = "NEBRASKA_LOCAL_ADMIN_PASSWORD"
 )
 
 func (c *Config) Validate() error {
@@ -94,6 +129,17 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.SMTPHost != "" {
+		if _, err := mail.ParseAddress(c.SMTPFrom); err != nil {
+			return errors.New("invalid SMTP sender, please ensure the value provided using -smtp-from is a valid email address")
+		}
+		switch c.SMTPTLS {
+		case "starttls", "tls", "none":
+		default:
+			return errors.New("invalid SMTP TLS mode, available modes: starttls, tls, none")
+		}
+	}
+
 	if c.CAFile != "" {
 		if _, err := os.Stat(c.CAFile); err != nil {
 			return fmt.Errorf("invalid ca-file: %w", err)
@@ -115,7 +161,7 @@ func Parse() (*Config, error) {
 	f.String("syncer-packages-url", "", "use this URL instead of the original one for packages created by the syncer; any {{ARCH}} and {{VERSION}} in the URL will be replaced by the original package's architecture and version, respectively. If this option is not used but the 'host-flatcar-packages' one is, then the URL will be nebraska-url/flatcar/ .")
 	f.Bool("http-log", false, "Enable http requests logging")
 	f.String("http-static-dir", "../frontend/dist", "Path to frontend static files")
-	f.String("auth-mode", "oidc", "authentication mode, available modes: noop, github, oidc")
+	f.String("auth-mode", "oidc", "authentication mode, available modes: noop, github, oidc, local")
 
 	f.String("gh-client-id", "", fmt.Sprintf("GitHub client ID used for authentication; can be taken from %s env var too", ghClientIDEnvName))
 	f.String("gh-client-secret", "", fmt.Sprintf("GitHub client secret used for authentication; can be taken from %s env var too", ghClientSecretEnvName))
@@ -126,19 +172,48 @@ func Parse() (*Config, error) {
 	f.String("gh-ro-teams", "", "comma-separated list of read-only GitHub teams in the org/team format")
 	f.String("gh-enterprise-url", "", fmt.Sprintf("base URL of the enterprise instance if using GHE; can be taken from %s env var too", ghEnterpriseURLEnvName))
 
+	f.String("local-session-secret", "", fmt.Sprintf("Session secret used for authenticating the session cookies of the local users, will be generated if none is passed; can be taken from %s env var too", localSessionAuthKeyEnvName))
+	f.String("local-session-crypt-key", "", fmt.Sprintf("Session key used for encrypting the session cookies of the local users, will be generated if none is passed; can be taken from %s env var too", localSessionCryptKeyEnvName))
+	f.String("local-admin-password", "", fmt.Sprintf("password of the admin local user of the default team, created at startup if it doesn't exist yet; can be taken from %s env var too", localAdminPasswordEnvName))
+
 	f.String("oidc-client-id", "", fmt.Sprintf("OIDC client ID used for authentication;can be taken from %s env var too", oidcClientIDEnvName))
 	f.String("oidc-issuer-url", "", "OIDC issuer URL used for authentication")
 	f.String("oidc-admin-roles", "", "comma-separated list of accepted roles with admin access")
 	f.String("oidc-viewer-roles", "", "comma-separated list of accepted roles with viewer access")
 	f.String("oidc-roles-path", "roles", "json path in which the roles array is present in the id token")
+	f.String("oidc-teams-path", "", "json path of the claim mapping the users to the teams, all the users belong to the default team when empty")
 	f.String("oidc-scopes", "openid,profile,email", "comma-separated list of scopes to be used in OIDC")
 	f.String("oidc-management-url", "", "OIDC management url for managing the account")
 	f.String("oidc-logout-url", "", "OIDC logout URL (optional fallback when end_session_endpoint is not available in discovery)")
 	f.String("oidc-audience", "", "OIDC audience parameter for the access token")
 	f.Bool("oidc-use-userinfo", false, "Use OIDC UserInfo endpoint for role extraction (for providers that don't include roles in access token)")
 	f.String("ca-file", "", "path to a PEM-encoded CA certificate file to trust for TLS verification (additive to system CAs, supports multiple certs in one file)")
-	f.String("sync-update-url", "https://public.update.flatcar-linux.net/v1/update/", "Flatcar update URL to sync from")
+	f.String("sync-update-url", "https://public.update.flatcar-linux.net/v1/update/", "Flatcar update URL to sync from, or a comma-separated list of URLs tried in order when an upstream fails")
 	f.String("sync-interval", "1h", "Sync check interval (the minimum depends on the number of channels to sync, e.g., 8m for 8 channels incl. different architectures)")
+	f.String("sync-trusted-keys", "", "comma-separated list of paths to PEM-encoded public keys (RSA, ECDSA or ed25519) trusted to sign synced payloads; when set, payloads are only imported if their detached signature verifies (payloads are downloaded for verification even if not hosted)")
+	f.String("sync-signature-suffix", ".sig", "suffix appended to a payload URL to fetch its detached signature")
+	f.Int("sync-retry-attempts", 3, "number of attempts made to an upstream update URL before failing over to the next one")
+	f.String("sync-retry-delay", "2s", "initial delay between attempts to an upstream update URL, doubled after each failure")
+	f.String("retention-instances", "", "how long instances that stopped checking for updates are kept, e.g. 2160h for 90 days (at least 24h); empty keeps them forever")
+	f.String("retention-events", "", "how long instances events are kept, e.g. 720h for 30 days; empty keeps them forever")
+	f.String("retention-status-history", "", "how long instances status history entries are kept; empty keeps them forever")
+	f.String("retention-instance-stats", "", "how long hourly instance stats snapshots are kept, they are rolled up into daily and weekly ones; empty keeps them forever")
+	f.String("retention-instance-stats-daily", "", "how long daily instance stats snapshots are kept; empty keeps them forever")
+	f.String("retention-instance-stats-weekly", "", "how long weekly instance stats snapshots are kept; empty keeps them forever")
+	f.String("retention-interval", "1h", "interval between runs of the job pruning rows past their retention")
+	f.Int("retention-batch-size", 5000, "maximum number of rows removed at once by the pruning job")
+	f.String("retention-webhook-deliveries", "", "how long the deliveries log of the webhooks is kept, pending deliveries are never removed; empty keeps it forever")
+	f.String("webhook-interval", "10s", "interval between runs of the job delivering activity entries to the webhooks")
+	f.String("webhook-timeout", "10s", "timeout of the requests delivering activity entries to the webhooks")
+	f.Int("webhook-max-attempts", 8, "number of attempts made to deliver an activity entry to a webhook before giving up, retried with an exponential backoff")
+	f.String("smtp-host", "", "host of the SMTP server used to send the email notifications; empty disables them")
+	f.Int("smtp-port", 587, "port of the SMTP server used to send the email notifications")
+	f.String("smtp-username", "", "username used to authenticate to the SMTP server; empty disables authentication")
+	f.String("smtp-password", "", fmt.Sprintf("password used to authenticate to the SMTP server; can be taken from %s env var too", smtpPasswordEnvName))
+	f.String("smtp-from", "", "sender address of the email notifications, e.g. Nebraska <nebraska@example.com>")
+	f.String("smtp-tls", "starttls", "TLS mode of the connections to the SMTP server: starttls (required), tls (implicit TLS, usually on port 465) or none")
+	f.String("email-interval", "1m", "interval between runs of the job sending the email notifications")
+	f.Bool("refuse-duplicate-instances", false, "refuse updates to instances suspected of sharing their machine id with other machines (e.g. cloned from the same image), detected by their IP or version flapping")
 	f.String("client-logo", "", "Client app logo, should be a path to svg file")
 	f.String("client-title", "", "Client app title")
 	f.String("client-header-style", "light", "Client app header style, should be either dark or light")
@@ -164,6 +239,8 @@ func Parse() (*Config, error) {
 		return nil, fmt.Errorf("error unmarshal config: %w", err)
 	}
 
+	config.SMTPPassword = getPotentialOrEnv(config.SMTPPassword, smtpPasswordEnvName)
+
 	switch config.AuthMode {
 	case "oidc":
 		config.OidcClientID = getPotentialOrEnv(config.OidcClientID, oidcClientIDEnvName)
@@ -183,6 +260,19 @@ func Parse() (*Config, error) {
 		if config.GhSessionCryptKey == "" {
 			config.GhSessionCryptKey = string(random.Data(32))
 		}
+
+	case "local":
+		config.LocalSessionAuthKey = getPotentialOrEnv(config.LocalSessionAuthKey, localSessionAuthKeyEnvName)
+		config.LocalSessionCryptKey = getPotentialOrEnv(config.LocalSessionCryptKey, localSessionCryptKeyEnvName)
+		config.LocalAdminPassword = getPotentialOrEnv(config.LocalAdminPassword, localAdminPasswordEnvName)
+
+		if config.LocalSessionAuthKey == "" {
+			config.LocalSessionAuthKey = string(random.Data(32))
+		}
+
+		if config.LocalSessionCryptKey == "" {
+			config.LocalSessionCryptKey = string(random.Data(32))
+		}
 	}
 
 	return &config, nil
@@ -0,0 +1,253 @@
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/random"
+)
+
+// TLS modes of the connections to the SMTP server.
+const (
+	// TLSStartTLS upgrades the connections with STARTTLS, failing if the
+	// server doesn't support it.
+	TLSStartTLS = "starttls"
+
+	// TLSImplicit connects over TLS right away, usually on port 465.
+	TLSImplicit = "tls"
+
+	// TLSNone doesn't encrypt the connections. Credentials are only sent
+	// over unencrypted connections to localhost.
+	TLSNone = "none"
+)
+
+const defaultSendTimeout = 30 * time.Second
+
+var (
+	// ErrInvalidMailerConfig error indicates that the configuration provided
+	// to the mailer constructor is not valid.
+	ErrInvalidMailerConfig = errors.New("invalid mailer config")
+
+	// ErrStartTLSNotSupported error indicates that the SMTP server doesn't
+	// support STARTTLS while it's required.
+	ErrStartTLSNotSupported = errors.New("SMTP server does not support STARTTLS")
+)
+
+// Mailer sends plain text emails through an SMTP server.
+type Mailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     *mail.Address
+	tlsMode  string
+	rootCAs  *x509.CertPool
+	timeout  time.Duration
+}
+
+// MailerConfig represents the configuration used to create a new Mailer
+// instance.
+type MailerConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	TLS      string
+	RootCAs  *x509.CertPool
+	Timeout  time.Duration
+}
+
+// SetupMailer creates a new mailer from config, and returns it. It returns a
+// nil mailer when no SMTP server is configured.
+func SetupMailer(conf *config.Config) (*Mailer, error) {
+	if conf.SMTPHost == "" {
+		return nil, nil
+	}
+
+	return NewMailer(&MailerConfig{
+		Host:     conf.SMTPHost,
+		Port:     conf.SMTPPort,
+		Username: conf.SMTPUsername,
+		Password: conf.SMTPPassword,
+		From:     conf.SMTPFrom,
+		TLS:      conf.SMTPTLS,
+		RootCAs:  conf.CACertPool,
+	})
+}
+
+// NewMailer creates a new Mailer instance.
+func NewMailer(conf *MailerConfig) (*Mailer, error) {
+	if conf.Host == "" || conf.Port <= 0 {
+		return nil, fmt.Errorf("%w: missing SMTP server host or port", ErrInvalidMailerConfig)
+	}
+	from, err := mail.ParseAddress(conf.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid sender address: %w", ErrInvalidMailerConfig, err)
+	}
+
+	m := &Mailer{
+		host:     conf.Host,
+		port:     conf.Port,
+		username: conf.Username,
+		password: conf.Password,
+		from:     from,
+		tlsMode:  conf.TLS,
+		rootCAs:  conf.RootCAs,
+		timeout:  conf.Timeout,
+	}
+
+	switch m.tlsMode {
+	case "":
+		m.tlsMode = TLSStartTLS
+	case TLSStartTLS, TLSImplicit, TLSNone:
+	default:
+		return nil, fmt.Errorf("%w: unknown TLS mode %q", ErrInvalidMailerConfig, m.tlsMode)
+	}
+
+	if m.timeout <= 0 {
+		m.timeout = defaultSendTimeout
+	}
+
+	return m, nil
+}
+
+// Send sends an email with the subject and plain text body provided to the
+// recipients provided.
+func (m *Mailer) Send(to []string, subject, body string) error {
+	msg, err := m.message(to, subject, body)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(m.host, strconv.Itoa(m.port))
+	dialer := &net.Dialer{Timeout: m.timeout}
+	var conn net.Conn
+	if m.tlsMode == TLSImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, m.tlsConfig())
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(m.timeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if m.tlsMode == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return ErrStartTLSNotSupported
+		}
+		if err := c.StartTLS(m.tlsConfig()); err != nil {
+			return err
+		}
+	}
+
+	if m.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := c.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// SendTest sends a test email to the recipients of the email notification
+// provided.
+func (m *Mailer) SendTest(notification *api.EmailNotification, app *api.Application) error {
+	subject, body, err := testMessage(app)
+	if err != nil {
+		return err
+	}
+	return m.Send(notification.Recipients, subject, body)
+}
+
+func (m *Mailer) tlsConfig() *tls.Config {
+	return &tls.Config{
+		ServerName: m.host,
+		RootCAs:    m.rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// message returns the email provided formatted as an RFC 5322 message with a
+// quoted-printable encoded UTF-8 body.
+func (m *Mailer) message(to []string, subject, body string) ([]byte, error) {
+	if len(to) == 0 {
+		return nil, errors.New("no recipients")
+	}
+
+	domain := "localhost"
+	if i := strings.LastIndex(m.from.Address, "@"); i >= 0 {
+		domain = m.from.Address[i+1:]
+	}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", m.from.String()},
+		{"To", strings.Join(to, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", random.String(24), domain)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header[0], header[1])
+	}
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(body, "\n") {
+		buf.WriteString("\r\n")
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,217 @@
+// Package email notifies the rollout outcomes and the daily digests of the
+// instances update failures of the applications by email.
+package email
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+)
+
+const (
+	defaultInterval = time.Minute
+
+	// digestPeriod is the period covered by the digests of the instances
+	// update failures.
+	digestPeriod = 24 * time.Hour
+)
+
+var (
+	l = logger.New("email")
+
+	// ErrInvalidAPIInstance error indicates that no valid api instance was
+	// provided to the notifier constructor.
+	ErrInvalidAPIInstance = errors.New("invalid api instance")
+
+	// ErrInvalidMailer error indicates that no valid mailer was provided to
+	// the notifier constructor.
+	ErrInvalidMailer = errors.New("invalid mailer")
+)
+
+// Notifier represents a background job in charge of sending the email
+// notifications of the applications.
+type Notifier struct {
+	api         *api.API
+	mailer      *Mailer
+	nebraskaURL string
+	interval    time.Duration
+	stopCh      chan struct{}
+	stopOnce    sync.Once
+}
+
+// Config represents the configuration used to create a new Notifier instance.
+type Config struct {
+	API         *api.API
+	Mailer      *Mailer
+	NebraskaURL string
+	Interval    time.Duration
+}
+
+// Setup creates a new notifier from config and db connection, and returns it.
+func Setup(conf *config.Config, db *api.API) (*Notifier, error) {
+	var err error
+
+	interval := defaultInterval
+	if conf.EmailInterval != "" {
+		interval, err = time.ParseDuration(conf.EmailInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid email interval: %w", err)
+		}
+	}
+
+	mailer, err := SetupMailer(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(&Config{
+		API:         db,
+		Mailer:      mailer,
+		NebraskaURL: conf.NebraskaURL,
+		Interval:    interval,
+	})
+}
+
+// New creates a new Notifier instance.
+func New(conf *Config) (*Notifier, error) {
+	if conf.API == nil {
+		return nil, ErrInvalidAPIInstance
+	}
+	if conf.Mailer == nil {
+		return nil, ErrInvalidMailer
+	}
+
+	n := &Notifier{
+		api:         conf.API,
+		mailer:      conf.Mailer,
+		nebraskaURL: strings.TrimSuffix(conf.NebraskaURL, "/"),
+		interval:    conf.Interval,
+		stopCh:      make(chan struct{}),
+	}
+
+	if n.interval <= 0 {
+		n.interval = defaultInterval
+	}
+
+	return n, nil
+}
+
+// Start makes the notifier start working. It will send the pending email
+// notifications every interval until it's stopped.
+func (n *Notifier) Start() {
+	l.Debug().Msg("notifier ready!")
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := n.Notify(); err != nil {
+			l.Error().Err(err).Msg("sending email notifications")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-n.stopCh:
+			return
+		}
+	}
+}
+
+// Stop stops the notifier.
+func (n *Notifier) Stop() {
+	n.stopOnce.Do(func() {
+		close(n.stopCh)
+	})
+}
+
+// Notify sends the rollout outcomes queued since the last run to the
+// recipients of the enabled email notifications, and their daily digests when
+// they are due.
+func (n *Notifier) Notify() error {
+	return n.notify(time.Now())
+}
+
+func (n *Notifier) notify(now time.Time) error {
+	notifications, err := n.api.GetEnabledEmailNotifications()
+	if err != nil {
+		return err
+	}
+
+	// The cursors are stored with a microsecond precision, so they are
+	// truncated to compare them with their stored value when released.
+	now = now.UTC().Truncate(time.Microsecond)
+	for _, notification := range notifications {
+		if err := n.notifyRollouts(notification); err != nil {
+			l.Error().Err(err).Str("notification", notification.ID).Msg("notifying rollouts by email")
+		}
+		if notification.DailyDigest && now.Sub(notification.LastDigestTs) >= digestPeriod {
+			if err := n.sendDigest(notification, now); err != nil {
+				l.Error().Err(err).Str("notification", notification.ID).Msg("sending daily digest by email")
+			}
+		}
+
+		select {
+		case <-n.stopCh:
+			return nil
+		default:
+		}
+	}
+
+	return nil
+}
+
+// notifyRollouts sends the rollout outcomes queued for the notification
+// provided by the transactions creating them. The ones not sent because of an
+// error are retried on the next run.
+func (n *Notifier) notifyRollouts(notification *api.EmailNotification) error {
+	return n.api.NotifyEmailNotificationRollouts(notification, func(activityEntry *api.Activity) error {
+		subject, body, err := rolloutMessage(activityEntry, n.nebraskaURL)
+		if err != nil {
+			return err
+		}
+		return n.mailer.Send(notification.Recipients, subject, body)
+	})
+}
+
+// sendDigest sends the digest of the instances update failures reported since
+// the last digest of the email notification provided. The period is claimed
+// before sending it, and no digest is sent when there were no failures.
+func (n *Notifier) sendDigest(notification *api.EmailNotification, until time.Time) error {
+	from := notification.LastDigestTs
+	claimed, err := n.api.MoveEmailNotificationDigestCursor(notification.ID, from, until)
+	if err != nil || !claimed {
+		return err
+	}
+
+	err = n.sendDigestMessage(notification, from, until)
+	if err != nil {
+		if _, releaseErr := n.api.MoveEmailNotificationDigestCursor(notification.ID, until, from); releaseErr != nil {
+			l.Error().Err(releaseErr).Str("notification", notification.ID).Msg("releasing email notification digest")
+		}
+	}
+	return err
+}
+
+func (n *Notifier) sendDigestMessage(notification *api.EmailNotification, from, until time.Time) error {
+	failures, err := n.api.GetInstanceFailures(notification.ApplicationID, from, until)
+	if err != nil || len(failures) == 0 {
+		return err
+	}
+
+	app, err := n.api.GetApp(notification.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := digestMessage(app, from, until, failures, n.nebraskaURL)
+	if err != nil {
+		return err
+	}
+	return n.mailer.Send(notification.Recipients, subject, body)
+}
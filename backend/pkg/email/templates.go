@@ -0,0 +1,124 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"timestamp": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 MST") },
+}).Parse(`
+{{- define "rollout" -}}
+{{- with .Activity -}}
+The rollout of version {{ .Version }} to the {{ .GroupName.String }} group of {{ .ApplicationName }}
+{{- if $.Finished }} finished successfully{{ else }} failed{{ end }} at {{ timestamp .CreatedTs }}.
+{{ if .ChannelName.Valid }}
+Channel: {{ .ChannelName.String }}
+{{- end }}
+{{- if not $.Finished }}
+
+Updates were disabled in the group. Check the failures reported by its
+instances before enabling them again.
+{{- end }}
+{{- end }}
+{{ if .URL }}
+Group: {{ .URL }}
+{{ end }}
+{{- end -}}
+
+{{- define "digest" -}}
+Update failures reported by the instances of {{ .ApplicationName }} between
+{{ timestamp .From }} and {{ timestamp .Until }}:
+
+{{ range .Failures -}}
+- error code {{ if .ErrorCode.Valid }}{{ .ErrorCode.String }}{{ else }}unknown{{ end }}: {{ .Events }} failure{{ if ne .Events 1 }}s{{ end }} reported by {{ .Instances }} instance{{ if ne .Instances 1 }}s{{ end }}
+{{ end }}
+{{- if .URL }}
+Application: {{ .URL }}
+{{ end }}
+{{- end -}}
+
+{{- define "test" -}}
+This is a test email notification sent by Nebraska for {{ .ApplicationName }}.
+
+If you got it, the rollout outcomes of the application will be notified to
+this address.
+{{ end -}}
+`))
+
+type rolloutData struct {
+	Activity *api.Activity
+	Finished bool
+	URL      string
+}
+
+type digestData struct {
+	ApplicationName string
+	From            time.Time
+	Until           time.Time
+	Failures        []*api.InstanceFailures
+	URL             string
+}
+
+type testData struct {
+	ApplicationName string
+}
+
+// rolloutMessage returns the subject and body of the email notifying the
+// rollout outcome recorded in the activity entry provided.
+func rolloutMessage(activityEntry *api.Activity, nebraskaURL string) (string, string, error) {
+	data := rolloutData{
+		Activity: activityEntry,
+		Finished: activityEntry.Class == api.ActivityRolloutFinished,
+	}
+	if nebraskaURL != "" && activityEntry.AppID.Valid && activityEntry.GroupID.Valid {
+		data.URL = fmt.Sprintf("%s/apps/%s/groups/%s", nebraskaURL, activityEntry.AppID.String, activityEntry.GroupID.String)
+	}
+
+	outcome := "failed"
+	if data.Finished {
+		outcome = "finished"
+	}
+	subject := fmt.Sprintf("[Nebraska] Rollout of %s %s in %s / %s", activityEntry.Version, outcome, activityEntry.ApplicationName, activityEntry.GroupName.String)
+
+	body, err := execute("rollout", data)
+	return subject, body, err
+}
+
+// digestMessage returns the subject and body of the daily digest of the
+// failures provided.
+func digestMessage(app *api.Application, from, until time.Time, failures []*api.InstanceFailures, nebraskaURL string) (string, string, error) {
+	data := digestData{
+		ApplicationName: app.Name,
+		From:            from,
+		Until:           until,
+		Failures:        failures,
+	}
+	if nebraskaURL != "" {
+		data.URL = fmt.Sprintf("%s/apps/%s", nebraskaURL, app.ID)
+	}
+
+	subject := fmt.Sprintf("[Nebraska] Daily update failures digest for %s", app.Name)
+	body, err := execute("digest", data)
+	return subject, body, err
+}
+
+// testMessage returns the subject and body of the email sent to check the
+// email notifications of the application provided.
+func testMessage(app *api.Application) (string, string, error) {
+	subject := fmt.Sprintf("[Nebraska] Test email notification for %s", app.Name)
+	body, err := execute("test", testData{ApplicationName: app.Name})
+	return subject, body, err
+}
+
+func execute(name string, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/codegen"
@@ -30,6 +32,10 @@ func (h *Handler) PaginateActivity(ctx echo.Context, params codegen.PaginateActi
 		}
 		p.AppID = appID
 	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(p.AppID)); replied {
+		return nil
+	}
 	if params.GroupID != nil {
 		p.GroupID = *params.GroupID
 	}
@@ -45,6 +51,9 @@ func (h *Handler) PaginateActivity(ctx echo.Context, params codegen.PaginateActi
 	if params.Severity != nil {
 		p.Severity = *params.Severity
 	}
+	if params.Unacknowledged != nil {
+		p.Unacknowledged = *params.Unacknowledged
+	}
 	p.Start, _ = time.Parse(time.RFC3339, params.Start)
 	p.End, _ = time.Parse(time.RFC3339, params.End)
 	p.Page = uint64(*params.Page)
@@ -67,6 +76,58 @@ func (h *Handler) PaginateActivity(ctx echo.Context, params codegen.PaginateActi
 	return ctx.JSON(http.StatusOK, activityPage{totalCount, len(activityEntries), activityEntries})
 }
 
+func (h *Handler) AcknowledgeActivity(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+	teamID := getTeamID(ctx)
+
+	// activity entries of several applications can be acknowledged at once
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	var request codegen.ActivityAcknowledgement
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("acknowledgeActivity")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	ack := api.ActivityAcknowledgement{
+		ActivityIDs: request.Ids,
+		Username:    getUsername(ctx),
+		Comment:     null.StringFromPtr(request.Comment),
+	}
+	if request.Resolve != nil {
+		ack.Resolve = *request.Resolve
+	}
+
+	updated, err := h.admin.AcknowledgeActivity(teamID, ack)
+	if err != nil {
+		if errors.Is(err, api.ErrInvalidActivityAcknowledgement) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_activity_acknowledgement",
+				"description": err.Error(),
+			})
+		}
+		l.Error().Err(err).Str("teamID", teamID).Msg("acknowledgeActivity")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetActivity, "", "", nil,
+		activityAcknowledgementChange{ack.ActivityIDs, ack.Resolve, ack.Comment, updated})
+
+	l.Info().Int64("updated", updated).Bool("resolve", ack.Resolve).Msg("acknowledgeActivity - successfully acknowledged activity entries")
+	return ctx.JSON(http.StatusOK, codegen.ActivityAcknowledgementResult{Updated: updated})
+}
+
+// activityAcknowledgementChange is the state recorded in the audit log for the
+// activity entries acknowledged at once.
+type activityAcknowledgementChange struct {
+	IDs     []string    `json:"ids"`
+	Resolve bool        `json:"resolve"`
+	Comment null.String `json:"comment"`
+	Updated int64       `json:"updated"`
+}
+
 type activityPage struct {
 	TotalCount int             `json:"totalCount"`
 	Count      int             `json:"count"`
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+type apiTokensPage struct {
+	TotalCount int             `json:"totalCount"`
+	Count      int             `json:"count"`
+	Tokens     []*api.APIToken `json:"tokens"`
+}
+
+// createdAPIToken is the API token returned when it's created, the only time
+// its secret is known.
+type createdAPIToken struct {
+	*api.APIToken
+	Token string `json:"token"`
+}
+
+func (h *Handler) PaginateAPITokens(ctx echo.Context, params codegen.PaginateAPITokensParams) error {
+	if replied := h.authorize(ctx, api.RoleViewer, roleScope{}); replied {
+		return nil
+	}
+
+	if usingAPIToken(ctx) {
+		return ctx.NoContent(http.StatusForbidden)
+	}
+
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	teamID := getTeamID(ctx)
+
+	totalCount, err := h.db.GetAPITokensCount(teamID)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("getAPITokens count - getting API tokens")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	tokens, err := h.db.GetAPITokens(teamID, uint64(*params.Page), uint64(*params.Perpage))
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("getAPITokens - getting API tokens")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if tokens == nil {
+		tokens = []*api.APIToken{}
+	}
+	return ctx.JSON(http.StatusOK, apiTokensPage{totalCount, len(tokens), tokens})
+}
+
+func (h *Handler) CreateAPIToken(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	if usingAPIToken(ctx) {
+		return ctx.NoContent(http.StatusForbidden)
+	}
+
+	var request codegen.ApiTokenConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addAPIToken")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	token := &api.APIToken{
+		Name:      request.Name,
+		TeamID:    getTeamID(ctx),
+		Role:      string(request.Role),
+		CreatedBy: getUsername(ctx),
+		ExpiresTs: null.TimeFromPtr(request.ExpiresTs),
+	}
+	token, secret, err := h.admin.AddAPIToken(token)
+	if err != nil {
+		if errors.Is(err, api.ErrInvalidAPIToken) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_api_token",
+				"description": err.Error(),
+			})
+		}
+		l.Error().Err(err).Msg("addAPIToken")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetAPIToken, token.ID, "", nil, token)
+	l.Info().Str("token", token.ID).Msgf("addAPIToken - successfully added API token %s", token.Name)
+	return ctx.JSON(http.StatusOK, createdAPIToken{token, secret})
+}
+
+func (h *Handler) GetAPIToken(ctx echo.Context, tokenID string) error {
+	if replied := h.authorize(ctx, api.RoleViewer, roleScope{}); replied {
+		return nil
+	}
+
+	if usingAPIToken(ctx) {
+		return ctx.NoContent(http.StatusForbidden)
+	}
+
+	token, err := h.getTeamAPIToken(ctx, tokenID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("tokenID", tokenID).Msg("getAPIToken - getting API token")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	return ctx.JSON(http.StatusOK, token)
+}
+
+func (h *Handler) RevokeAPIToken(ctx echo.Context, tokenID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	if usingAPIToken(ctx) {
+		return ctx.NoContent(http.StatusForbidden)
+	}
+
+	token, err := h.getTeamAPIToken(ctx, tokenID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("tokenID", tokenID).Msg("revokeAPIToken - getting API token to revoke")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	err = h.admin.RevokeAPIToken(tokenID)
+	switch err {
+	case nil:
+		revokedToken, err := h.db.GetAPIToken(tokenID)
+		if err != nil {
+			l.Error().Err(err).Str("tokenID", tokenID).Msg("revokeAPIToken - getting revoked API token")
+			revokedToken = nil
+		}
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetAPIToken, tokenID, "", token, revokedToken)
+		l.Info().Str("token", tokenID).Msg("revokeAPIToken - successfully revoked API token")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("tokenID", tokenID).Msg("revokeAPIToken - revoking API token")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+// getTeamAPIToken returns the API token identified by the id provided, or
+// sql.ErrNoRows if it doesn't belong to the team of the request.
+func (h *Handler) getTeamAPIToken(ctx echo.Context, tokenID string) (*api.APIToken, error) {
+	token, err := h.db.GetAPIToken(tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if token.TeamID != getTeamID(ctx) {
+		return nil, sql.ErrNoRows
+	}
+	return token, nil
+}
+
+// usingAPIToken reports whether the request was authenticated with an API
+// token. API tokens can't be used to manage API tokens.
+func usingAPIToken(ctx echo.Context) bool {
+	return ctx.Get("api_token_id") != nil
+}
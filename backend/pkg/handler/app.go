@@ -22,13 +22,31 @@ func (h *Handler) PaginateApps(ctx echo.Context, params codegen.PaginateAppsPara
 		params.Perpage = &defaultPerPage
 	}
 
-	totalCount, err := h.db.GetAppsCount(teamID)
+	// users without a global role only see the applications they have a
+	// role binding on
+	appIDs, err := h.visibleAppIDs(ctx)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("getApps - getting role bindings")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var totalCount int
+	if appIDs == nil {
+		totalCount, err = h.db.GetAppsCount(teamID)
+	} else {
+		totalCount, err = h.db.GetAppsByIDsCount(teamID, appIDs)
+	}
 	if err != nil {
 		l.Error().Err(err).Str("teamID", teamID).Msg("getApps count - getting apps")
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
-	apps, err := h.db.GetApps(teamID, uint64(*params.Page), uint64(*params.Perpage))
+	var apps []*api.Application
+	if appIDs == nil {
+		apps, err = h.db.GetApps(teamID, uint64(*params.Page), uint64(*params.Perpage))
+	} else {
+		apps, err = h.db.GetAppsByIDs(teamID, appIDs, uint64(*params.Page), uint64(*params.Perpage))
+	}
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return ctx.NoContent(http.StatusNotFound)
@@ -43,6 +61,10 @@ func (h *Handler) PaginateApps(ctx echo.Context, params codegen.PaginateAppsPara
 func (h *Handler) CreateApp(ctx echo.Context, params codegen.CreateAppParams) error {
 	l := loggerWithUsername(l, ctx)
 
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
 	teamID := getTeamID(ctx)
 
 	var request codegen.AppConfig
@@ -60,6 +82,9 @@ func (h *Handler) CreateApp(ctx echo.Context, params codegen.CreateAppParams) er
 		if err != nil {
 			return appNotFoundResponse(ctx, *params.CloneFrom)
 		}
+		if replied := h.authorize(ctx, api.RoleViewer, appScope(cloneAppID)); replied {
+			return nil
+		}
 		source = cloneAppID
 	}
 
@@ -79,6 +104,7 @@ func (h *Handler) CreateApp(ctx echo.Context, params codegen.CreateAppParams) er
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetApplication, app.ID, app.ID, nil, app)
 	l.Info().Msgf("addApp - successfully added app %+v", app)
 	return ctx.JSON(http.StatusOK, app)
 }
@@ -89,6 +115,10 @@ func (h *Handler) GetApp(ctx echo.Context, appIDorProductID string) error {
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	app, err := h.db.GetApp(appID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -115,6 +145,10 @@ func (h *Handler) UpdateApp(ctx echo.Context, appIDorProductID string) error {
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
 	oldApp, err := h.db.GetApp(appID)
 	if err != nil {
 		l.Error().Err(err).Str("appID", appID).Msg("updateApp - getting old app to update")
@@ -135,6 +169,7 @@ func (h *Handler) UpdateApp(ctx echo.Context, appIDorProductID string) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetApplication, appID, appID, oldApp, app)
 	l.Info().Msgf("updateApp - successfully updated app %+v -> %+v", oldApp, app)
 
 	return ctx.JSON(http.StatusOK, app)
@@ -148,6 +183,10 @@ func (h *Handler) DeleteApp(ctx echo.Context, appIDorProductID string) error {
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
 	app, err := h.db.GetApp(appID)
 	if err != nil {
 		l.Error().Err(err).Str("appID", appID).Msg("deleteApp - getting app to delete")
@@ -159,6 +198,7 @@ func (h *Handler) DeleteApp(ctx echo.Context, appIDorProductID string) error {
 		l.Error().Err(err).Str("appID", appID).Msg("deleteApp")
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
+	h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetApplication, appID, appID, app, nil)
 	l.Info().Msgf("deleteApp - successfully deleted app %+v", app)
 
 	return ctx.NoContent(http.StatusNoContent)
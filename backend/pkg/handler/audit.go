@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+type auditPage struct {
+	TotalCount int               `json:"totalCount"`
+	Count      int               `json:"count"`
+	Entries    []*api.AuditEntry `json:"entries"`
+}
+
+func (h *Handler) PaginateAudit(ctx echo.Context, params codegen.PaginateAuditParams) error {
+	teamID := getTeamID(ctx)
+
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	var p api.AuditQueryParams
+	if params.AppIDorProductID != nil {
+		appID, err := h.db.GetAppID(*params.AppIDorProductID)
+		if err != nil {
+			return appNotFoundResponse(ctx, *params.AppIDorProductID)
+		}
+		p.AppID = appID
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(p.AppID)); replied {
+		return nil
+	}
+	if params.Username != nil {
+		p.Username = *params.Username
+	}
+	if params.Action != nil {
+		p.Action = string(*params.Action)
+	}
+	if params.TargetType != nil {
+		p.TargetType = string(*params.TargetType)
+	}
+	if params.TargetID != nil {
+		p.TargetID = *params.TargetID
+	}
+	if params.Start != nil {
+		p.Start = *params.Start
+	}
+	if params.End != nil {
+		p.End = *params.End
+	}
+	p.Page = uint64(*params.Page)
+	p.PerPage = uint64(*params.Perpage)
+
+	totalCount, err := h.db.GetAuditEntriesCount(teamID, p)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msgf("getAudit count params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	entries, err := h.db.GetAuditEntries(teamID, p)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msgf("getAudit params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if entries == nil {
+		entries = []*api.AuditEntry{}
+	}
+	return ctx.JSON(http.StatusOK, auditPage{totalCount, len(entries), entries})
+}
+
+// recordAudit records in the audit log the change of the target provided
+// from before to after made by the user of the request. Nil before and after
+// values stand for creations and deletions respectively. The change was
+// already made, so failures are only logged.
+func (h *Handler) recordAudit(ctx echo.Context, action, targetType, targetID, appID string, before, after any) {
+	l := loggerWithUsername(l, ctx)
+
+	entry := &api.AuditEntry{
+		TeamID:        getTeamID(ctx),
+		Username:      getUsername(ctx),
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		ApplicationID: null.NewString(appID, appID != ""),
+	}
+
+	var err error
+	entry.Before, entry.After, err = api.NewAuditStates(before, after)
+	if err == nil {
+		err = h.admin.AddAuditEntry(entry)
+	}
+	if err != nil {
+		l.Error().Err(err).Str("targetType", targetType).Str("targetID", targetID).Msgf("recordAudit - recording %s", action)
+	}
+}
@@ -13,6 +13,20 @@ func (h *Handler) LoginCb(ctx echo.Context) error {
 	return h.auth.LoginCb(ctx)
 }
 
+// Login starts a session for a local user
+// Local mode: Checks the username and the password of the user
+// Other modes: Returns 501 Not Implemented
+func (h *Handler) Login(ctx echo.Context) error {
+	return h.auth.Login(ctx)
+}
+
+// Logout destroys the session of the user
+// Local and GitHub modes: Marks the session for destruction and clears its cookie
+// OIDC mode: Returns 501 Not Implemented
+func (h *Handler) Logout(ctx echo.Context) error {
+	return h.auth.Logout(ctx)
+}
+
 // ValidateToken validates JWT access tokens
 // OIDC mode: Validates JWT access token signature and expiration
 // GitHub mode: Returns 501 Not Implemented
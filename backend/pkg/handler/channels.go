@@ -2,6 +2,7 @@ package handler
 
 import (
 	"database/sql"
+	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -17,6 +18,10 @@ func (h *Handler) PaginateChannels(ctx echo.Context, appIDorProductID string, pa
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	if params.Page == nil {
 		params.Page = &defaultPage
 	}
@@ -56,6 +61,11 @@ func (h *Handler) CreateChannel(ctx echo.Context, appIDorProductID string) error
 	if err != nil {
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
 	channel := newChannel(appID, request.Arch, request.Color, request.Name, request.PackageId)
 	_, err = h.admin.AddChannel(channel)
 	if err != nil {
@@ -69,11 +79,16 @@ func (h *Handler) CreateChannel(ctx echo.Context, appIDorProductID string) error
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetChannel, channel.ID, appID, nil, channel)
 	l.Info().Msgf("addChannel - successfully added channel %+v", channel)
 	return ctx.JSON(http.StatusOK, channel)
 }
 
 func (h *Handler) GetChannel(ctx echo.Context, _ string, channelID string) error {
+	if _, replied := h.authorizeChannel(ctx, api.RoleViewer, channelID); replied {
+		return nil
+	}
+
 	channel, err := h.db.GetChannel(channelID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -88,6 +103,10 @@ func (h *Handler) GetChannel(ctx echo.Context, _ string, channelID string) error
 func (h *Handler) UpdateChannel(ctx echo.Context, appIDorProductID string, channelID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeChannel(ctx, api.RoleAdmin, channelID); replied {
+		return nil
+	}
+
 	appID, err := h.db.GetAppID(appIDorProductID)
 	if err != nil {
 		return appNotFoundResponse(ctx, appIDorProductID)
@@ -125,6 +144,7 @@ func (h *Handler) UpdateChannel(ctx echo.Context, appIDorProductID string, chann
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetChannel, channelID, appID, oldChannel, channel)
 	l.Info().Msgf("updateChannel - successfully updated channel %+v (PACKAGE: %+v) -> %+v (PACKAGE: %+v)", oldChannel, oldChannel.Package, channel, channel.Package)
 
 	return ctx.JSON(http.StatusOK, channel)
@@ -133,6 +153,10 @@ func (h *Handler) UpdateChannel(ctx echo.Context, appIDorProductID string, chann
 func (h *Handler) DeleteChannel(ctx echo.Context, _ string, channelID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeChannel(ctx, api.RoleAdmin, channelID); replied {
+		return nil
+	}
+
 	channel, err := h.db.GetChannel(channelID)
 	if err != nil {
 		l.Error().Err(err).Str("channelID", channel.ID).Msg("updateChannel - getting channel to be deleted")
@@ -145,6 +169,7 @@ func (h *Handler) DeleteChannel(ctx echo.Context, _ string, channelID string) er
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetChannel, channelID, channel.ApplicationID, channel, nil)
 	l.Info().Msgf("deleteChannel - successfully deleted channel %+v (PACKAGE: %+v)", channel, channel.Package)
 
 	return ctx.NoContent(http.StatusNoContent)
@@ -168,3 +193,60 @@ type channelsPage struct {
 	Count      int            `json:"count"`
 	Channels   []*api.Channel `json:"channels"`
 }
+
+func (h *Handler) GetChannelVersionTimeline(ctx echo.Context, appIDorProductID string, channelID string, params codegen.GetChannelVersionTimelineParams) error {
+	if _, replied := h.authorizeChannel(ctx, api.RoleViewer, channelID); replied {
+		return nil
+	}
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	channel, err := h.db.GetChannel(channelID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("channelID", channelID).Msg("getChannelVersionTimeline - getting channel")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if channel.ApplicationID != appID {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	p := api.VersionStatsQueryParams{
+		ChannelID: channel.ID,
+		TeamID:    getTeamID(ctx),
+	}
+	if params.Start != nil {
+		p.Start = *params.Start
+	}
+	if params.End != nil {
+		p.End = *params.End
+	}
+	if params.Start != nil && params.End != nil && !p.Start.Before(p.End) {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "invalid_time_range",
+			"description": "start must be before end",
+		})
+	}
+	if params.Resolution != nil {
+		p.Resolution = api.InstanceStatsResolution(*params.Resolution)
+	}
+
+	timeline, err := h.db.GetVersionStatsTimeline(p)
+	if err != nil {
+		if err == api.ErrInvalidInstanceStatsResolution {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_resolution",
+				"description": fmt.Sprintf("resolution must be hourly, daily or weekly, got %q", p.Resolution),
+			})
+		}
+		l.Error().Err(err).Str("channelID", channelID).Msgf("getChannelVersionTimeline - getting version timeline params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, timeline)
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+type emailNotificationsPage struct {
+	TotalCount         int                      `json:"totalCount"`
+	Count              int                      `json:"count"`
+	EmailNotifications []*api.EmailNotification `json:"emailNotifications"`
+}
+
+func (h *Handler) PaginateEmailNotifications(ctx echo.Context, appIDorProductID string, params codegen.PaginateEmailNotificationsParams) error {
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
+	totalCount, err := h.db.GetEmailNotificationsCount(appID)
+	if err != nil {
+		l.Error().Err(err).Str("appID", appID).Msg("getEmailNotifications count - getting email notifications")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	notifications, err := h.db.GetEmailNotifications(appID, uint64(*params.Page), uint64(*params.Perpage))
+	if err != nil {
+		l.Error().Err(err).Str("appID", appID).Msg("getEmailNotifications - getting email notifications")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if notifications == nil {
+		notifications = []*api.EmailNotification{}
+	}
+	return ctx.JSON(http.StatusOK, emailNotificationsPage{totalCount, len(notifications), notifications})
+}
+
+func (h *Handler) CreateEmailNotification(ctx echo.Context, appIDorProductID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
+	var request codegen.EmailNotificationConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addEmailNotification")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	notification := newEmailNotification(appID, request)
+	if _, err := h.admin.AddEmailNotification(notification); err != nil {
+		if errors.Is(err, api.ErrInvalidEmailNotification) {
+			return invalidEmailNotificationResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("appID", appID).Msg("addEmailNotification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetEmailNotification, notification.ID, appID, nil, notification)
+	l.Info().Str("notification", notification.ID).Msgf("addEmailNotification - successfully added email notification to app %s", appID)
+	return ctx.JSON(http.StatusOK, notification)
+}
+
+func (h *Handler) GetEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error {
+	notification, err := h.getAppEmailNotification(appIDorProductID, notificationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("getEmailNotification - getting email notification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(notification.ApplicationID)); replied {
+		return nil
+	}
+	return ctx.JSON(http.StatusOK, notification)
+}
+
+func (h *Handler) UpdateEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	var request codegen.EmailNotificationConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("updateEmailNotification")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	oldNotification, err := h.getAppEmailNotification(appIDorProductID, notificationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("updateEmailNotification - getting email notification to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(oldNotification.ApplicationID)); replied {
+		return nil
+	}
+
+	notification := newEmailNotification(oldNotification.ApplicationID, request)
+	notification.ID = notificationID
+	if err := h.admin.UpdateEmailNotification(notification); err != nil {
+		if errors.Is(err, api.ErrInvalidEmailNotification) {
+			return invalidEmailNotificationResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("updateEmailNotification - updating email notification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	notification, err = h.db.GetEmailNotification(notificationID)
+	if err != nil {
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("updateEmailNotification - getting updated email notification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetEmailNotification, notificationID, notification.ApplicationID, oldNotification, notification)
+	l.Info().Str("notification", notificationID).Msg("updateEmailNotification - successfully updated email notification")
+	return ctx.JSON(http.StatusOK, notification)
+}
+
+func (h *Handler) DeleteEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	notification, err := h.getAppEmailNotification(appIDorProductID, notificationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("deleteEmailNotification - getting email notification to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(notification.ApplicationID)); replied {
+		return nil
+	}
+
+	err = h.admin.DeleteEmailNotification(notificationID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetEmailNotification, notificationID, notification.ApplicationID, notification, nil)
+		l.Info().Str("notification", notificationID).Msg("deleteEmailNotification - successfully deleted email notification")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("deleteEmailNotification - deleting email notification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) TestEmailNotification(ctx echo.Context, appIDorProductID string, notificationID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if h.mailer == nil {
+		return ctx.NoContent(http.StatusServiceUnavailable)
+	}
+
+	notification, err := h.getAppEmailNotification(appIDorProductID, notificationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("testEmailNotification - getting email notification")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(notification.ApplicationID)); replied {
+		return nil
+	}
+
+	app, err := h.db.GetApp(notification.ApplicationID)
+	if err != nil {
+		l.Error().Err(err).Str("notificationID", notificationID).Msg("testEmailNotification - getting app")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if err := h.mailer.SendTest(notification, app); err != nil {
+		l.Warn().Err(err).Str("notificationID", notificationID).Msg("testEmailNotification - sending test email")
+		return ctx.JSON(http.StatusBadGateway, map[string]any{
+			"error":       "email_not_sent",
+			"description": err.Error(),
+		})
+	}
+
+	l.Info().Str("notification", notificationID).Msg("testEmailNotification - successfully sent test email")
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// getAppEmailNotification returns the email notification identified by the id
+// provided, or sql.ErrNoRows if it doesn't belong to the application provided.
+func (h *Handler) getAppEmailNotification(appIDorProductID, notificationID string) (*api.EmailNotification, error) {
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return nil, sql.ErrNoRows
+	}
+	notification, err := h.db.GetEmailNotification(notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if notification.ApplicationID != appID {
+		return nil, sql.ErrNoRows
+	}
+	return notification, nil
+}
+
+func newEmailNotification(appID string, request codegen.EmailNotificationConfig) *api.EmailNotification {
+	notification := &api.EmailNotification{
+		ApplicationID:   appID,
+		Recipients:      pq.StringArray(request.Recipients),
+		RolloutFinished: true,
+		RolloutFailed:   true,
+		Enabled:         true,
+	}
+	if request.RolloutFinished != nil {
+		notification.RolloutFinished = *request.RolloutFinished
+	}
+	if request.RolloutFailed != nil {
+		notification.RolloutFailed = *request.RolloutFailed
+	}
+	if request.DailyDigest != nil {
+		notification.DailyDigest = *request.DailyDigest
+	}
+	if request.Enabled != nil {
+		notification.Enabled = *request.Enabled
+	}
+	return notification
+}
+
+func invalidEmailNotificationResponse(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_email_notification",
+		"description": err.Error(),
+	})
+}
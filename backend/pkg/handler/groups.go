@@ -2,6 +2,7 @@ package handler
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -25,6 +26,10 @@ func (h *Handler) PaginateGroups(ctx echo.Context, appIDorProductID string, para
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	totalCount, err := h.db.GetGroupsCount(appID)
 	if err != nil {
 		l.Error().Err(err).Str("appID", appID).Msg("getGroups count - getting groups")
@@ -52,6 +57,10 @@ func (h *Handler) CreateGroup(ctx echo.Context, appIDorProductID string) error {
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
 	var request codegen.GroupConfig
 	err = ctx.Bind(&request)
 	if err != nil {
@@ -72,12 +81,17 @@ func (h *Handler) CreateGroup(ctx echo.Context, appIDorProductID string) error {
 		l.Error().Err(err).Msgf("addGroup - adding group %v", group)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetGroup, group.ID, appID, nil, group)
 	l.Info().Msgf("addGroup - successfully added group %+v", group)
 
 	return ctx.JSON(http.StatusOK, group)
 }
 
 func (h *Handler) GetGroup(ctx echo.Context, _ string, groupID string) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	group, err := h.db.GetGroup(groupID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -98,6 +112,11 @@ func (h *Handler) UpdateGroup(ctx echo.Context, appIDorProductID string, groupID
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	// operators can only toggle the policies of the group, checked below
+	if _, replied := h.authorizeGroup(ctx, api.RoleOperator, groupID); replied {
+		return nil
+	}
+
 	var request codegen.GroupConfig
 	err = ctx.Bind(&request)
 	if err != nil {
@@ -116,6 +135,12 @@ func (h *Handler) UpdateGroup(ctx echo.Context, appIDorProductID string, groupID
 
 	group := groupFromRequest(request.Name, request.Description, request.PolicyMaxUpdatesPerPeriod, request.PolicyOfficeHours, request.PolicyPeriodInterval, request.PolicySafeMode, request.PolicyTimezone, request.PolicyUpdateTimeout, request.PolicyUpdatesEnabled, request.ChannelId, request.Track, groupID, appID)
 
+	if !policiesOnlyChange(oldGroup, group) {
+		if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: oldGroup.ApplicationID, groupID: groupID}); replied {
+			return nil
+		}
+	}
+
 	err = h.admin.UpdateGroup(group)
 	if err != nil {
 		l.Error().Err(err).Msgf("updateGroup - updating group %+v", request)
@@ -140,6 +165,7 @@ func (h *Handler) UpdateGroup(ctx echo.Context, appIDorProductID string, groupID
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetGroup, groupID, appID, oldGroup, group)
 	l.Info().Msgf("updateGroup - successfully updated group %+v -> %+v", oldGroup, group)
 
 	return ctx.JSON(http.StatusOK, group)
@@ -148,6 +174,10 @@ func (h *Handler) UpdateGroup(ctx echo.Context, appIDorProductID string, groupID
 func (h *Handler) DeleteGroup(ctx echo.Context, _ string, groupID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeGroup(ctx, api.RoleAdmin, groupID); replied {
+		return nil
+	}
+
 	group, err := h.db.GetGroup(groupID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -163,12 +193,17 @@ func (h *Handler) DeleteGroup(ctx echo.Context, _ string, groupID string) error
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetGroup, groupID, group.ApplicationID, group, nil)
 	l.Info().Msgf("deleteGroup - successfully deleted group %+v", group)
 
 	return ctx.NoContent(http.StatusNoContent)
 }
 
 func (h *Handler) GetGroupVersionTimeline(ctx echo.Context, _ string, groupID string, params codegen.GetGroupVersionTimelineParams) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	versionCountTimeline, isCache, err := h.db.GetGroupVersionCountTimeline(groupID, params.Duration)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -188,6 +223,10 @@ func (h *Handler) GetGroupVersionTimeline(ctx echo.Context, _ string, groupID st
 }
 
 func (h *Handler) GetGroupStatusTimeline(ctx echo.Context, _ string, groupID string, params codegen.GetGroupStatusTimelineParams) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	statusCountTimeline, err := h.db.GetGroupStatusCountTimeline(groupID, params.Duration)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -201,6 +240,10 @@ func (h *Handler) GetGroupStatusTimeline(ctx echo.Context, _ string, groupID str
 }
 
 func (h *Handler) GetGroupInstanceStats(ctx echo.Context, _ string, groupID string, params codegen.GetGroupInstanceStatsParams) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	instancesStats, err := h.db.GetGroupInstancesStats(groupID, params.Duration)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -214,6 +257,10 @@ func (h *Handler) GetGroupInstanceStats(ctx echo.Context, _ string, groupID stri
 }
 
 func (h *Handler) GetGroupVersionBreakdown(ctx echo.Context, _ string, groupID string) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	versionBreakdown, err := h.db.GetGroupVersionBreakdown(groupID)
 
 	if err != nil {
@@ -232,6 +279,10 @@ func (h *Handler) GetGroupVersionBreakdown(ctx echo.Context, _ string, groupID s
 }
 
 func (h *Handler) GetGroupInstances(ctx echo.Context, appIDorProductID string, groupID string, params codegen.GetGroupInstancesParams) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	appID, err := h.db.GetAppID(appIDorProductID)
 	if err != nil {
 		return appNotFoundResponse(ctx, appIDorProductID)
@@ -267,9 +318,13 @@ func (h *Handler) GetGroupInstances(ctx echo.Context, appIDorProductID string, g
 	if params.SearchValue != nil {
 		p.SearchValue = *params.SearchValue
 	}
+	setInstancesFilters(&p, params.IpCidr, params.Oem, params.AlephVersion, params.VersionRange, params.Labels, params.LastCheckAfter, params.LastCheckBefore)
 
 	groupInstances, err := h.db.GetInstances(p, params.Duration)
 	if err != nil {
+		if errors.Is(err, api.ErrInvalidInstancesFilter) {
+			return invalidInstancesFilterResponse(ctx, err)
+		}
 		l.Error().Err(err).Msgf("getInstances - getting instances params %v", p)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
@@ -278,6 +333,10 @@ func (h *Handler) GetGroupInstances(ctx echo.Context, appIDorProductID string, g
 }
 
 func (h *Handler) GetGroupInstancesCount(ctx echo.Context, appIDorProductID string, groupID string, params codegen.GetGroupInstancesCountParams) error {
+	if _, replied := h.authorizeGroup(ctx, api.RoleViewer, groupID); replied {
+		return nil
+	}
+
 	appID, err := h.db.GetAppID(appIDorProductID)
 	if err != nil {
 		return appNotFoundResponse(ctx, appIDorProductID)
@@ -297,6 +356,15 @@ func (h *Handler) GetGroupInstancesCount(ctx echo.Context, appIDorProductID stri
 	return ctx.JSON(http.StatusOK, codegen.InstanceCount{Count: uint64(count)})
 }
 
+// policiesOnlyChange reports whether the update of the group from before to
+// after only changes its policies, which operators are allowed to do.
+func policiesOnlyChange(before, after *api.Group) bool {
+	return before.Name == after.Name &&
+		before.Description == after.Description &&
+		before.ChannelID == after.ChannelID &&
+		before.Track == after.Track
+}
+
 func groupFromRequest(name string, description *string, policyMaxUpdatesPerPeriod int, policyOfficeHours *bool, policyPeriodInterval string, policySafeMode *bool, policyTimezone string, policyUpdateTimeout string, policyUpdatesEnabled *bool, channelID *string, track *string, groupID string, appID string) *api.Group {
 	group := &api.Group{
 		Name:                      name,
@@ -13,8 +13,11 @@ import (
 	"github.com/flatcar/nebraska/backend/pkg/auth"
 	"github.com/flatcar/nebraska/backend/pkg/codegen"
 	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/email"
 	"github.com/flatcar/nebraska/backend/pkg/logger"
 	"github.com/flatcar/nebraska/backend/pkg/omaha"
+	"github.com/flatcar/nebraska/backend/pkg/stream"
+	"github.com/flatcar/nebraska/backend/pkg/syncer"
 	"github.com/flatcar/nebraska/backend/pkg/version"
 )
 
@@ -30,6 +33,9 @@ type Handler struct {
 	conf         *config.Config
 	clientConf   *codegen.Config
 	auth         auth.Authenticator
+	syncer       *syncer.Syncer
+	stream       *stream.Broker
+	mailer       *email.Mailer
 }
 
 var defaultPage = 1
@@ -37,7 +43,7 @@ var defaultPerPage = 10
 
 var l = logger.New("nebraska")
 
-func New(db *api.API, adminSvc *admin.Service, conf *config.Config, auth auth.Authenticator) (*Handler, error) {
+func New(db *api.API, adminSvc *admin.Service, conf *config.Config, auth auth.Authenticator, syncer *syncer.Syncer, broker *stream.Broker) (*Handler, error) {
 	clientConfig := &codegen.Config{
 		AuthMode:        conf.AuthMode,
 		NebraskaVersion: version.Version,
@@ -84,7 +90,13 @@ func New(db *api.API, adminSvc *admin.Service, conf *config.Config, auth auth.Au
 		}
 	}
 
-	return &Handler{db, adminSvc, omaha.NewHandler(db), conf, clientConfig, auth}, nil
+	mailer, err := email.SetupMailer(conf)
+	if err != nil {
+		l.Error().Err(err).Msg("Invalid SMTP configuration")
+		return nil, err
+	}
+
+	return &Handler{db, adminSvc, omaha.NewHandler(db), conf, clientConfig, auth, syncer, broker, mailer}, nil
 }
 
 func (h *Handler) Health(ctx echo.Context) error {
@@ -2,10 +2,15 @@ package handler
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v4"
 
+	"github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/codegen"
 )
 
@@ -15,6 +20,10 @@ func (h *Handler) GetInstance(ctx echo.Context, appIDorProductID string, _ strin
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	instance, err := h.db.GetInstance(instanceID, appID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -36,6 +45,10 @@ func (h *Handler) GetInstanceStatusHistory(ctx echo.Context, appIDorProductID st
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	instanceStatusHistory, err := h.db.GetInstanceStatusHistory(instanceID, appID, groupID, uint64(limit))
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -48,9 +61,68 @@ func (h *Handler) GetInstanceStatusHistory(ctx echo.Context, appIDorProductID st
 	return ctx.JSON(http.StatusOK, instanceStatusHistory)
 }
 
+func (h *Handler) GetInstanceEvents(ctx echo.Context, appIDorProductID string, groupID string, instanceID string, params codegen.GetInstanceEventsParams) error {
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
+	p := api.InstanceEventsQueryParams{
+		InstanceID:    instanceID,
+		ApplicationID: appID,
+		GroupID:       groupID,
+	}
+	if params.Start != nil {
+		p.Start = *params.Start
+	}
+	if params.End != nil {
+		p.End = *params.End
+	}
+	if params.Start != nil && params.End != nil && !p.Start.Before(p.End) {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "invalid_time_range",
+			"description": "start must be before end",
+		})
+	}
+	if params.MinGap != nil {
+		p.MinGap, err = time.ParseDuration(*params.MinGap)
+		if err != nil || p.MinGap <= 0 {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_min_gap",
+				"description": fmt.Sprintf("minGap must be a positive duration, like 2h, got %q", *params.MinGap),
+			})
+		}
+	}
+	if params.Page != nil {
+		p.Page = uint64(*params.Page)
+	}
+	if params.Perpage != nil {
+		p.PerPage = uint64(*params.Perpage)
+	}
+
+	timeline, err := h.db.GetInstanceEvents(p)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("appID", appID).Str("instanceID", instanceID).Msgf("getInstanceEvents - getting events params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusOK, timeline)
+}
+
 func (h *Handler) UpdateInstance(ctx echo.Context, instanceID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if replied := h.authorizeInstance(ctx, api.RoleAdmin, instanceID); replied {
+		return nil
+	}
+
 	var request codegen.UpdateInstanceConfig
 
 	err := ctx.Bind(&request)
@@ -58,13 +130,317 @@ func (h *Handler) UpdateInstance(ctx echo.Context, instanceID string) error {
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
+	oldInstance, err := h.db.GetInstance(instanceID, "")
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("instance", instanceID).Msg("updateInstance - getting instance to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
 	instance, err := h.db.UpdateInstance(instanceID, request.Alias)
 	if err != nil {
 		l.Error().Err(err).Str("instance", instanceID).Msgf("updateInstance - updating params %s", request.Alias)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetInstance, instanceID, "",
+		map[string]any{"alias": oldInstance.Alias}, map[string]any{"alias": instance.Alias})
+
 	l.Info().Msgf("updateInstance - successfully updated instance %q alias to %q", instanceID, instance.Alias)
 
 	return ctx.JSON(http.StatusOK, instance)
 }
+
+func (h *Handler) DeleteInstance(ctx echo.Context, instanceID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeInstance(ctx, api.RoleAdmin, instanceID); replied {
+		return nil
+	}
+
+	instance, err := h.db.GetInstance(instanceID, "")
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("instance", instanceID).Msg("deleteInstance - getting instance to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	err = h.admin.DeleteInstance(instanceID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetInstance, instanceID, "", instance, nil)
+		l.Info().Str("instance", instanceID).Msg("deleteInstance - successfully deleted instance")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("instance", instanceID).Msg("deleteInstance - deleting instance")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) DeleteInstances(ctx echo.Context, appIDorProductID string, params codegen.DeleteInstancesParams) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	p := api.InstancesQueryParams{ApplicationID: appID}
+	if params.GroupID != nil {
+		p.GroupID = *params.GroupID
+	}
+	if params.Status != nil {
+		p.Status = *params.Status
+	}
+	if params.Version != nil {
+		p.Version = *params.Version
+	}
+	if params.SearchFilter != nil {
+		p.SearchFilter = *params.SearchFilter
+	}
+	if params.SearchValue != nil {
+		p.SearchValue = *params.SearchValue
+	}
+	setInstancesFilters(&p, params.IpCidr, params.Oem, params.AlephVersion, params.VersionRange, params.Labels, params.LastCheckAfter, params.LastCheckBefore)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: appID, groupID: p.GroupID}); replied {
+		return nil
+	}
+
+	// Deleting all the instances of an application at once is very likely
+	// a mistake, so some filter is required.
+	unfiltered := api.InstancesQueryParams{ApplicationID: appID, SearchFilter: p.SearchFilter}
+	if p == unfiltered {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "missing_filter",
+			"description": "at least one filter is required to delete instances",
+		})
+	}
+
+	duration := defaultExportDuration
+	if params.Duration != nil {
+		duration = *params.Duration
+	}
+
+	result := codegen.InstancesDeleted{DryRun: params.DryRun != nil && *params.DryRun}
+	if result.DryRun {
+		var count int
+		count, err = h.db.GetInstancesCount(p, duration)
+		result.Deleted = int64(count)
+	} else {
+		result.Deleted, err = h.admin.DeleteInstances(p, duration)
+	}
+	if err != nil {
+		if errors.Is(err, api.ErrInvalidInstancesFilter) {
+			return invalidInstancesFilterResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("appID", appID).Msgf("deleteInstances - deleting instances params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if !result.DryRun {
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetInstances, "", appID,
+			instancesChange{p, duration, result.Deleted}, nil)
+		l.Info().Str("appID", appID).Int64("instances", result.Deleted).Msgf("deleteInstances - successfully deleted instances params %v", p)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) SetInstanceGroupOverride(ctx echo.Context, appIDorProductID string, instanceID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	var request codegen.InstanceGroupOverrideConfig
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	groupID := ""
+	if request.GroupId != nil {
+		groupID = *request.GroupId
+	}
+
+	oldGroupID, err := h.db.GetInstanceGroupOverride(instanceID, appID)
+	if err != nil && err != sql.ErrNoRows {
+		l.Error().Err(err).Str("instance", instanceID).Str("appID", appID).Msg("setInstanceGroupOverride - getting current group assignment")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	// both the groups the instance is moved from and to must be managed
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: appID, groupID: oldGroupID}); replied {
+		return nil
+	}
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: appID, groupID: groupID}); replied {
+		return nil
+	}
+
+	err = h.admin.SetInstanceGroupOverride(instanceID, appID, groupID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetInstance, instanceID, appID,
+			map[string]any{"group_override": null.NewString(oldGroupID, oldGroupID != "")},
+			map[string]any{"group_override": null.NewString(groupID, groupID != "")})
+		l.Info().Str("instance", instanceID).Str("appID", appID).Str("groupID", groupID).Msg("setInstanceGroupOverride - successfully assigned instance to group")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrInvalidApplicationOrGroup:
+		return invalidGroupOverrideResponse(ctx, groupID)
+	case api.ErrInstanceArchMismatch:
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "arch_mismatch",
+			"description": fmt.Sprintf("the channel of group %q serves packages of another architecture than the instance", groupID),
+		})
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("instance", instanceID).Str("appID", appID).Msg("setInstanceGroupOverride - assigning instance to group")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) SetInstancesGroupOverride(ctx echo.Context, appIDorProductID string, params codegen.SetInstancesGroupOverrideParams) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	var request codegen.InstanceGroupOverrideConfig
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+	groupID := ""
+	if request.GroupId != nil {
+		groupID = *request.GroupId
+	}
+
+	p := api.InstancesQueryParams{ApplicationID: appID}
+	if params.GroupID != nil {
+		p.GroupID = *params.GroupID
+	}
+	if params.Status != nil {
+		p.Status = *params.Status
+	}
+	if params.Version != nil {
+		p.Version = *params.Version
+	}
+	if params.SearchFilter != nil {
+		p.SearchFilter = *params.SearchFilter
+	}
+	if params.SearchValue != nil {
+		p.SearchValue = *params.SearchValue
+	}
+	setInstancesFilters(&p, params.IpCidr, params.Oem, params.AlephVersion, params.VersionRange, params.Labels, params.LastCheckAfter, params.LastCheckBefore)
+
+	// both the groups the instances are moved from and to must be managed
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: appID, groupID: p.GroupID}); replied {
+		return nil
+	}
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{appID: appID, groupID: groupID}); replied {
+		return nil
+	}
+
+	// Moving all the instances of an application at once is very likely a
+	// mistake, so some filter is required.
+	unfiltered := api.InstancesQueryParams{ApplicationID: appID, SearchFilter: p.SearchFilter}
+	if p == unfiltered {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "missing_filter",
+			"description": "at least one filter is required to assign instances to a group",
+		})
+	}
+
+	duration := defaultExportDuration
+	if params.Duration != nil {
+		duration = *params.Duration
+	}
+
+	var result codegen.InstancesGroupOverridden
+	result.Updated, result.Skipped, err = h.admin.SetInstancesGroupOverride(p, duration, groupID)
+	if err != nil {
+		if errors.Is(err, api.ErrInvalidInstancesFilter) {
+			return invalidInstancesFilterResponse(ctx, err)
+		}
+		if errors.Is(err, api.ErrInvalidApplicationOrGroup) {
+			return invalidGroupOverrideResponse(ctx, groupID)
+		}
+		l.Error().Err(err).Str("appID", appID).Msgf("setInstancesGroupOverride - assigning instances to group params %v", p)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetInstances, "", appID,
+		nil, instancesGroupOverrideChange{instancesChange{p, duration, result.Updated}, null.NewString(groupID, groupID != "")})
+
+	l.Info().Str("appID", appID).Str("groupID", groupID).Int64("instances", result.Updated).Int64("skipped", result.Skipped).Msgf("setInstancesGroupOverride - successfully assigned instances to group params %v", p)
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) UpdateInstanceLabels(ctx echo.Context, instanceID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeInstance(ctx, api.RoleAdmin, instanceID); replied {
+		return nil
+	}
+
+	var request codegen.UpdateInstanceLabelsConfig
+
+	err := ctx.Bind(&request)
+	if err != nil {
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	oldInstance, err := h.db.GetInstance(instanceID, "")
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("instance", instanceID).Msg("updateInstanceLabels - getting instance to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	instance, err := h.db.UpdateInstanceLabels(instanceID, api.Labels(request.Labels))
+	if err != nil {
+		switch {
+		case errors.Is(err, api.ErrInvalidLabels):
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_labels",
+				"description": err.Error(),
+			})
+		case errors.Is(err, sql.ErrNoRows):
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("instance", instanceID).Msgf("updateInstanceLabels - updating labels %v", request.Labels)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetInstance, instanceID, "",
+		map[string]any{"labels": oldInstance.Labels}, map[string]any{"labels": instance.Labels})
+
+	l.Info().Msgf("updateInstanceLabels - successfully updated instance %q labels to %q", instanceID, instance.Labels.String())
+
+	return ctx.JSON(http.StatusOK, instance)
+}
+
+// instancesChange is the state recorded in the audit log for the changes
+// made to the instances matching a filter at once.
+type instancesChange struct {
+	Filters   api.InstancesQueryParams `json:"filters"`
+	Duration  string                   `json:"duration"`
+	Instances int64                    `json:"instances"`
+}
+
+type instancesGroupOverrideChange struct {
+	instancesChange
+	GroupOverride null.String `json:"group_override"`
+}
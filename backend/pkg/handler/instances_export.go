@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+const (
+	defaultExportDuration = "30d"
+
+	// exportFlushInterval is the number of instances written to the response
+	// between flushes.
+	exportFlushInterval = 1000
+)
+
+var instancesExportCSVHeader = []string{
+	"id", "ip", "alias", "oem", "aleph_version", "created_ts", "application_id", "group_id", "group_name",
+	"version", "status", "last_check_for_updates", "last_update_granted_ts", "last_update_version", "update_in_progress",
+	"labels",
+}
+
+// instancesExportWriter writes exported instances to a response in a given
+// format. The response is only started when the first instance is written,
+// so that errors happening before can still be reported with a proper status.
+type instancesExportWriter struct {
+	ctx      echo.Context
+	format   codegen.ExportInstancesParamsFormat
+	csv      *csv.Writer
+	json     *json.Encoder
+	started  bool
+	written  int
+	filename string
+}
+
+func (w *instancesExportWriter) start() error {
+	w.started = true
+	resp := w.ctx.Response()
+
+	switch w.format {
+	case codegen.Ndjson:
+		resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", w.filename+".ndjson"))
+		resp.WriteHeader(http.StatusOK)
+		w.json = json.NewEncoder(resp)
+	default:
+		resp.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", w.filename+".csv"))
+		resp.WriteHeader(http.StatusOK)
+		w.csv = csv.NewWriter(resp)
+		if err := w.csv.Write(instancesExportCSVHeader); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *instancesExportWriter) write(instance *api.InstanceExport) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if w.json != nil {
+		err = w.json.Encode(instance)
+	} else {
+		err = w.csv.Write(instanceExportCSVRecord(instance))
+	}
+	if err != nil {
+		return err
+	}
+
+	w.written++
+	if w.written%exportFlushInterval == 0 {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *instancesExportWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	w.ctx.Response().Flush()
+	return nil
+}
+
+func (w *instancesExportWriter) close() error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+func instanceExportCSVRecord(instance *api.InstanceExport) []string {
+	status := ""
+	if instance.Status.Valid {
+		status = strconv.FormatInt(instance.Status.Int64, 10)
+	}
+	lastUpdateGrantedTs := ""
+	if instance.LastUpdateGrantedTs.Valid {
+		lastUpdateGrantedTs = instance.LastUpdateGrantedTs.Time.UTC().Format(time.RFC3339)
+	}
+
+	return []string{
+		instance.ID,
+		instance.IP,
+		instance.Alias,
+		instance.OEM,
+		instance.AlephVersion,
+		instance.CreatedTs.UTC().Format(time.RFC3339),
+		instance.ApplicationID,
+		instance.GroupID.String,
+		instance.GroupName.String,
+		instance.Version,
+		status,
+		instance.LastCheckForUpdates.UTC().Format(time.RFC3339),
+		lastUpdateGrantedTs,
+		instance.LastUpdateVersion.String,
+		strconv.FormatBool(instance.UpdateInProgress),
+		instance.Labels.String(),
+	}
+}
+
+func (h *Handler) ExportInstances(ctx echo.Context, appIDorProductID string, params codegen.ExportInstancesParams) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
+	format := codegen.Csv
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != codegen.Csv && format != codegen.Ndjson {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{
+			"error":       "invalid_format",
+			"description": fmt.Sprintf("unsupported export format %q, expected csv or ndjson", format),
+		})
+	}
+
+	p := api.InstancesQueryParams{ApplicationID: appID}
+	if params.GroupID != nil {
+		p.GroupID = *params.GroupID
+	}
+	if params.Status != nil {
+		p.Status = *params.Status
+	}
+	if params.Version != nil {
+		p.Version = *params.Version
+	}
+	if params.SearchFilter != nil {
+		p.SearchFilter = *params.SearchFilter
+	}
+	if params.SearchValue != nil {
+		p.SearchValue = *params.SearchValue
+	}
+	setInstancesFilters(&p, params.IpCidr, params.Oem, params.AlephVersion, params.VersionRange, params.Labels, params.LastCheckAfter, params.LastCheckBefore)
+	duration := defaultExportDuration
+	if params.Duration != nil {
+		duration = *params.Duration
+	}
+
+	w := &instancesExportWriter{ctx: ctx, format: format, filename: "instances-" + appID}
+	err = h.db.ExportInstances(ctx.Request().Context(), p, duration, w.write)
+	if err == nil {
+		err = w.close()
+	}
+	if err != nil {
+		l.Error().Err(err).Str("appID", appID).Int("instances", w.written).Msgf("exportInstances - exporting instances params %v", p)
+		if !w.started {
+			if errors.Is(err, api.ErrInvalidInstancesFilter) {
+				return invalidInstancesFilterResponse(ctx, err)
+			}
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
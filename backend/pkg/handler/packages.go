@@ -25,6 +25,10 @@ func (h *Handler) PaginatePackages(ctx echo.Context, appIDorProductID string, pa
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(appID)); replied {
+		return nil
+	}
+
 	totalCount, err := h.db.GetPackagesCount(appID, params.SearchVersion)
 	if err != nil {
 		l.Error().Err(err).Str("appID", appID).Msg("getPackages count - encoding packages")
@@ -50,6 +54,10 @@ func (h *Handler) CreatePackage(ctx echo.Context, appIDorProductID string) error
 		return appNotFoundResponse(ctx, appIDorProductID)
 	}
 
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
 	var request codegen.PackageConfig
 
 	err = ctx.Bind(&request)
@@ -72,12 +80,17 @@ func (h *Handler) CreatePackage(ctx echo.Context, appIDorProductID string) error
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetPackage, pkg.ID, appID, nil, pkg)
 	l.Info().Msgf("addPackage - successfully added package %+v", pkg)
 
 	return ctx.JSON(http.StatusOK, pkg)
 }
 
 func (h *Handler) GetPackage(ctx echo.Context, _ string, packageID string) error {
+	if replied := h.authorizePackage(ctx, api.RoleViewer, packageID); replied {
+		return nil
+	}
+
 	pkg, err := h.db.GetPackage(packageID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -93,6 +106,10 @@ func (h *Handler) GetPackage(ctx echo.Context, _ string, packageID string) error
 func (h *Handler) UpdatePackage(ctx echo.Context, appIDorProductID string, packageID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if replied := h.authorizePackage(ctx, api.RoleAdmin, packageID); replied {
+		return nil
+	}
+
 	appID, err := h.db.GetAppID(appIDorProductID)
 	if err != nil {
 		return appNotFoundResponse(ctx, appIDorProductID)
@@ -129,6 +146,7 @@ func (h *Handler) UpdatePackage(ctx echo.Context, appIDorProductID string, packa
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetPackage, packageID, appID, oldPkg, pkg)
 	l.Info().Msgf("updatePackage - successfully updated package %+v -> %+v", oldPkg, pkg)
 
 	return ctx.JSON(http.StatusOK, pkg)
@@ -137,6 +155,10 @@ func (h *Handler) UpdatePackage(ctx echo.Context, appIDorProductID string, packa
 func (h *Handler) DeletePackage(ctx echo.Context, _ string, packageID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if replied := h.authorizePackage(ctx, api.RoleAdmin, packageID); replied {
+		return nil
+	}
+
 	pkg, err := h.db.GetPackage(packageID)
 	if err != nil {
 		l.Error().Err(err).Str("packageID", packageID).Msg("deletePackage - getting package to delete")
@@ -149,6 +171,7 @@ func (h *Handler) DeletePackage(ctx echo.Context, _ string, packageID string) er
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetPackage, packageID, pkg.ApplicationID, pkg, nil)
 	l.Info().Msgf("deletePackage - successfully deleted package %+v", pkg)
 
 	return ctx.NoContent(http.StatusNoContent)
@@ -237,6 +260,10 @@ type floorPackagesPage struct {
 func (h *Handler) PaginateChannelFloors(ctx echo.Context, channelID string, params codegen.PaginateChannelFloorsParams) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeChannel(ctx, api.RoleViewer, channelID); replied {
+		return nil
+	}
+
 	if params.Page == nil {
 		params.Page = &defaultPage
 	}
@@ -277,6 +304,10 @@ func (h *Handler) PaginateChannelFloors(ctx echo.Context, channelID string, para
 func (h *Handler) SetChannelFloor(ctx echo.Context, channelID string, packageID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeChannel(ctx, api.RoleAdmin, channelID); replied {
+		return nil
+	}
+
 	var request codegen.SetChannelFloorJSONRequestBody
 	if err := ctx.Bind(&request); err != nil {
 		l.Error().Err(err).Msg("SetChannelFloor - binding request")
@@ -289,6 +320,12 @@ func (h *Handler) SetChannelFloor(ctx echo.Context, channelID string, packageID
 		floorReason = null.StringFrom(*request.FloorReason)
 	}
 
+	oldFloor, err := h.getChannelFloor(channelID, packageID)
+	if err != nil {
+		l.Error().Err(err).Str("channelID", channelID).Str("packageID", packageID).Msg("SetChannelFloor - getting current floor")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
 	// Use AddChannelPackageFloor which already handles upsert via ON CONFLICT
 	if err := h.admin.AddChannelPackageFloor(channelID, packageID, floorReason); err != nil {
 		switch err {
@@ -304,6 +341,13 @@ func (h *Handler) SetChannelFloor(ctx echo.Context, channelID string, packageID
 		}
 	}
 
+	floor := &channelFloor{channelID, packageID, floorReason}
+	if oldFloor == nil {
+		h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetChannelFloor, channelID, h.channelAppID(channelID), nil, floor)
+	} else {
+		h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetChannelFloor, channelID, h.channelAppID(channelID), oldFloor, floor)
+	}
+
 	l.Info().Str("channelID", channelID).Str("packageID", packageID).Msg("SetChannelFloor - successfully set floor")
 
 	// Return JSON response
@@ -319,6 +363,16 @@ func (h *Handler) SetChannelFloor(ctx echo.Context, channelID string, packageID
 func (h *Handler) RemoveChannelFloor(ctx echo.Context, channelID string, packageID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if _, replied := h.authorizeChannel(ctx, api.RoleAdmin, channelID); replied {
+		return nil
+	}
+
+	oldFloor, err := h.getChannelFloor(channelID, packageID)
+	if err != nil {
+		l.Error().Err(err).Str("channelID", channelID).Str("packageID", packageID).Msg("RemoveChannelFloor - getting floor to remove")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
 	if err := h.admin.RemoveChannelPackageFloor(channelID, packageID); err != nil {
 		if err == api.ErrNoRowsAffected {
 			return ctx.NoContent(http.StatusNotFound)
@@ -327,6 +381,8 @@ func (h *Handler) RemoveChannelFloor(ctx echo.Context, channelID string, package
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetChannelFloor, channelID, h.channelAppID(channelID), oldFloor, nil)
+
 	l.Info().Str("channelID", channelID).Str("packageID", packageID).Msg("RemoveChannelFloor - successfully removed floor")
 	return ctx.NoContent(http.StatusNoContent)
 }
@@ -335,6 +391,10 @@ func (h *Handler) RemoveChannelFloor(ctx echo.Context, channelID string, package
 func (h *Handler) GetPackageFloorChannels(ctx echo.Context, _ string, packageID string) error {
 	l := loggerWithUsername(l, ctx)
 
+	if replied := h.authorizePackage(ctx, api.RoleViewer, packageID); replied {
+		return nil
+	}
+
 	// First verify the package exists
 	_, err := h.db.GetPackage(packageID)
 	if err != nil {
@@ -362,3 +422,35 @@ func (h *Handler) GetPackageFloorChannels(ctx echo.Context, _ string, packageID
 		Count:    len(channelInfos),
 	})
 }
+
+// channelFloor is the state of a channel floor recorded in the audit log.
+type channelFloor struct {
+	ChannelID   string      `json:"channel_id"`
+	PackageID   string      `json:"package_id"`
+	FloorReason null.String `json:"floor_reason"`
+}
+
+// getChannelFloor returns the floor of the channel provided for the package
+// provided, or nil if the package is not a floor of the channel.
+func (h *Handler) getChannelFloor(channelID, packageID string) (*channelFloor, error) {
+	floorChannels, err := h.db.GetPackageFloorChannels(packageID)
+	if err != nil {
+		return nil, err
+	}
+	for _, floorChannel := range floorChannels {
+		if floorChannel.Channel != nil && floorChannel.Channel.ID == channelID {
+			return &channelFloor{channelID, packageID, floorChannel.FloorReason}, nil
+		}
+	}
+	return nil, nil
+}
+
+// channelAppID returns the id of the application of the channel provided, or
+// an empty string if it can't be found.
+func (h *Handler) channelAppID(channelID string) string {
+	channel, err := h.db.GetChannel(channelID)
+	if err != nil {
+		return ""
+	}
+	return channel.ApplicationID
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+// roleScope is what a request acts on: an application as a whole, or one of
+// its groups or channels.
+type roleScope struct {
+	appID     string
+	groupID   string
+	channelID string
+}
+
+func appScope(appID string) roleScope {
+	return roleScope{appID: appID}
+}
+
+// globalRole returns the role of the user making the request on all the
+// applications, set by the authenticator: admin, viewer or none.
+func globalRole(ctx echo.Context) string {
+	if val, ok := ctx.Get("access_level").(string); ok {
+		return val
+	}
+	return ""
+}
+
+// roleSubjects returns the subjects identifying the user making the request
+// for the role bindings, set by the authenticator.
+func roleSubjects(ctx echo.Context) []string {
+	if val, ok := ctx.Get("role_subjects").([]string); ok {
+		return val
+	}
+	return nil
+}
+
+// roleBindingSubjectType returns the type of the subjects of the role
+// bindings matched in the auth mode in use, or empty if the auth mode doesn't
+// support role bindings.
+func (h *Handler) roleBindingSubjectType() string {
+	switch h.conf.AuthMode {
+	case "oidc":
+		return api.RoleBindingSubjectOIDCRole
+	case "github":
+		return api.RoleBindingSubjectGithubTeam
+	}
+	return ""
+}
+
+// subjectsRoleBindings returns the role bindings of the user making the
+// request on the application provided, or on every application when empty.
+func (h *Handler) subjectsRoleBindings(ctx echo.Context, appID string) ([]*api.RoleBinding, error) {
+	subjectType := h.roleBindingSubjectType()
+	subjects := roleSubjects(ctx)
+	if subjectType == "" || len(subjects) == 0 {
+		return nil, nil
+	}
+	return h.db.GetSubjectsRoleBindings(subjectType, subjects, appID)
+}
+
+// userRole returns the role of the user making the request on the scope
+// provided: the most privileged of its global role and of the roles bound to
+// it on the scope. Any role binding on an application, even restricted to one
+// of its groups or channels, lets the user view the whole application.
+func (h *Handler) userRole(ctx echo.Context, scope roleScope) (string, error) {
+	role := globalRole(ctx)
+	if role == api.RoleAdmin || scope.appID == "" {
+		return role, nil
+	}
+
+	bindings, err := h.subjectsRoleBindings(ctx, scope.appID)
+	if err != nil {
+		return "", err
+	}
+	for _, binding := range bindings {
+		bindingRole := api.RoleViewer
+		if binding.Applies(scope.groupID, scope.channelID) {
+			bindingRole = binding.Role
+		}
+		if !api.RoleIncludes(role, bindingRole) {
+			role = bindingRole
+		}
+	}
+	return role, nil
+}
+
+// authorize checks that the user making the request has at least the role
+// provided on the scope, replying with a 403 otherwise. An empty scope only
+// considers the global role of the user. The applications of other teams are
+// replied with a 404, so that teams don't learn about each other's
+// applications.
+func (h *Handler) authorize(ctx echo.Context, role string, scope roleScope) (replied bool) {
+	if scope.appID != "" {
+		teamID, err := h.db.GetAppTeamID(scope.appID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			l.Error().Err(err).Str("appID", scope.appID).Msg("authorize - getting team of application")
+			//nolint:errcheck
+			ctx.NoContent(http.StatusInternalServerError)
+			return true
+		}
+		if err != nil || teamID != getTeamID(ctx) {
+			//nolint:errcheck
+			ctx.NoContent(http.StatusNotFound)
+			return true
+		}
+	}
+
+	userRole, err := h.userRole(ctx, scope)
+	if err != nil {
+		l.Error().Err(err).Str("appID", scope.appID).Msg("authorize - getting role bindings")
+		//nolint:errcheck
+		ctx.NoContent(http.StatusInternalServerError)
+		return true
+	}
+	if !api.RoleIncludes(userRole, role) {
+		//nolint:errcheck
+		ctx.NoContent(http.StatusForbidden)
+		return true
+	}
+	return false
+}
+
+// authorizeDefaultTeam checks that the user making the request belongs to the
+// default team and has at least the global role provided, replying with a
+// 403 otherwise. It guards the operations on the whole Nebraska instance,
+// like managing the teams.
+func (h *Handler) authorizeDefaultTeam(ctx echo.Context, role string) (replied bool) {
+	defaultTeam, err := h.db.GetTeam()
+	if err != nil {
+		l.Error().Err(err).Msg("authorize - getting default team")
+		//nolint:errcheck
+		ctx.NoContent(http.StatusInternalServerError)
+		return true
+	}
+	if defaultTeam.ID != getTeamID(ctx) {
+		//nolint:errcheck
+		ctx.NoContent(http.StatusForbidden)
+		return true
+	}
+	return h.authorize(ctx, role, roleScope{})
+}
+
+// authorizeGroup checks that the user making the request has at least the
+// role provided on the group provided, replying with a 404 if the group
+// doesn't exist and a 403 if the user lacks the role. The id of the
+// application of the group is returned.
+func (h *Handler) authorizeGroup(ctx echo.Context, role, groupID string) (appID string, replied bool) {
+	appID, err := h.db.GetGroupAppID(groupID)
+	if err != nil {
+		return "", scopeNotFound(ctx, err, "group", groupID)
+	}
+	return appID, h.authorize(ctx, role, roleScope{appID: appID, groupID: groupID})
+}
+
+// authorizeChannel checks that the user making the request has at least the
+// role provided on the channel provided, replying with a 404 if the channel
+// doesn't exist and a 403 if the user lacks the role. The id of the
+// application of the channel is returned.
+func (h *Handler) authorizeChannel(ctx echo.Context, role, channelID string) (appID string, replied bool) {
+	appID, err := h.db.GetChannelAppID(channelID)
+	if err != nil {
+		return "", scopeNotFound(ctx, err, "channel", channelID)
+	}
+	return appID, h.authorize(ctx, role, roleScope{appID: appID, channelID: channelID})
+}
+
+// authorizePackage checks that the user making the request has at least the
+// role provided on the application of the package provided, replying with a
+// 404 if the package doesn't exist and a 403 if the user lacks the role.
+func (h *Handler) authorizePackage(ctx echo.Context, role, packageID string) (replied bool) {
+	appID, err := h.db.GetPackageAppID(packageID)
+	if err != nil {
+		return scopeNotFound(ctx, err, "package", packageID)
+	}
+	return h.authorize(ctx, role, appScope(appID))
+}
+
+// authorizeInstance checks that the user making the request has at least the
+// role provided on every application the instance provided reports to,
+// replying with a 403 otherwise. Instances reporting to no application need
+// the global role.
+func (h *Handler) authorizeInstance(ctx echo.Context, role, instanceID string) (replied bool) {
+	appIDs, err := h.db.GetInstanceAppIDs(instanceID)
+	if err != nil {
+		return scopeNotFound(ctx, err, "instance", instanceID)
+	}
+	if len(appIDs) == 0 {
+		return h.authorize(ctx, role, roleScope{})
+	}
+	for _, appID := range appIDs {
+		if replied := h.authorize(ctx, role, appScope(appID)); replied {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeNotFound replies with a 404 if the error provided means that the
+// entity the request acts on doesn't exist, with a 500 otherwise.
+func scopeNotFound(ctx echo.Context, err error, entity, id string) (replied bool) {
+	if errors.Is(err, sql.ErrNoRows) {
+		//nolint:errcheck
+		ctx.NoContent(http.StatusNotFound)
+		return true
+	}
+	l.Error().Err(err).Str(entity+"ID", id).Msgf("authorize - getting application of %s", entity)
+	//nolint:errcheck
+	ctx.NoContent(http.StatusInternalServerError)
+	return true
+}
+
+// visibleAppIDs returns the ids of the applications the user making the
+// request can view through its role bindings, or nil if it can view all of
+// them.
+func (h *Handler) visibleAppIDs(ctx echo.Context) ([]string, error) {
+	if api.RoleIncludes(globalRole(ctx), api.RoleViewer) {
+		return nil, nil
+	}
+	bindings, err := h.subjectsRoleBindings(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	appIDs := []string{}
+	seen := map[string]bool{}
+	for _, binding := range bindings {
+		if !seen[binding.ApplicationID] {
+			seen[binding.ApplicationID] = true
+			appIDs = append(appIDs, binding.ApplicationID)
+		}
+	}
+	return appIDs, nil
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+	"github.com/flatcar/nebraska/backend/pkg/retention"
+)
+
+func (h *Handler) RetentionDryRun(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+		return nil
+	}
+
+	policy, err := retention.PolicyFromConfig(h.conf)
+	if err != nil {
+		l.Error().Err(err).Msg("retentionDryRun - invalid retention policy")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	counts, err := retention.DryRun(h.db, policy)
+	if err != nil {
+		l.Error().Err(err).Msg("retentionDryRun - counting rows")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	dryRun := codegen.RetentionDryRun{Tables: make([]codegen.RetentionTableCount, 0, len(counts))}
+	for _, count := range counts {
+		tableCount := codegen.RetentionTableCount{
+			Table:   string(count.Table),
+			Enabled: count.Retention > 0,
+			Rows:    count.Rows,
+		}
+		if tableCount.Enabled {
+			tableCount.Retention = count.Retention.String()
+			tableCount.Cutoff = &count.Cutoff
+		}
+		dryRun.Tables = append(dryRun.Tables, tableCount)
+	}
+
+	return ctx.JSON(http.StatusOK, dryRun)
+}
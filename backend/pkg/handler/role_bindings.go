@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+type roleBindingsPage struct {
+	TotalCount   int                `json:"totalCount"`
+	Count        int                `json:"count"`
+	RoleBindings []*api.RoleBinding `json:"roleBindings"`
+}
+
+func (h *Handler) PaginateRoleBindings(ctx echo.Context, appIDorProductID string, params codegen.PaginateRoleBindingsParams) error {
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
+	totalCount, err := h.db.GetRoleBindingsCount(appID)
+	if err != nil {
+		l.Error().Err(err).Str("appID", appID).Msg("getRoleBindings count - getting role bindings")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	bindings, err := h.db.GetRoleBindings(appID, uint64(*params.Page), uint64(*params.Perpage))
+	if err != nil {
+		l.Error().Err(err).Str("appID", appID).Msg("getRoleBindings - getting role bindings")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if bindings == nil {
+		bindings = []*api.RoleBinding{}
+	}
+	return ctx.JSON(http.StatusOK, roleBindingsPage{totalCount, len(bindings), bindings})
+}
+
+func (h *Handler) CreateRoleBinding(ctx echo.Context, appIDorProductID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return appNotFoundResponse(ctx, appIDorProductID)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(appID)); replied {
+		return nil
+	}
+
+	var request codegen.RoleBindingConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addRoleBinding")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	binding := &api.RoleBinding{
+		ApplicationID: appID,
+		GroupID:       null.StringFromPtr(request.GroupId),
+		ChannelID:     null.StringFromPtr(request.ChannelId),
+		SubjectType:   string(request.SubjectType),
+		Subject:       request.Subject,
+		Role:          string(request.Role),
+		CreatedBy:     getUsername(ctx),
+	}
+	if _, err := h.admin.AddRoleBinding(binding); err != nil {
+		if errors.Is(err, api.ErrInvalidRoleBinding) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_role_binding",
+				"description": err.Error(),
+			})
+		}
+		l.Error().Err(err).Str("appID", appID).Msg("addRoleBinding")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetRoleBinding, binding.ID, appID, nil, binding)
+	l.Info().Str("binding", binding.ID).Msgf("addRoleBinding - successfully added role binding to app %s", appID)
+	return ctx.JSON(http.StatusOK, binding)
+}
+
+func (h *Handler) GetRoleBinding(ctx echo.Context, appIDorProductID string, bindingID string) error {
+	binding, err := h.getAppRoleBinding(appIDorProductID, bindingID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("bindingID", bindingID).Msg("getRoleBinding - getting role binding")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(binding.ApplicationID)); replied {
+		return nil
+	}
+
+	return ctx.JSON(http.StatusOK, binding)
+}
+
+func (h *Handler) DeleteRoleBinding(ctx echo.Context, appIDorProductID string, bindingID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	binding, err := h.getAppRoleBinding(appIDorProductID, bindingID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("bindingID", bindingID).Msg("deleteRoleBinding - getting role binding to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if replied := h.authorize(ctx, api.RoleAdmin, appScope(binding.ApplicationID)); replied {
+		return nil
+	}
+
+	err = h.admin.DeleteRoleBinding(bindingID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetRoleBinding, bindingID, binding.ApplicationID, binding, nil)
+		l.Info().Str("binding", bindingID).Msg("deleteRoleBinding - successfully deleted role binding")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("bindingID", bindingID).Msg("deleteRoleBinding - deleting role binding")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+// getAppRoleBinding returns the role binding identified by the id provided,
+// or sql.ErrNoRows if it doesn't belong to the application provided.
+func (h *Handler) getAppRoleBinding(appIDorProductID, bindingID string) (*api.RoleBinding, error) {
+	appID, err := h.db.GetAppID(appIDorProductID)
+	if err != nil {
+		return nil, sql.ErrNoRows
+	}
+	binding, err := h.db.GetRoleBinding(bindingID)
+	if err != nil {
+		return nil, err
+	}
+	if binding.ApplicationID != appID {
+		return nil, sql.ErrNoRows
+	}
+	return binding, nil
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+	"github.com/flatcar/nebraska/backend/pkg/stream"
+)
+
+// streamKeepAliveInterval is the interval between the comments sent to the
+// events stream clients, so that idle connections are not closed by proxies.
+const streamKeepAliveInterval = 30 * time.Second
+
+func (h *Handler) StreamEvents(ctx echo.Context, params codegen.StreamEventsParams) error {
+	if h.stream == nil {
+		return ctx.NoContent(http.StatusServiceUnavailable)
+	}
+
+	filter := stream.Filter{TeamID: getTeamID(ctx)}
+	if params.AppIDorProductID != nil {
+		appID, err := h.db.GetAppID(*params.AppIDorProductID)
+		if err != nil {
+			return appNotFoundResponse(ctx, *params.AppIDorProductID)
+		}
+		filter.AppID = appID
+	}
+	scopeAppID := filter.AppID
+	if params.GroupID != nil {
+		group, err := h.db.GetGroup(*params.GroupID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ctx.NoContent(http.StatusNotFound)
+			}
+			l.Error().Err(err).Str("groupID", *params.GroupID).Msg("streamEvents - getting group")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		teamID, err := h.db.GetAppTeamID(group.ApplicationID)
+		if err != nil {
+			l.Error().Err(err).Str("groupID", group.ID).Msg("streamEvents - getting group team")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		if teamID != filter.TeamID || (filter.AppID != "" && filter.AppID != group.ApplicationID) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		filter.GroupID = group.ID
+		scopeAppID = group.ApplicationID
+	}
+
+	if replied := h.authorize(ctx, api.RoleViewer, appScope(scopeAppID)); replied {
+		return nil
+	}
+	if params.Duration != nil {
+		if !api.IsValidDuration(string(*params.Duration)) {
+			return ctx.JSON(http.StatusBadRequest, map[string]any{
+				"error":       "invalid_duration",
+				"description": fmt.Sprintf("The duration %q is not one of 1h, 1d, 7d or 30d.", *params.Duration),
+			})
+		}
+		filter.Duration = string(*params.Duration)
+	}
+
+	events, unsubscribe := h.stream.Subscribe(filter)
+	defer unsubscribe()
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Disable the response buffering of nginx reverse proxies.
+	resp.Header().Set("X-Accel-Buffering", "no")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprint(resp, ": connected\n\n"); err != nil {
+		return nil
+	}
+	resp.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case event := <-events:
+			_, err = fmt.Fprintf(resp, "event: %s\ndata: %s\n\n", event.Type, event.Data)
+		case <-keepAlive.C:
+			_, err = fmt.Fprint(resp, ": keep-alive\n\n")
+		}
+		if err != nil {
+			return nil
+		}
+		resp.Flush()
+	}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+// syncerRunChange is the state recorded in the audit log for the syncer runs
+// requested through the API.
+type syncerRunChange struct {
+	Queued bool `json:"queued"`
+}
+
+func syncerNotEnabledResponse(ctx echo.Context) error {
+	return ctx.JSON(http.StatusNotFound, map[string]any{
+		"error":       "syncer_not_enabled",
+		"description": "The Flatcar updates syncer is not enabled in this Nebraska instance.",
+	})
+}
+
+func (h *Handler) GetSyncerStatus(ctx echo.Context) error {
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleViewer); replied {
+		return nil
+	}
+
+	if h.syncer == nil {
+		return syncerNotEnabledResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, h.syncer.Status())
+}
+
+func (h *Handler) RunSyncer(ctx echo.Context) error {
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+		return nil
+	}
+
+	if h.syncer == nil {
+		return syncerNotEnabledResponse(ctx)
+	}
+
+	l := loggerWithUsername(l, ctx)
+
+	if !h.syncer.TriggerSync() {
+		l.Debug().Msg("runSyncer - syncer run already queued")
+		return ctx.NoContent(http.StatusConflict)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetSyncer, "", "", nil, syncerRunChange{Queued: true})
+
+	l.Info().Msg("runSyncer - syncer run queued")
+	return ctx.NoContent(http.StatusAccepted)
+}
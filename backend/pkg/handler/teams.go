@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func (h *Handler) GetTeams(ctx echo.Context) error {
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleViewer); replied {
+		return nil
+	}
+
+	teams, err := h.db.GetTeams()
+	if err != nil {
+		l.Error().Err(err).Msg("getTeams - getting teams")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if teams == nil {
+		teams = []*api.Team{}
+	}
+	return ctx.JSON(http.StatusOK, teams)
+}
+
+func (h *Handler) CreateTeam(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+		return nil
+	}
+
+	var request codegen.TeamConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addTeam")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	team := newTeam(request)
+	if _, err := h.admin.AddTeam(team); err != nil {
+		if errors.Is(err, api.ErrInvalidTeam) {
+			return invalidTeamResponse(ctx, err)
+		}
+		l.Error().Err(err).Msg("addTeam")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetTeam, team.ID, "", nil, team)
+	l.Info().Str("team", team.ID).Msgf("addTeam - successfully added team %s", team.Name)
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) GetTeam(ctx echo.Context, teamID string) error {
+	// the members of a team can view it, the members of the default team
+	// can view all of them
+	if teamID != getTeamID(ctx) {
+		if replied := h.authorizeDefaultTeam(ctx, api.RoleViewer); replied {
+			return nil
+		}
+	}
+
+	team, err := h.db.GetTeamByID(teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("teamID", teamID).Msg("getTeam - getting team")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) UpdateTeam(ctx echo.Context, teamID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+		return nil
+	}
+
+	var request codegen.TeamConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("updateTeam")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	oldTeam, err := h.db.GetTeamByID(teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("teamID", teamID).Msg("updateTeam - getting team to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	team := newTeam(request)
+	team.ID = teamID
+	if err := h.admin.UpdateTeam(team); err != nil {
+		if errors.Is(err, api.ErrInvalidTeam) {
+			return invalidTeamResponse(ctx, err)
+		}
+		if err == api.ErrNoRowsAffected {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("teamID", teamID).Msg("updateTeam - updating team")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	team, err = h.db.GetTeamByID(teamID)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("updateTeam - getting updated team")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetTeam, teamID, "", oldTeam, team)
+	l.Info().Str("team", teamID).Msg("updateTeam - successfully updated team")
+	return ctx.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) DeleteTeam(ctx echo.Context, teamID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+		return nil
+	}
+
+	team, err := h.db.GetTeamByID(teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("teamID", teamID).Msg("deleteTeam - getting team to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	err = h.admin.DeleteTeam(teamID)
+	switch {
+	case err == nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetTeam, teamID, "", team, nil)
+		l.Info().Str("team", teamID).Msg("deleteTeam - successfully deleted team")
+		return ctx.NoContent(http.StatusNoContent)
+	case errors.Is(err, api.ErrInvalidTeam):
+		return invalidTeamResponse(ctx, err)
+	case err == api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("teamID", teamID).Msg("deleteTeam - deleting team")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+func newTeam(request codegen.TeamConfig) *api.Team {
+	team := &api.Team{Name: request.Name}
+	if request.OidcClaims != nil {
+		team.OIDCClaims = pq.StringArray(*request.OidcClaims)
+	}
+	if request.GithubOrgs != nil {
+		team.GithubOrgs = pq.StringArray(*request.GithubOrgs)
+	}
+	return team
+}
+
+func invalidTeamResponse(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_team",
+		"description": err.Error(),
+	})
+}
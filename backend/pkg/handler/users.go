@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func (h *Handler) GetUsers(ctx echo.Context) error {
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	users, err := h.db.GetUsersInTeam(getTeamID(ctx))
+	if err != nil {
+		l.Error().Err(err).Msg("getUsers - getting users")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if users == nil {
+		users = []*api.User{}
+	}
+	return ctx.JSON(http.StatusOK, users)
+}
+
+func (h *Handler) CreateUser(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	var request codegen.UserConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addUser")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	teamID := getTeamID(ctx)
+	if request.TeamId != nil && *request.TeamId != teamID {
+		if replied := h.authorizeDefaultTeam(ctx, api.RoleAdmin); replied {
+			return nil
+		}
+		if _, err := h.db.GetTeamByID(*request.TeamId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return invalidUserResponse(ctx, fmt.Errorf("%w: the team doesn't exist", api.ErrInvalidUser))
+			}
+			l.Error().Err(err).Str("teamID", *request.TeamId).Msg("addUser - getting team")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		teamID = *request.TeamId
+	}
+
+	secret, err := h.admin.GenerateUserSecret(request.Password)
+	if err != nil {
+		if errors.Is(err, api.ErrInvalidUser) {
+			return invalidUserResponse(ctx, err)
+		}
+		l.Error().Err(err).Msg("addUser - hashing password")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	user := &api.User{
+		Username: request.Username,
+		Secret:   secret,
+		Role:     string(request.Role),
+		TeamID:   teamID,
+	}
+	if _, err := h.admin.AddUser(user); err != nil {
+		if errors.Is(err, api.ErrInvalidUser) {
+			return invalidUserResponse(ctx, err)
+		}
+		l.Error().Err(err).Msg("addUser")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetUser, user.ID, "", nil, user)
+	l.Info().Str("user", user.ID).Msgf("addUser - successfully added user %s", user.Username)
+	return ctx.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) GetUser(ctx echo.Context, userID string) error {
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	user, err := h.getManagedUser(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("userID", userID).Msg("getUser - getting user")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	return ctx.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) UpdateUser(ctx echo.Context, userID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	var request codegen.UserUpdateConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("updateUser")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	oldUser, err := h.getManagedUser(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("userID", userID).Msg("updateUser - getting user to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if request.Role != nil && string(*request.Role) != oldUser.Role {
+		if err := h.checkNotLastAdmin(oldUser); err != nil {
+			if errors.Is(err, api.ErrInvalidUser) {
+				return invalidUserResponse(ctx, err)
+			}
+			l.Error().Err(err).Str("userID", userID).Msg("updateUser - counting admins")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		user := *oldUser
+		user.Role = string(*request.Role)
+		if err := h.admin.UpdateUser(&user); err != nil {
+			if errors.Is(err, api.ErrInvalidUser) {
+				return invalidUserResponse(ctx, err)
+			}
+			if err == api.ErrNoRowsAffected {
+				return ctx.NoContent(http.StatusNotFound)
+			}
+			l.Error().Err(err).Str("userID", userID).Msg("updateUser - updating role")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	if request.Password != nil {
+		if err := h.admin.UpdateUserPassword(oldUser.Username, *request.Password); err != nil {
+			if errors.Is(err, api.ErrInvalidUser) {
+				return invalidUserResponse(ctx, err)
+			}
+			if err == api.ErrUpdatingPassword {
+				return ctx.NoContent(http.StatusNotFound)
+			}
+			l.Error().Err(err).Str("userID", userID).Msg("updateUser - updating password")
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	user, err := h.db.GetUserByID(userID)
+	if err != nil {
+		l.Error().Err(err).Str("userID", userID).Msg("updateUser - getting updated user")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetUser, userID, "", oldUser, user)
+	l.Info().Str("user", userID).Msg("updateUser - successfully updated user")
+	return ctx.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) DeleteUser(ctx echo.Context, userID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	user, err := h.getManagedUser(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("userID", userID).Msg("deleteUser - getting user to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if err := h.checkNotLastAdmin(user); err != nil {
+		if errors.Is(err, api.ErrInvalidUser) {
+			return invalidUserResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("userID", userID).Msg("deleteUser - counting admins")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	err = h.admin.DeleteUser(userID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetUser, userID, "", user, nil)
+		l.Info().Str("user", userID).Msg("deleteUser - successfully deleted user")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("userID", userID).Msg("deleteUser - deleting user")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+// getManagedUser returns the user identified by the id provided, or
+// sql.ErrNoRows if it doesn't belong to the team of the user making the
+// request. The members of the default team manage the users of all the
+// teams.
+func (h *Handler) getManagedUser(ctx echo.Context, userID string) (*api.User, error) {
+	user, err := h.db.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TeamID == getTeamID(ctx) {
+		return user, nil
+	}
+	defaultTeam, err := h.db.GetTeam()
+	if err != nil {
+		return nil, err
+	}
+	if defaultTeam.ID != getTeamID(ctx) {
+		return nil, sql.ErrNoRows
+	}
+	return user, nil
+}
+
+// checkNotLastAdmin fails with ErrInvalidUser if the user provided is the
+// last admin of its team, so that the team isn't left without users able to
+// manage it.
+func (h *Handler) checkNotLastAdmin(user *api.User) error {
+	if user.Role != api.UserRoleAdmin {
+		return nil
+	}
+	count, err := h.db.GetTeamAdminsCount(user.TeamID)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return fmt.Errorf("%w: the last admin of a team can't be removed", api.ErrInvalidUser)
+	}
+	return nil
+}
+
+func invalidUserResponse(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_user",
+		"description": err.Error(),
+	})
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/flatcar/nebraska/backend/pkg/api"
 	echosessions "github.com/flatcar/nebraska/backend/pkg/sessions/echo"
 
 	"github.com/labstack/echo/v4"
@@ -17,15 +19,29 @@ func getTeamID(c echo.Context) string {
 	return ""
 }
 
-func loggerWithUsername(_ zerolog.Logger, ctx echo.Context) zerolog.Logger {
-	session := echosessions.GetSession(ctx)
+// getUsername returns the identity of the user making the request: the one
+// set by the authenticator for bearer tokens, or the one of the session.
+func getUsername(c echo.Context) string {
+	if val, ok := c.Get("username").(string); ok {
+		return val
+	}
+	session := echosessions.GetSession(c)
 	if session == nil {
-		return l
+		return ""
 	}
+	if val, ok := session.Get("username").(string); ok {
+		return val
+	}
+	return ""
+}
 
-	username := session.Get("username")
+func loggerWithUsername(_ zerolog.Logger, ctx echo.Context) zerolog.Logger {
+	username := getUsername(ctx)
+	if username == "" {
+		return l
+	}
 
-	return l.With().Str("username", username.(string)).Logger()
+	return l.With().Str("username", username).Logger()
 }
 
 func appNotFoundResponse(ctx echo.Context, appIDProductID string) error {
@@ -33,3 +49,43 @@ func appNotFoundResponse(ctx echo.Context, appIDProductID string) error {
 		"message": fmt.Sprintf("App not found for :%s", appIDProductID),
 	})
 }
+
+func invalidGroupOverrideResponse(ctx echo.Context, groupID string) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_group",
+		"description": fmt.Sprintf("group %q does not exist in the application", groupID),
+	})
+}
+
+func invalidInstancesFilterResponse(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_filter",
+		"description": err.Error(),
+	})
+}
+
+// setInstancesFilters sets the structured filters provided as query
+// parameters in the instances query parameters.
+func setInstancesFilters(p *api.InstancesQueryParams, ipCidr, oem, alephVersion, versionRange, labels *string, lastCheckAfter, lastCheckBefore *time.Time) {
+	if ipCidr != nil {
+		p.IPCIDR = *ipCidr
+	}
+	if oem != nil {
+		p.OEM = *oem
+	}
+	if alephVersion != nil {
+		p.AlephVersionRange = *alephVersion
+	}
+	if versionRange != nil {
+		p.VersionRange = *versionRange
+	}
+	if labels != nil {
+		p.Labels = *labels
+	}
+	if lastCheckAfter != nil {
+		p.LastCheckAfter = *lastCheckAfter
+	}
+	if lastCheckBefore != nil {
+		p.LastCheckBefore = *lastCheckBefore
+	}
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+type webhooksPage struct {
+	TotalCount int            `json:"totalCount"`
+	Count      int            `json:"count"`
+	Webhooks   []*api.Webhook `json:"webhooks"`
+}
+
+type webhookDeliveriesPage struct {
+	TotalCount int                    `json:"totalCount"`
+	Count      int                    `json:"count"`
+	Deliveries []*api.WebhookDelivery `json:"deliveries"`
+}
+
+// createdWebhook is the response to the creation of a webhook, the only one
+// including its secret.
+type createdWebhook struct {
+	*api.Webhook
+	Secret string `json:"secret"`
+}
+
+func (h *Handler) PaginateWebhooks(ctx echo.Context, params codegen.PaginateWebhooksParams) error {
+	if replied := h.authorize(ctx, api.RoleViewer, roleScope{}); replied {
+		return nil
+	}
+
+	teamID := getTeamID(ctx)
+
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	totalCount, err := h.db.GetWebhooksCount(teamID)
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("getWebhooks count - getting webhooks")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	webhooks, err := h.db.GetWebhooks(teamID, uint64(*params.Page), uint64(*params.Perpage))
+	if err != nil {
+		l.Error().Err(err).Str("teamID", teamID).Msg("getWebhooks - getting webhooks")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if webhooks == nil {
+		webhooks = []*api.Webhook{}
+	}
+	return ctx.JSON(http.StatusOK, webhooksPage{totalCount, len(webhooks), webhooks})
+}
+
+func (h *Handler) CreateWebhook(ctx echo.Context) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	var request codegen.WebhookConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("addWebhook")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	webhook := newWebhook(getTeamID(ctx), request)
+	if _, err := h.admin.AddWebhook(webhook); err != nil {
+		if isInvalidWebhookError(err) {
+			return invalidWebhookResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("name", webhook.Name).Msg("addWebhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionCreate, api.AuditTargetWebhook, webhook.ID, webhook.ApplicationID.String, nil, webhook)
+	l.Info().Str("webhook", webhook.ID).Msgf("addWebhook - successfully added webhook %q", webhook.Name)
+	return ctx.JSON(http.StatusOK, createdWebhook{webhook, webhook.Secret})
+}
+
+func (h *Handler) GetWebhook(ctx echo.Context, webhookID string) error {
+	if replied := h.authorize(ctx, api.RoleViewer, roleScope{}); replied {
+		return nil
+	}
+
+	webhook, err := h.getTeamWebhook(ctx, webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("getWebhook - getting webhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (h *Handler) UpdateWebhook(ctx echo.Context, webhookID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	var request codegen.WebhookConfig
+	if err := ctx.Bind(&request); err != nil {
+		l.Error().Err(err).Msg("updateWebhook")
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	oldWebhook, err := h.getTeamWebhook(ctx, webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("updateWebhook - getting webhook to update")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	webhook := newWebhook(getTeamID(ctx), request)
+	webhook.ID = webhookID
+	if err := h.admin.UpdateWebhook(webhook); err != nil {
+		if isInvalidWebhookError(err) {
+			return invalidWebhookResponse(ctx, err)
+		}
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("updateWebhook - updating webhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	webhook, err = h.db.GetWebhook(webhookID)
+	if err != nil {
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("updateWebhook - getting updated webhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	h.recordAudit(ctx, api.AuditActionUpdate, api.AuditTargetWebhook, webhookID, webhook.ApplicationID.String, oldWebhook, webhook)
+	l.Info().Str("webhook", webhookID).Msg("updateWebhook - successfully updated webhook")
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (h *Handler) DeleteWebhook(ctx echo.Context, webhookID string) error {
+	l := loggerWithUsername(l, ctx)
+
+	if replied := h.authorize(ctx, api.RoleAdmin, roleScope{}); replied {
+		return nil
+	}
+
+	webhook, err := h.getTeamWebhook(ctx, webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("deleteWebhook - getting webhook to delete")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	err = h.admin.DeleteWebhook(webhookID)
+	switch err {
+	case nil:
+		h.recordAudit(ctx, api.AuditActionDelete, api.AuditTargetWebhook, webhookID, webhook.ApplicationID.String, webhook, nil)
+		l.Info().Str("webhook", webhookID).Msg("deleteWebhook - successfully deleted webhook")
+		return ctx.NoContent(http.StatusNoContent)
+	case api.ErrNoRowsAffected:
+		return ctx.NoContent(http.StatusNotFound)
+	default:
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("deleteWebhook - deleting webhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) PaginateWebhookDeliveries(ctx echo.Context, webhookID string, params codegen.PaginateWebhookDeliveriesParams) error {
+	if replied := h.authorize(ctx, api.RoleViewer, roleScope{}); replied {
+		return nil
+	}
+
+	if _, err := h.getTeamWebhook(ctx, webhookID); err != nil {
+		if err == sql.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("getWebhookDeliveries - getting webhook")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if params.Page == nil {
+		params.Page = &defaultPage
+	}
+
+	if params.Perpage == nil {
+		params.Perpage = &defaultPerPage
+	}
+
+	totalCount, err := h.db.GetWebhookDeliveriesCount(webhookID)
+	if err != nil {
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("getWebhookDeliveries count - getting deliveries")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	deliveries, err := h.db.GetWebhookDeliveries(webhookID, uint64(*params.Page), uint64(*params.Perpage))
+	if err != nil {
+		l.Error().Err(err).Str("webhookID", webhookID).Msg("getWebhookDeliveries - getting deliveries")
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if deliveries == nil {
+		deliveries = []*api.WebhookDelivery{}
+	}
+	return ctx.JSON(http.StatusOK, webhookDeliveriesPage{totalCount, len(deliveries), deliveries})
+}
+
+// getTeamWebhook returns the webhook identified by the id provided, or
+// sql.ErrNoRows if it doesn't belong to the team of the request.
+func (h *Handler) getTeamWebhook(ctx echo.Context, webhookID string) (*api.Webhook, error) {
+	webhook, err := h.db.GetWebhook(webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.TeamID != getTeamID(ctx) {
+		return nil, sql.ErrNoRows
+	}
+	return webhook, nil
+}
+
+func newWebhook(teamID string, request codegen.WebhookConfig) *api.Webhook {
+	webhook := &api.Webhook{
+		Name:          request.Name,
+		URL:           request.Url,
+		TeamID:        teamID,
+		ApplicationID: nonEmptyNullString(request.ApplicationId),
+		GroupID:       nonEmptyNullString(request.GroupId),
+		Enabled:       true,
+	}
+	if request.Secret != nil {
+		webhook.Secret = *request.Secret
+	}
+	if request.Classes != nil {
+		webhook.Classes = pq.Int64Array(*request.Classes)
+	}
+	if request.Severities != nil {
+		webhook.Severities = pq.Int64Array(*request.Severities)
+	}
+	if request.Enabled != nil {
+		webhook.Enabled = *request.Enabled
+	}
+	return webhook
+}
+
+// nonEmptyNullString returns a null string when the value provided is nil or
+// empty.
+func nonEmptyNullString(value *string) null.String {
+	if value == nil {
+		return null.String{}
+	}
+	return null.NewString(*value, *value != "")
+}
+
+func isInvalidWebhookError(err error) bool {
+	return errors.Is(err, api.ErrInvalidWebhook) || errors.Is(err, api.ErrInvalidApplicationOrGroup)
+}
+
+func invalidWebhookResponse(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusBadRequest, map[string]any{
+		"error":       "invalid_webhook",
+		"description": err.Error(),
+	})
+}
@@ -3,12 +3,15 @@ package metrics
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/logger"
+	"github.com/flatcar/nebraska/backend/pkg/retention"
+	"github.com/flatcar/nebraska/backend/pkg/syncer"
 )
 
 const (
@@ -29,6 +32,19 @@ var (
 		},
 	)
 
+	appInstancePerLabelGaugeMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "application_instances_per_label",
+			Help:      "Number of instances of an application per value of an instance label",
+		},
+		[]string{
+			"application",
+			"key",
+			"value",
+		},
+	)
+
 	failedUpdatesGaugeMetric = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "nebraska",
@@ -64,6 +80,89 @@ var (
 		},
 	)
 
+	syncerLastCheckGaugeMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "syncer_last_check_timestamp_seconds",
+			Help:      "Unix time of the last syncer check for updates of a channel",
+		},
+		[]string{
+			"channel",
+			"arch",
+		},
+	)
+
+	syncerLastErrorGaugeMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "syncer_last_check_failed",
+			Help:      "Whether the last syncer check for updates of a channel failed (1) or not (0)",
+		},
+		[]string{
+			"channel",
+			"arch",
+		},
+	)
+
+	syncerUpstreamVersionGaugeMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "syncer_upstream_version_info",
+			Help:      "Last version seen upstream by the syncer for a channel",
+		},
+		[]string{
+			"channel",
+			"arch",
+			"version",
+		},
+	)
+
+	syncerNextCheckGaugeMetric = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "syncer_next_check_timestamp_seconds",
+			Help:      "Unix time of the next scheduled syncer check for updates",
+		},
+	)
+
+	syncerUpstreamHealthyGaugeMetric = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "syncer_upstream_healthy",
+			Help:      "Whether a syncer upstream update URL is healthy (1) or backing off after failures (0)",
+		},
+		[]string{
+			"url",
+		},
+	)
+
+	retentionLastRunGaugeMetric = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "retention_last_run_timestamp_seconds",
+			Help:      "Unix time of the last run of the retention pruner",
+		},
+	)
+
+	retentionLastRunFailedGaugeMetric = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "nebraska",
+			Name:      "retention_last_run_failed",
+			Help:      "Whether the last run of the retention pruner failed (1) or not (0)",
+		},
+	)
+
+	retentionPrunedRowsCounterMetric = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "nebraska",
+			Name:      "retention_pruned_rows_total",
+			Help:      "Number of rows of a table removed by the retention pruner",
+		},
+		[]string{
+			"table",
+		},
+	)
+
 	l = logger.New("nebraska")
 )
 
@@ -71,10 +170,19 @@ var (
 func registerNebraskaMetrics() error {
 	collectors := []prometheus.Collector{
 		appInstancePerChannelGaugeMetric,
+		appInstancePerLabelGaugeMetric,
 		failedUpdatesGaugeMetric,
 		openConnections,
 		inUseConnections,
 		idleConnections,
+		syncerLastCheckGaugeMetric,
+		syncerLastErrorGaugeMetric,
+		syncerUpstreamVersionGaugeMetric,
+		syncerNextCheckGaugeMetric,
+		syncerUpstreamHealthyGaugeMetric,
+		retentionLastRunGaugeMetric,
+		retentionLastRunFailedGaugeMetric,
+		retentionPrunedRowsCounterMetric,
 	}
 
 	for _, collector := range collectors {
@@ -103,22 +211,43 @@ func getMetricsRefreshInterval() time.Duration {
 	return refreshInterval
 }
 
+// getMetricsLabelKeys returns the instance label keys set in the environment,
+// NEBRASKA_METRICS_LABEL_KEYS, as a comma separated list. Instances are only
+// broken down by these labels, as every distinct value adds a time series.
+func getMetricsLabelKeys() []string {
+	var keys []string
+	for _, key := range strings.Split(os.Getenv("NEBRASKA_METRICS_LABEL_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 // registerAndInstrumentMetrics registers the application metrics and instruments them in configurable intervals.
-func RegisterAndInstrument(api *api.API) error {
+// The syncer and the pruner are optional and can be nil when they're not enabled.
+func RegisterAndInstrument(api *api.API, syncer *syncer.Syncer, pruner *retention.Pruner) error {
 	// register application metrics
 	err := registerNebraskaMetrics()
 	if err != nil {
 		return err
 	}
 
+	if pruner != nil {
+		pruner.OnRun(countPrunedRows)
+	}
+
 	refreshInterval := getMetricsRefreshInterval()
+	labelKeys := getMetricsLabelKeys()
 
 	metricsTicker := time.NewTicker(refreshInterval)
 
 	go func() {
 		for {
 			<-metricsTicker.C
-			err := calculateMetrics(api)
+			calculateSyncerMetrics(syncer)
+			calculateRetentionMetrics(pruner)
+			err := calculateMetrics(api, labelKeys)
 			if err != nil {
 				l.Error().Err(err).Msg("registerAndInstrumentMetrics updating the metrics")
 			}
@@ -129,7 +258,7 @@ func RegisterAndInstrument(api *api.API) error {
 }
 
 // calculateMetrics calculates the application metrics and updates the respective metric.
-func calculateMetrics(api *api.API) error {
+func calculateMetrics(api *api.API, labelKeys []string) error {
 	aipcMetrics, err := api.GetAppInstancesPerChannelMetrics()
 	if err != nil {
 		return fmt.Errorf("failed to get app instances per channel metrics: %w", err)
@@ -139,6 +268,17 @@ func calculateMetrics(api *api.API) error {
 		appInstancePerChannelGaugeMetric.WithLabelValues(metric.ApplicationName, metric.Version, metric.ChannelName).Set(float64(metric.InstancesCount))
 	}
 
+	aiplMetrics, err := api.GetAppInstancesPerLabelMetrics(labelKeys)
+	if err != nil {
+		return fmt.Errorf("failed to get app instances per label metrics: %w", err)
+	}
+
+	// Reset so that label values no longer used don't linger around.
+	appInstancePerLabelGaugeMetric.Reset()
+	for _, metric := range aiplMetrics {
+		appInstancePerLabelGaugeMetric.WithLabelValues(metric.ApplicationName, metric.LabelKey, metric.LabelValue).Set(float64(metric.InstancesCount))
+	}
+
 	fuMetrics, err := api.GetFailedUpdatesMetrics()
 	if err != nil {
 		return fmt.Errorf("failed to get failed update metrics: %w", err)
@@ -156,3 +296,70 @@ func calculateMetrics(api *api.API) error {
 
 	return nil
 }
+
+// calculateSyncerMetrics updates the syncer metrics from the current syncer status.
+func calculateSyncerMetrics(syncer *syncer.Syncer) {
+	if syncer == nil {
+		return
+	}
+
+	status := syncer.Status()
+
+	if !status.NextCheck.IsZero() {
+		syncerNextCheckGaugeMetric.Set(float64(status.NextCheck.Unix()))
+	}
+
+	// Reset so that versions no longer seen upstream don't linger around.
+	syncerUpstreamVersionGaugeMetric.Reset()
+
+	for _, cs := range status.Channels {
+		syncerLastCheckGaugeMetric.WithLabelValues(cs.Channel, cs.Arch).Set(float64(cs.LastCheck.Unix()))
+
+		failed := 0.0
+		if cs.LastError != "" {
+			failed = 1
+		}
+		syncerLastErrorGaugeMetric.WithLabelValues(cs.Channel, cs.Arch).Set(failed)
+
+		if cs.LastSeenVersion != "" {
+			syncerUpstreamVersionGaugeMetric.WithLabelValues(cs.Channel, cs.Arch, cs.LastSeenVersion).Set(1)
+		}
+	}
+
+	for _, us := range status.Upstreams {
+		healthy := 0.0
+		if us.Healthy {
+			healthy = 1
+		}
+		syncerUpstreamHealthyGaugeMetric.WithLabelValues(us.URL).Set(healthy)
+	}
+}
+
+// calculateRetentionMetrics updates the retention metrics from the current
+// pruner status.
+func calculateRetentionMetrics(pruner *retention.Pruner) {
+	if pruner == nil {
+		return
+	}
+
+	status := pruner.Status()
+	if status.LastRun.IsZero() {
+		return
+	}
+
+	retentionLastRunGaugeMetric.Set(float64(status.LastRun.Unix()))
+
+	failed := 0.0
+	if status.LastError != "" {
+		failed = 1
+	}
+	retentionLastRunFailedGaugeMetric.Set(failed)
+}
+
+// countPrunedRows adds the rows removed by a run of the retention pruner to
+// the pruned rows counter.
+func countPrunedRows(pruned map[api.RetentionTable]int64) {
+	for table, rows := range pruned {
+		retentionPrunedRowsCounterMetric.WithLabelValues(string(table)).Add(float64(rows))
+	}
+}
@@ -1,14 +1,20 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/auth"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
 )
 
+var l = logger.New("middleware")
+
 var commonPaths = []string{"/health", "/config", "/v1/update", "/flatcar/*", "/assets/*", "/apps", "/apps/*", "/instances", "/instances/*", "/404"}
 
 func MatchesOneOfPatterns(path string, patterns ...string) bool {
@@ -31,6 +37,8 @@ func NewAuthSkipper(auth string) middleware.Skipper {
 			return MatchesOneOfPatterns(path, "/auth/callback", "/auth/error", "/")
 		case "github":
 			return MatchesOneOfPatterns(path, "/login/cb", "/login/webhook")
+		case "local":
+			return MatchesOneOfPatterns(path, "/login", "/")
 		}
 		return false
 	}
@@ -60,6 +68,15 @@ func MatchesPattern(pattern, path string) bool {
 
 type AuthConfig struct {
 	Skipper middleware.Skipper
+
+	// APITokens authenticates the requests made with API tokens, whatever
+	// the auth mode. API tokens are not accepted when nil.
+	APITokens APITokenAuthenticator
+}
+
+// APITokenAuthenticator authenticates the requests made with API tokens.
+type APITokenAuthenticator interface {
+	AuthenticateAPIToken(secret string) (*api.APIToken, error)
 }
 
 func Auth(auth auth.Authenticator, conf AuthConfig) echo.MiddlewareFunc {
@@ -68,6 +85,14 @@ func Auth(auth auth.Authenticator, conf AuthConfig) echo.MiddlewareFunc {
 			if conf.Skipper(c) {
 				return next(c)
 			}
+			if conf.APITokens != nil {
+				if secret := apiTokenFromRequest(c); secret != "" {
+					if replied := authorizeAPIToken(c, conf.APITokens, secret); replied {
+						return nil
+					}
+					return next(c)
+				}
+			}
 			teamID, replied := auth.Authorize(c)
 			if replied {
 				return nil
@@ -77,3 +102,48 @@ func Auth(auth auth.Authenticator, conf AuthConfig) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// apiTokenFromRequest returns the API token sent as bearer token in the
+// request, if any.
+func apiTokenFromRequest(c echo.Context) string {
+	scheme, token, found := strings.Cut(c.Request().Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		return ""
+	}
+	token = strings.TrimSpace(token)
+	if !api.IsAPIToken(token) {
+		return ""
+	}
+	return token
+}
+
+// authorizeAPIToken authorizes the request made with the API token provided,
+// replying with an error if it's rejected or its role doesn't allow the
+// request. Viewer tokens can only make GET and HEAD requests.
+func authorizeAPIToken(c echo.Context, tokens APITokenAuthenticator, secret string) (replied bool) {
+	token, err := tokens.AuthenticateAPIToken(secret)
+	if err != nil {
+		if errors.Is(err, api.ErrAPITokenRejected) {
+			//nolint:errcheck
+			c.NoContent(http.StatusUnauthorized)
+			return true
+		}
+		l.Error().Err(err).Msg("authenticating api token")
+		//nolint:errcheck
+		c.NoContent(http.StatusInternalServerError)
+		return true
+	}
+
+	method := c.Request().Method
+	if token.Role != api.APITokenRoleAdmin && method != http.MethodGet && method != http.MethodHead {
+		//nolint:errcheck
+		c.NoContent(http.StatusForbidden)
+		return true
+	}
+
+	c.Set("team_id", token.TeamID)
+	c.Set("access_level", token.Role)
+	c.Set("username", "api-token:"+token.Name)
+	c.Set("api_token_id", token.ID)
+	return false
+}
@@ -4,11 +4,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
 )
 
 func TestMain(m *testing.M) {
@@ -35,6 +38,10 @@ func (m *mockAuthenticator) Login(_ echo.Context) error {
 	return nil
 }
 
+func (m *mockAuthenticator) Logout(_ echo.Context) error {
+	return nil
+}
+
 func (m *mockAuthenticator) LoginCb(_ echo.Context) error {
 	return nil
 }
@@ -128,6 +135,37 @@ func TestAuthSkipper_GitHub_SkippedPaths(t *testing.T) {
 	}
 }
 
+func TestAuthSkipper_Local_SkippedPaths(t *testing.T) {
+	skipper := NewAuthSkipper("local")
+
+	testCases := []struct {
+		path     string
+		expected bool
+		desc     string
+	}{
+		{"/health", true, "health endpoint should be skipped"},
+		{"/login", true, "login should be skipped"},
+		{"/", true, "root should be skipped for frontend"},
+		{"/apps", true, "apps path should be skipped for frontend"},
+		{"/logout", false, "logout should not be skipped"},
+		{"/login/cb", false, "login callback should not be skipped for local"},
+		{"/api/apps", false, "API endpoints should not be skipped"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tc.path)
+
+			result := skipper(c)
+			assert.Equal(t, tc.expected, result, "Path %s should return %v", tc.path, tc.expected)
+		})
+	}
+}
+
 func TestAuthSkipper_UnknownAuth(t *testing.T) {
 	skipper := NewAuthSkipper("unknown")
 
@@ -281,3 +319,69 @@ func TestAuth_AuthenticatorReplied(t *testing.T) {
 	assert.False(t, nextCalled, "Next handler should not be called when authenticator replies")
 	assert.Nil(t, c.Get("team_id"), "team_id should not be set when authenticator replies")
 }
+
+// mockAPITokens implements the APITokenAuthenticator interface for testing
+type mockAPITokens struct {
+	tokens map[string]*api.APIToken
+}
+
+func (m *mockAPITokens) AuthenticateAPIToken(secret string) (*api.APIToken, error) {
+	token, ok := m.tokens[secret]
+	if !ok {
+		return nil, api.ErrAPITokenRejected
+	}
+	return token, nil
+}
+
+func TestAuth_APITokens(t *testing.T) {
+	tokens := &mockAPITokens{tokens: map[string]*api.APIToken{
+		"nbr_viewer": {ID: "viewer-id", Name: "ci", TeamID: "token-team", Role: api.APITokenRoleViewer},
+		"nbr_admin":  {ID: "admin-id", Name: "release", TeamID: "token-team", Role: api.APITokenRoleAdmin},
+	}}
+
+	testCases := []struct {
+		desc           string
+		method         string
+		authorization  string
+		expectedStatus int
+		expectedTeamID interface{}
+		authCalls      int
+	}{
+		{"viewer token can read", http.MethodGet, "Bearer nbr_viewer", http.StatusOK, "token-team", 0},
+		{"viewer token can't write", http.MethodPost, "Bearer nbr_viewer", http.StatusForbidden, nil, 0},
+		{"admin token can write", http.MethodPost, "bearer nbr_admin", http.StatusOK, "token-team", 0},
+		{"unknown token is rejected", http.MethodGet, "Bearer nbr_unknown", http.StatusUnauthorized, nil, 0},
+		{"other bearer tokens are left to the authenticator", http.MethodGet, "Bearer eyJhbGciOi", http.StatusOK, "auth-team", 1},
+		{"requests without token are left to the authenticator", http.MethodGet, "", http.StatusOK, "auth-team", 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockAuth := &mockAuthenticator{teamID: "auth-team"}
+			config := AuthConfig{Skipper: func(_ echo.Context) bool { return false }, APITokens: tokens}
+
+			var teamID, username interface{}
+			handler := Auth(mockAuth, config)(func(c echo.Context) error {
+				teamID = c.Get("team_id")
+				username = c.Get("username")
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(tc.method, "/api/apps", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			require.NoError(t, handler(c))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedTeamID, teamID)
+			assert.Equal(t, tc.authCalls, mockAuth.callCount)
+			if tc.authCalls == 0 && tc.expectedStatus == http.StatusOK {
+				assert.True(t, strings.HasPrefix(username.(string), "api-token:"))
+			}
+		})
+	}
+}
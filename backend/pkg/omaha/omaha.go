@@ -1,6 +1,7 @@
 package omaha
 
 import (
+	"database/sql"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 
 	omahaSpec "github.com/flatcar/go-omaha/omaha"
 	"github.com/rs/zerolog"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/flatcar/nebraska/backend/pkg/api"
 	"github.com/flatcar/nebraska/backend/pkg/logger"
@@ -52,17 +54,32 @@ func NewHandler(crAPI *api.API) *Handler {
 	}
 }
 
+// machineLabelsRequest represents the Nebraska specific attributes of an
+// Omaha request that aren't part of the Omaha spec. Clients can report labels
+// for the instance in the machinelabels attribute of the app elements, like
+// machinelabels="env=prod,rack=r12".
+type machineLabelsRequest struct {
+	Apps []struct {
+		MachineLabels string `xml:"machinelabels,attr"`
+	} `xml:"app"`
+}
+
 // Handle is in charge of processing an Omaha request.
 func (h *Handler) Handle(rawReq io.Reader, respWriter io.Writer, ip string) error {
 	var omahaReq *omahaSpec.Request
 
-	if err := xml.NewDecoder(rawReq).Decode(&omahaReq); err != nil {
+	body, err := io.ReadAll(rawReq)
+	if err != nil {
+		l.Warn().Msgf("Handle - reading omaha request error %s", err.Error())
+		return fmt.Errorf("%s: %w", ErrMalformedRequest, err)
+	}
+	if err := xml.Unmarshal(body, &omahaReq); err != nil {
 		l.Warn().Msgf("Handle - malformed omaha request error %s", err.Error())
 		return fmt.Errorf("%s: %w", ErrMalformedRequest, err)
 	}
 	trace(omahaReq)
 
-	omahaResp, err := h.buildOmahaResponse(omahaReq, ip)
+	omahaResp, err := h.buildOmahaResponse(omahaReq, getMachineLabels(body), ip)
 	if err != nil {
 		l.Warn().Msgf("Handle - error building omaha response error %s", err.Error())
 		return ErrMalformedResponse
@@ -91,6 +108,32 @@ func getArch(os *omahaSpec.OS, appReq *omahaSpec.AppRequest) api.Arch {
 	return api.ArchAMD64
 }
 
+// getMachineLabels returns the labels reported in the request body provided
+// for each of its apps, in the same order. Labels that aren't valid are
+// dropped or truncated, so that they don't prevent the instances from getting
+// updates.
+func getMachineLabels(body []byte) []api.Labels {
+	var labelsReq machineLabelsRequest
+	if err := xml.Unmarshal(body, &labelsReq); err != nil {
+		return nil
+	}
+
+	labels := make([]api.Labels, len(labelsReq.Apps))
+	for i, app := range labelsReq.Apps {
+		if app.MachineLabels == "" {
+			continue
+		}
+		appLabels, problems := api.ParseReportedLabels(app.MachineLabels)
+		for _, problem := range problems {
+			l.Debug().Str("machineLabels", app.MachineLabels).Msgf("getMachineLabels - %s", problem)
+		}
+		if len(appLabels) > 0 {
+			labels[i] = appLabels
+		}
+	}
+	return labels
+}
+
 // isSyncerClient detects if the client is a Nebraska syncer based on request characteristics
 func isSyncerClient(req *omahaSpec.Request) bool {
 	// Nebraska syncers must have BOTH:
@@ -104,11 +147,11 @@ func isSyncerClient(req *omahaSpec.Request) bool {
 	return false
 }
 
-func (h *Handler) buildOmahaResponse(omahaReq *omahaSpec.Request, ip string) (*omahaSpec.Response, error) {
+func (h *Handler) buildOmahaResponse(omahaReq *omahaSpec.Request, machineLabels []api.Labels, ip string) (*omahaSpec.Response, error) {
 	omahaResp := omahaSpec.NewResponse()
 	omahaResp.Server = "nebraska"
 
-	for _, reqApp := range omahaReq.Apps {
+	for i, reqApp := range omahaReq.Apps {
 		var respApp *omahaSpec.AppResponse
 
 		appID, err := h.crAPI.GetAppID(reqApp.ID)
@@ -130,14 +173,41 @@ func (h *Handler) buildOmahaResponse(omahaReq *omahaSpec.Request, ip string) (*o
 			l.Info().Str("machineId", reqApp.MachineID).Str("uuid", group).Msgf("buildOmahaResponse - found client using a hard-coded group UUID")
 			group = trackName
 		}
-		groupID, err := h.crAPI.GetGroupID(appID, group, getArch(omahaReq.OS, reqApp))
-		if err == nil {
-			group = groupID
-		} else {
-			l.Info().Str("machineId", reqApp.MachineID).Str("track", group).Msgf("buildOmahaResponse - no group found for track and arch error %s", err.Error())
-			respApp.Status = h.getStatusMessage(err)
-			respApp.AddUpdateCheck(omahaSpec.UpdateInternalError)
-			return omahaResp, nil
+		// Instances assigned to another group through the API get their
+		// updates from it instead of the one matching their track, even if
+		// the track doesn't match any group anymore, as long as the channel
+		// of the group serves packages of their architecture.
+		arch := getArch(omahaReq.OS, reqApp)
+		overrideGroupID, err := h.crAPI.GetInstanceGroupOverride(reqApp.MachineID, appID)
+		if err != nil && err != sql.ErrNoRows {
+			l.Error().Err(err).Str("machineId", reqApp.MachineID).Msg("buildOmahaResponse - could not get group override")
+		}
+		if overrideGroupID != "" {
+			overrideGroup, err := h.crAPI.GetGroup(overrideGroupID)
+			if err != nil {
+				l.Error().Err(err).Str("machineId", reqApp.MachineID).Str("groupID", overrideGroupID).Msg("buildOmahaResponse - could not get override group")
+				overrideGroupID = ""
+			} else if overrideGroup.Channel != nil && overrideGroup.Channel.Arch != arch {
+				l.Info().Str("machineId", reqApp.MachineID).Str("groupID", overrideGroupID).Str("arch", arch.String()).Msg("buildOmahaResponse - ignoring group override of another arch")
+				overrideGroupID = ""
+			}
+		}
+		groupID, err := h.crAPI.GetGroupID(appID, group, arch)
+		if err != nil {
+			if overrideGroupID == "" {
+				l.Info().Str("machineId", reqApp.MachineID).Str("track", group).Msgf("buildOmahaResponse - no group found for track and arch error %s", err.Error())
+				respApp.Status = h.getStatusMessage(err)
+				respApp.AddUpdateCheck(omahaSpec.UpdateInternalError)
+				return omahaResp, nil
+			}
+			groupID = ""
+		}
+		// The requested group is left empty when the track doesn't match
+		// any group.
+		requestedGroup := groupID
+		group = groupID
+		if overrideGroupID != "" {
+			group = overrideGroupID
 		}
 
 		for _, event := range reqApp.Events {
@@ -154,7 +224,11 @@ func (h *Handler) buildOmahaResponse(omahaReq *omahaSpec.Request, ip string) (*o
 			OEM:          reqApp.OEM,
 			AlephVersion: reqApp.AlephVersion,
 		}
+		if i < len(machineLabels) {
+			inst.Labels = machineLabels[i]
+		}
 		instApp := api.NewInstanceApplication(appID, group, reqApp.Version)
+		instApp.RequestedGroupID = null.StringFrom(requestedGroup)
 
 		if reqApp.Ping != nil {
 			if _, err := h.crAPI.RegisterInstance(inst, instApp); err != nil {
@@ -258,6 +332,8 @@ func (h *Handler) getStatusMessageStr(crErr error) string {
 		return "error-couldNotCheckUpdatesStats"
 	case api.ErrUpdateInProgressOnInstance:
 		return "error-updateInProgressOnInstance"
+	case api.ErrSuspectedDuplicateInstance:
+		return "error-suspectedDuplicateInstance"
 	}
 
 	l.Warn().Msgf("getStatusMessage error %s", crErr.Error())
@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	omahaSpec "github.com/flatcar/go-omaha/omaha"
@@ -232,6 +233,87 @@ func TestAppUpdateForAppWithChannelAndPackageName(t *testing.T) {
 	checkOmahaUpdateResponse(t, omahaResp, tPkgFlatcar640.Version, "", "", omahaSpec.NoUpdate)
 }
 
+func TestInstanceGroupOverride(t *testing.T) {
+	a := newForTest(t)
+	defer a.Close()
+	h := NewHandler(a)
+	as := adminSvc(a)
+
+	tAppFlatcar, _ := a.GetApp(flatcarAppID)
+	tPkgFlatcar640, _ := as.AddPackage(&api.Package{Type: api.PkgTypeFlatcar, URL: "http://sample.url/pkg", Version: "640.0.0", ApplicationID: tAppFlatcar.ID, Arch: api.ArchAMD64})
+	tPkgFlatcar660, _ := as.AddPackage(&api.Package{Type: api.PkgTypeFlatcar, URL: "http://sample.url/pkg", Filename: null.StringFrom("flatcarupdate.tgz"), Version: "99660.0.0", ApplicationID: tAppFlatcar.ID, Arch: api.ArchAMD64})
+	tChannel, _ := as.AddChannel(&api.Channel{Name: "mychannel", Color: "white", ApplicationID: tAppFlatcar.ID, PackageID: null.StringFrom(tPkgFlatcar640.ID), Arch: api.ArchAMD64})
+	tCanaryChannel, _ := as.AddChannel(&api.Channel{Name: "mycanarychannel", Color: "white", ApplicationID: tAppFlatcar.ID, PackageID: null.StringFrom(tPkgFlatcar660.ID), Arch: api.ArchAMD64})
+	tGroup, _ := as.AddGroup(&api.Group{Name: "Production", ApplicationID: tAppFlatcar.ID, ChannelID: null.StringFrom(tChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: false, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	tCanaryGroup, _ := as.AddGroup(&api.Group{Name: "Canary", ApplicationID: tAppFlatcar.ID, ChannelID: null.StringFrom(tCanaryChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: false, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+
+	ip := "127.0.0.1"
+	machineID := "65e1266d-6f54-4b87-9080-23b99ca9c12f"
+
+	omahaResp := doOmahaRequest(t, h, tAppFlatcar.ID, tPkgFlatcar640.Version, machineID, tGroup.ID, ip, false, true, nil)
+	checkOmahaResponse(t, omahaResp, tAppFlatcar.ID, omahaSpec.AppOK)
+	checkOmahaUpdateResponse(t, omahaResp, tPkgFlatcar640.Version, "", "", omahaSpec.NoUpdate)
+
+	// The instance gets its updates from the group it is assigned to, while
+	// still reporting the track of the other one.
+	require.NoError(t, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, tCanaryGroup.ID))
+	instance, err := a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tCanaryGroup.ID, instance.Application.GroupID.String)
+	assert.Equal(t, tGroup.ID, instance.Application.RequestedGroupID.String)
+
+	omahaResp = doOmahaRequest(t, h, tAppFlatcar.ID, tPkgFlatcar640.Version, machineID, tGroup.ID, ip, false, true, nil)
+	checkOmahaResponse(t, omahaResp, tAppFlatcar.ID, omahaSpec.AppOK)
+	checkOmahaUpdateResponse(t, omahaResp, tPkgFlatcar660.Version, "flatcarupdate.tgz", tPkgFlatcar660.URL, omahaSpec.UpdateOK)
+	instance, err = a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tCanaryGroup.ID, instance.Application.GroupID.String)
+	assert.Equal(t, tGroup.ID, instance.Application.RequestedGroupID.String)
+
+	// The assignment is honored even if the reported track doesn't match
+	// any group.
+	omahaResp = doOmahaRequest(t, h, tAppFlatcar.ID, tPkgFlatcar640.Version, machineID, "unknown-track", ip, false, true, nil)
+	checkOmahaResponse(t, omahaResp, tAppFlatcar.ID, omahaSpec.AppOK)
+	checkOmahaUpdateResponse(t, omahaResp, tPkgFlatcar660.Version, "flatcarupdate.tgz", tPkgFlatcar660.URL, omahaSpec.UpdateOK)
+	instance, err = a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tCanaryGroup.ID, instance.Application.GroupID.String)
+	assert.False(t, instance.Application.RequestedGroupID.Valid)
+
+	// Groups whose channel serves another architecture are refused.
+	tArmChannel, _ := as.AddChannel(&api.Channel{Name: "myarmchannel", Color: "white", ApplicationID: tAppFlatcar.ID, Arch: api.ArchAArch64})
+	tArmGroup, _ := as.AddGroup(&api.Group{Name: "Arm", ApplicationID: tAppFlatcar.ID, ChannelID: null.StringFrom(tArmChannel.ID), PolicyUpdatesEnabled: true, PolicySafeMode: false, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	assert.Equal(t, api.ErrInstanceArchMismatch, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, tArmGroup.ID))
+	instance, err = a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tCanaryGroup.ID, instance.Application.GroupID.String)
+
+	// Assignments to a group whose channel moved to another architecture
+	// afterwards are ignored.
+	tArmGroup.ChannelID = null.String{}
+	require.NoError(t, as.UpdateGroup(tArmGroup))
+	require.NoError(t, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, tArmGroup.ID))
+	tArmGroup.ChannelID = null.StringFrom(tArmChannel.ID)
+	require.NoError(t, as.UpdateGroup(tArmGroup))
+	omahaResp = doOmahaRequest(t, h, tAppFlatcar.ID, tPkgFlatcar640.Version, machineID, tGroup.ID, ip, false, true, nil)
+	checkOmahaResponse(t, omahaResp, tAppFlatcar.ID, omahaSpec.AppOK)
+	checkOmahaUpdateResponse(t, omahaResp, tPkgFlatcar640.Version, "", "", omahaSpec.NoUpdate)
+	instance, err = a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tGroup.ID, instance.Application.GroupID.String)
+
+	// Removing the assignment moves the instance back to its track's group.
+	require.NoError(t, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, ""))
+	instance, err = a.GetInstance(machineID, tAppFlatcar.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tGroup.ID, instance.Application.GroupID.String)
+	assert.Equal(t, api.ErrNoRowsAffected, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, ""))
+
+	otherApp, _ := as.AddApp(&api.Application{Name: "other_app", TeamID: tAppFlatcar.TeamID})
+	otherGroup, _ := as.AddGroup(&api.Group{Name: "Other", ApplicationID: otherApp.ID, PolicyUpdatesEnabled: true, PolicySafeMode: true, PolicyPeriodInterval: "15 minutes", PolicyMaxUpdatesPerPeriod: 2, PolicyUpdateTimeout: "60 minutes"})
+	assert.Equal(t, api.ErrInvalidApplicationOrGroup, as.SetInstanceGroupOverride(machineID, tAppFlatcar.ID, otherGroup.ID))
+}
+
 func TestFlatcarGroupNamesConversionToIds(t *testing.T) {
 	a := newForTest(t)
 	defer a.Close()
@@ -562,3 +644,25 @@ func Test_getArch(t *testing.T) {
 		})
 	}
 }
+
+func Test_getMachineLabels(t *testing.T) {
+	body := []byte(`<request protocol="3.0">
+  <app appid="` + flatcarAppID + `" machinelabels="env=prod, rack=r12"></app>
+  <app appid="` + flatcarAppID + `"></app>
+  <app appid="` + flatcarAppID + `" machinelabels="invalid"></app>
+  <app appid="` + flatcarAppID + `" machinelabels="env=prod,invalid key=value,rack"></app>
+  <app appid="` + flatcarAppID + `" machinelabels="note=a` + strings.Repeat("é", 200) + `"></app>
+</request>`)
+
+	labels := getMachineLabels(body)
+	require.Len(t, labels, 5)
+	assert.Equal(t, api.Labels{"env": "prod", "rack": "r12"}, labels[0])
+	assert.Nil(t, labels[1])
+	assert.Nil(t, labels[2])
+	assert.Equal(t, api.Labels{"env": "prod"}, labels[3])
+	// long values are truncated to 255 bytes without splitting multi-byte
+	// characters
+	assert.Equal(t, "a"+strings.Repeat("é", 127), labels[4]["note"])
+
+	assert.Nil(t, getMachineLabels([]byte("not xml")))
+}
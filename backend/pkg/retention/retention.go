@@ -0,0 +1,354 @@
+package retention
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+)
+
+const (
+	defaultInterval  = time.Hour
+	defaultBatchSize = 5000
+
+	// minInstancesRetention is the shortest retention allowed for instances,
+	// so that instances considered active are never pruned.
+	minInstancesRetention = 24 * time.Hour
+
+	// batchPause is the time waited between batches, giving other queries
+	// a chance to run.
+	batchPause = 100 * time.Millisecond
+)
+
+var (
+	l = logger.New("retention")
+
+	// ErrInvalidAPIInstance error indicates that no valid api instance was
+	// provided to the pruner constructor.
+	ErrInvalidAPIInstance = errors.New("invalid api instance")
+
+	errStopped = errors.New("pruner stopped")
+)
+
+// Policy represents how long rows are kept in each of the tables that can be
+// pruned. Tables without a retention are never pruned.
+type Policy map[api.RetentionTable]time.Duration
+
+// PolicyFromConfig returns the retention policy set in the config provided.
+func PolicyFromConfig(conf *config.Config) (Policy, error) {
+	policy := make(Policy)
+	values := map[api.RetentionTable]string{
+		api.RetentionTableInstanceApplication:   conf.RetentionInstances,
+		api.RetentionTableInstance:              conf.RetentionInstances,
+		api.RetentionTableEvent:                 conf.RetentionEvents,
+		api.RetentionTableInstanceStatusHistory: conf.RetentionHistory,
+		api.RetentionTableInstanceStats:         conf.RetentionStats,
+		api.RetentionTableInstanceStatsDaily:    conf.RetentionStatsDaily,
+		api.RetentionTableInstanceStatsWeekly:   conf.RetentionStatsWeekly,
+		api.RetentionTableWebhookDelivery:       conf.RetentionWebhookDelivery,
+	}
+
+	for table, value := range values {
+		if value == "" {
+			continue
+		}
+		retention, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention for %s: %w", table, err)
+		}
+		if retention <= 0 {
+			continue
+		}
+		if (table == api.RetentionTableInstance || table == api.RetentionTableInstanceApplication) && retention < minInstancesRetention {
+			return nil, fmt.Errorf("invalid retention for %s: must be at least %s", table, minInstancesRetention)
+		}
+		policy[table] = retention
+	}
+
+	return policy, nil
+}
+
+// TableStatus represents what the pruner removed from a table.
+type TableStatus struct {
+	Table       api.RetentionTable `json:"table"`
+	Retention   time.Duration      `json:"retention"`
+	LastPruned  int64              `json:"last_pruned"`
+	TotalPruned int64              `json:"total_pruned"`
+}
+
+// Status represents the current state of the pruner.
+type Status struct {
+	Running   bool          `json:"running"`
+	LastRun   time.Time     `json:"last_run"`
+	LastError string        `json:"last_error"`
+	Tables    []TableStatus `json:"tables"`
+}
+
+// TableCount represents the number of rows of a table past their retention.
+type TableCount struct {
+	Table     api.RetentionTable
+	Retention time.Duration
+	Cutoff    time.Time
+	Rows      int64
+}
+
+// Pruner represents a background job in charge of removing the rows past
+// their retention from the tables that grow with the fleet size.
+type Pruner struct {
+	api       *api.API
+	policy    Policy
+	interval  time.Duration
+	batchSize int
+	stopCh    chan struct{}
+	stopOnce  sync.Once
+
+	statusMu  sync.RWMutex
+	status    Status
+	tables    map[api.RetentionTable]*TableStatus
+	observers []func(pruned map[api.RetentionTable]int64)
+}
+
+// Config represents the configuration used to create a new Pruner instance.
+type Config struct {
+	API       *api.API
+	Policy    Policy
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Setup creates a new pruner from config and db connection, and returns it.
+func Setup(conf *config.Config, db *api.API) (*Pruner, error) {
+	policy, err := PolicyFromConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultInterval
+	if conf.RetentionInterval != "" {
+		interval, err = time.ParseDuration(conf.RetentionInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention interval: %w", err)
+		}
+	}
+
+	return New(&Config{
+		API:       db,
+		Policy:    policy,
+		Interval:  interval,
+		BatchSize: conf.RetentionBatchSize,
+	})
+}
+
+// New creates a new Pruner instance.
+func New(conf *Config) (*Pruner, error) {
+	if conf.API == nil {
+		return nil, ErrInvalidAPIInstance
+	}
+
+	p := &Pruner{
+		api:       conf.API,
+		policy:    conf.Policy,
+		interval:  conf.Interval,
+		batchSize: conf.BatchSize,
+		stopCh:    make(chan struct{}),
+		tables:    make(map[api.RetentionTable]*TableStatus, len(conf.Policy)),
+	}
+
+	if p.interval <= 0 {
+		p.interval = defaultInterval
+	}
+
+	if p.batchSize <= 0 {
+		p.batchSize = defaultBatchSize
+	}
+
+	for _, table := range api.RetentionTables {
+		if retention, ok := p.policy[table]; ok {
+			p.tables[table] = &TableStatus{Table: table, Retention: retention}
+		}
+	}
+
+	return p, nil
+}
+
+// Enabled reports whether the retention policy prunes any table.
+func (p *Pruner) Enabled() bool {
+	return len(p.policy) > 0
+}
+
+// Start makes the pruner start working. It will prune the tables every
+// interval until it's stopped. Even when no table is pruned, it keeps
+// creating the upcoming partitions of the partitioned tables.
+func (p *Pruner) Start() {
+	l.Debug().Msg("pruner ready!")
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Prune(); err != nil && !errors.Is(err, errStopped) {
+			l.Error().Err(err).Msg("pruning tables")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// Stop stops the pruner.
+func (p *Pruner) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+}
+
+// Prune creates the upcoming partitions of the partitioned tables and removes
+// from all tables the rows past their retention.
+func (p *Pruner) Prune() error {
+	p.setRunning(true)
+	defer p.setRunning(false)
+
+	now := time.Now().UTC()
+	pruned := make(map[api.RetentionTable]int64, len(p.policy))
+
+	err := p.api.CreateFuturePartitions()
+	if err != nil {
+		p.recordRun(now, pruned, err)
+		return err
+	}
+
+	for _, table := range api.RetentionTables {
+		retention, ok := p.policy[table]
+		if !ok {
+			continue
+		}
+		pruned[table], err = p.pruneTable(table, now.Add(-retention))
+		if err != nil {
+			err = fmt.Errorf("pruning %s: %w", table, err)
+			break
+		}
+		if pruned[table] > 0 {
+			l.Info().Str("table", string(table)).Int64("rows", pruned[table]).Msg("pruned rows past their retention")
+		}
+	}
+
+	p.recordRun(now, pruned, err)
+	return err
+}
+
+// pruneTable removes the rows of the table provided older than the cutoff,
+// returning the number of rows removed. Partitions only holding such rows are
+// dropped, and the remaining rows are deleted batch by batch.
+func (p *Pruner) pruneTable(table api.RetentionTable, cutoff time.Time) (int64, error) {
+	total, err := p.api.DropPartitionsBefore(table, cutoff)
+	if err != nil {
+		return total, err
+	}
+
+	for {
+		rows, err := p.api.PruneRows(table, cutoff, p.batchSize)
+		total += rows
+		if err != nil {
+			return total, err
+		}
+		if rows < int64(p.batchSize) {
+			return total, nil
+		}
+
+		select {
+		case <-time.After(batchPause):
+		case <-p.stopCh:
+			return total, errStopped
+		}
+	}
+}
+
+// DryRun returns the number of rows of each table that would be removed
+// according to the policy provided if the pruner ran now. Tables not pruned
+// by the policy are reported with no rows.
+func DryRun(db *api.API, policy Policy) ([]TableCount, error) {
+	now := time.Now().UTC()
+	counts := make([]TableCount, 0, len(api.RetentionTables))
+
+	for _, table := range api.RetentionTables {
+		count := TableCount{Table: table}
+		if retention, ok := policy[table]; ok {
+			count.Retention = retention
+			count.Cutoff = now.Add(-retention)
+			rows, err := db.CountPrunableRows(table, count.Cutoff)
+			if err != nil {
+				return nil, fmt.Errorf("counting %s rows: %w", table, err)
+			}
+			count.Rows = rows
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+// Status returns the current state of the pruner.
+func (p *Pruner) Status() Status {
+	p.statusMu.RLock()
+	defer p.statusMu.RUnlock()
+
+	status := p.status
+	status.Tables = make([]TableStatus, 0, len(p.tables))
+	for _, ts := range p.tables {
+		status.Tables = append(status.Tables, *ts)
+	}
+	sort.Slice(status.Tables, func(i, j int) bool {
+		return status.Tables[i].Table < status.Tables[j].Table
+	})
+
+	return status
+}
+
+// OnRun registers a function called with the number of rows removed from each
+// table after every run of the pruner. It's first called with the rows
+// removed since the pruner started, so that the runs that happened before the
+// registration aren't missed. The function must not call into the pruner.
+func (p *Pruner) OnRun(fn func(pruned map[api.RetentionTable]int64)) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+
+	total := make(map[api.RetentionTable]int64, len(p.tables))
+	for table, ts := range p.tables {
+		total[table] = ts.TotalPruned
+	}
+	fn(total)
+	p.observers = append(p.observers, fn)
+}
+
+func (p *Pruner) setRunning(running bool) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+
+	p.status.Running = running
+}
+
+func (p *Pruner) recordRun(t time.Time, pruned map[api.RetentionTable]int64, err error) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+
+	p.status.LastRun = t
+	p.status.LastError = ""
+	if err != nil {
+		p.status.LastError = err.Error()
+	}
+	for table, ts := range p.tables {
+		ts.LastPruned = pruned[table]
+		ts.TotalPruned += pruned[table]
+	}
+	for _, fn := range p.observers {
+		fn(pruned)
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
@@ -25,24 +26,38 @@ import (
 	custommiddleware "github.com/flatcar/nebraska/backend/pkg/middleware"
 	"github.com/flatcar/nebraska/backend/pkg/sessions"
 	echosessions "github.com/flatcar/nebraska/backend/pkg/sessions/echo"
-	"github.com/flatcar/nebraska/backend/pkg/sessions/memcache"
-	memcachegob "github.com/flatcar/nebraska/backend/pkg/sessions/memcache/gob"
+	"github.com/flatcar/nebraska/backend/pkg/sessions/postgres"
 	"github.com/flatcar/nebraska/backend/pkg/sessions/securecookie"
+	"github.com/flatcar/nebraska/backend/pkg/stream"
+	"github.com/flatcar/nebraska/backend/pkg/syncer"
 	"github.com/flatcar/nebraska/backend/pkg/tlsutil"
 )
 
-const serviceName = "nebraska"
+const (
+	serviceName = "nebraska"
+
+	// localAdminUsername is the username of the admin local user created
+	// at startup in local mode.
+	localAdminUsername = "admin"
+)
 
 var (
 	l                 = logger.New("nebraska")
 	middlewareSkipper = func(c echo.Context) bool {
 		return custommiddleware.MatchesOneOfPatterns(c.Request().URL.Path, "/health", "/metrics", "/config", "/v1/update", "/flatcar/*", "/assets/*")
 	}
+	// gzipSkipper also skips the events stream, whose events must be flushed
+	// to the client as soon as they are written.
+	gzipSkipper = func(c echo.Context) bool {
+		return middlewareSkipper(c) || c.Request().URL.Path == "/api/events/stream"
+	}
 )
 
 // New takes the config and db connection to create the server and returns it.
-// It also starts a background job to update instance stats periodically.
-func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo, error) {
+// It also starts a background job to update instance stats periodically, and
+// the broker of the events stream. The syncer is optional and can be nil when
+// it's not enabled.
+func New(conf *config.Config, db *db.API, adminSvc *admin.Service, syncer *syncer.Syncer) (*echo.Echo, error) {
 	// Setup Echo Server
 	e := echo.New()
 
@@ -67,10 +82,16 @@ func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo,
 		return nil, fmt.Errorf("cannot fetch the default teamID: %w", err)
 	}
 
+	if conf.AuthMode == "local" {
+		if err := setupLocalAdmin(*conf, db, adminSvc, defaultTeam.ID); err != nil {
+			return nil, fmt.Errorf("local admin setup error: %w", err)
+		}
+	}
+
 	// setup session store
-	sessionStore := setupSessionStore(*conf)
+	sessionStore := setupSessionStore(*conf, db)
 
-	authenticator, err := setupAuthenticator(*conf, sessionStore, defaultTeam.ID)
+	authenticator, err := setupAuthenticator(*conf, sessionStore, defaultTeam.ID, db, db, db, db)
 	if err != nil {
 		return nil, fmt.Errorf("authenticator setup error: %w", err)
 	}
@@ -93,7 +114,7 @@ func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo,
 	e.Use(middleware.Recover())
 	e.Use(middleware.RequestID())
 	e.Use(middleware.CORS())
-	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{Skipper: middlewareSkipper}))
+	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{Skipper: gzipSkipper}))
 	if conf.HTTPLog {
 		e.Use(middleware.RequestLogger())
 	}
@@ -108,7 +129,10 @@ func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo,
 	//
 	// This order ensures authorization errors (403) are returned before
 	// validation errors (400), preventing information leakage.
-	e.Use(custommiddleware.Auth(authenticator, custommiddleware.AuthConfig{Skipper: custommiddleware.NewAuthSkipper(conf.AuthMode)}))
+	e.Use(custommiddleware.Auth(authenticator, custommiddleware.AuthConfig{
+		Skipper:   custommiddleware.NewAuthSkipper(conf.AuthMode),
+		APITokens: db,
+	}))
 
 	e.Use(echomiddleware.OapiRequestValidatorWithOptions(
 		swagger,
@@ -117,8 +141,15 @@ func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo,
 			Skipper: middlewareSkipper,
 		}))
 
+	// setup the broker of the events stream
+	broker, err := stream.New(db)
+	if err != nil {
+		return nil, fmt.Errorf("error setting up events stream: %w", err)
+	}
+	go broker.Start()
+
 	// setup handler
-	handlers, err := handler.New(db, adminSvc, conf, authenticator)
+	handlers, err := handler.New(db, adminSvc, conf, authenticator, syncer, broker)
 	if err != nil {
 		return nil, fmt.Errorf("error setting up handlers: %w", err)
 	}
@@ -148,28 +179,30 @@ func New(conf *config.Config, db *db.API, adminSvc *admin.Service) (*echo.Echo,
 		e.DefaultHTTPErrorHandler(err, c)
 	}
 
-	// setup background job for updating instance stats
+	// setup background job for updating instance stats and rolling them up
 	go func() {
-		// update once at startup
-		err = db.UpdateInstanceStats(nil, nil)
-		if err != nil {
-			l.Err(err).Msg("Error updating instance stats")
+		updateInstanceStats := func() {
+			if err := db.UpdateInstanceStats(nil, nil); err != nil {
+				l.Err(err).Msg("Error updating instance stats")
+			}
+			if err := db.RollupInstanceStats(time.Now()); err != nil {
+				l.Err(err).Msg("Error rolling up instance stats")
+			}
 		}
+		// update once at startup
+		updateInstanceStats()
 		ticker := time.NewTicker(time.Hour)
 		defer ticker.Stop()
 
 		for range ticker.C {
-			err := db.UpdateInstanceStats(nil, nil)
-			if err != nil {
-				l.Err(err).Msg("Error updating instance stats")
-			}
+			updateInstanceStats()
 		}
 	}()
 
 	return e, nil
 }
 
-func setupAuthenticator(conf config.Config, sessionStore *sessions.Store, defaultTeamID string) (auth.Authenticator, error) {
+func setupAuthenticator(conf config.Config, sessionStore *sessions.Store, defaultTeamID string, roleBindings auth.RoleBindingChecker, teams auth.TeamResolver, githubSessions auth.GithubSessionIndex, localUsers auth.LocalUsers) (auth.Authenticator, error) {
 	switch conf.AuthMode {
 	case "noop":
 		noopAuthConfig := &auth.NoopAuthConfig{
@@ -186,6 +219,9 @@ func setupAuthenticator(conf config.Config, sessionStore *sessions.Store, defaul
 			ReadWriteTeams:    strings.Split(conf.GhReadWriteTeams, ","),
 			ReadOnlyTeams:     strings.Split(conf.GhReadOnlyTeams, ","),
 			DefaultTeamID:     defaultTeamID,
+			RoleBindings:      roleBindings,
+			Teams:             teams,
+			Sessions:          githubSessions,
 		}
 		return auth.NewGithubAuthenticator(gituhbAuthConfig), nil
 	case "oidc":
@@ -197,23 +233,82 @@ func setupAuthenticator(conf config.Config, sessionStore *sessions.Store, defaul
 			RolesPath:     conf.OidcRolesPath,
 			UseUserInfo:   conf.OidcUseUserInfo,
 			HTTPClient:    tlsutil.NewHTTPClient(conf.CACertPool),
+			RoleBindings:  roleBindings,
+			TeamsPath:     conf.OidcTeamsPath,
+			Teams:         teams,
 		}
 		return auth.NewOIDCAuthenticator(oidcAuthConfig)
+	case "local":
+		localAuthConfig := &auth.LocalAuthConfig{
+			SessionStore: sessionStore,
+			Users:        localUsers,
+		}
+		return auth.NewLocalAuthenticator(localAuthConfig), nil
 	}
 	return nil, nil
 }
 
-func setupSessionStore(conf config.Config) *sessions.Store {
+func setupSessionStore(conf config.Config, storage postgres.Storage) *sessions.Store {
 	switch conf.AuthMode {
 	case "noop":
 		return nil
 	case "oidc":
 		return nil
 	case "github":
-		cache := memcache.New(memcachegob.New())
+		// the sessions are kept in the database, so they are shared
+		// between the replicas and survive restarts; the expired ones
+		// are destroyed for as long as the server runs
+		cache := postgres.New(storage)
+		go cache.Start()
 		codec := securecookie.New([]byte(conf.GhSessionAuthKey), []byte(conf.GhSessionCryptKey))
 		return sessions.NewStore(cache, codec)
+	case "local":
+		cache := postgres.New(storage)
+		go cache.Start()
+		codec := securecookie.New([]byte(conf.LocalSessionAuthKey), []byte(conf.LocalSessionCryptKey))
+		return sessions.NewStore(cache, codec)
+	}
+	return nil
+}
+
+// setupLocalAdmin creates the admin local user of the default team with the
+// password from the config, unless it exists already. Without a password, it
+// only warns when the default team has no admin, as nobody could log in to
+// manage the users.
+func setupLocalAdmin(conf config.Config, api *db.API, adminSvc *admin.Service, defaultTeamID string) error {
+	if conf.LocalAdminPassword == "" {
+		count, err := api.GetTeamAdminsCount(defaultTeamID)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			l.Warn().Msg("There is no admin local user in the default team, set one up with -local-admin-password")
+		}
+		return nil
 	}
+
+	_, err := api.GetUser(localAdminUsername)
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	secret, err := adminSvc.GenerateUserSecret(conf.LocalAdminPassword)
+	if err != nil {
+		return err
+	}
+	_, err = adminSvc.AddUser(&db.User{
+		Username: localAdminUsername,
+		Secret:   secret,
+		Role:     db.UserRoleAdmin,
+		TeamID:   defaultTeamID,
+	})
+	if err != nil {
+		return err
+	}
+	l.Info().Msgf("Created the %s local user", localAdminUsername)
 	return nil
 }
 
@@ -234,6 +329,15 @@ func nebraskaAuthenticationFunc(authMode string) func(context.Context, *openapi3
 				}
 			}
 			return nil
+		case "local":
+			err := validateAuthorizationToken(input)
+			if err != nil {
+				_, err := input.RequestValidationInput.Request.Cookie("local")
+				if err != nil {
+					return fmt.Errorf("local cookie not found: %w", err)
+				}
+			}
+			return nil
 		}
 		return nil
 	}
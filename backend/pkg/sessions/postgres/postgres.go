@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+	"github.com/flatcar/nebraska/backend/pkg/random"
+	"github.com/flatcar/nebraska/backend/pkg/sessions"
+)
+
+const (
+	// sessionTTL is how long a session is kept after it was last
+	// saved, matching the max age of the session cookies.
+	sessionTTL = 30 * 24 * time.Hour
+
+	defaultCleanupInterval = time.Hour
+)
+
+var l = logger.New("sessions")
+
+// Storage persists the encoded session values. It's implemented by
+// the Nebraska API.
+type Storage interface {
+	// GetSessionData should return the data of the session, or
+	// sql.ErrNoRows if it doesn't exist or has expired.
+	GetSessionData(id string) ([]byte, error)
+	// AddSessionData should store the data of a new session.
+	AddSessionData(id string, data []byte, expiresTs time.Time) error
+	// UpdateSessionData should replace the data of the session and
+	// report false if it doesn't exist anymore.
+	UpdateSessionData(id string, data []byte, expiresTs time.Time) (bool, error)
+	// DeleteSessionData should destroy the session.
+	DeleteSessionData(id string) error
+	// DeleteExpiredSessionsData should destroy the sessions that
+	// expired before the passed time.
+	DeleteExpiredSessionsData(now time.Time) (int64, error)
+}
+
+type sessionInfo struct {
+	uses    uint64
+	destroy bool
+}
+
+// Cache is an implementation of sessions.Cache keeping the session
+// values in Postgres, so the sessions can be shared between Nebraska
+// replicas and survive restarts. The use counts are local to the
+// replica, a destroyed session is removed from the storage right away
+// and the requests still using it fail to save it.
+type Cache struct {
+	storage Storage
+
+	sessionsLock sync.Mutex
+	sessions     map[string]*sessionInfo
+	randomString func(int) string
+	now          func() time.Time
+
+	cleanupInterval time.Duration
+	stopCh          chan struct{}
+	stopOnce        sync.Once
+}
+
+var _ sessions.Cache = &Cache{}
+
+// New returns a Postgres-based implementation of sessions.Cache.
+func New(storage Storage) *Cache {
+	return &Cache{
+		storage:         storage,
+		sessions:        make(map[string]*sessionInfo),
+		randomString:    random.String,
+		now:             time.Now,
+		cleanupInterval: defaultCleanupInterval,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start periodically destroys the expired sessions until Stop is
+// called.
+func (c *Cache) Start() {
+	ticker := time.NewTicker(c.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Cleanup(); err != nil {
+			l.Error().Err(err).Msg("destroying expired sessions")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// Stop stops the periodic destruction of the expired sessions.
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+// Cleanup destroys the expired sessions.
+func (c *Cache) Cleanup() error {
+	count, err := c.storage.DeleteExpiredSessionsData(c.now())
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		l.Debug().Int64("count", count).Msg("destroyed expired sessions")
+	}
+	return nil
+}
+
+// GetSessionUse is a part of sessions.Cache interface.
+func (c *Cache) GetSessionUse(session sessions.SessionExt) {
+	if session.ID() == "" {
+		return
+	}
+	c.sessionsLock.Lock()
+	defer c.sessionsLock.Unlock()
+	c.getInfo(session.ID()).uses++
+}
+
+// GetSessionUseByID is a part of sessions.Cache interface.
+func (c *Cache) GetSessionUseByID(builder sessions.SessionBuilder, id, name string) *sessions.Session {
+	if id == "" {
+		return nil
+	}
+	data, err := c.storage.GetSessionData(id)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			l.Error().Err(err).Msg("getting session")
+		}
+		return nil
+	}
+	values, err := decodeValues(data)
+	if err != nil {
+		l.Error().Err(err).Msg("getting session")
+		return nil
+	}
+
+	c.sessionsLock.Lock()
+	defer c.sessionsLock.Unlock()
+	info := c.getInfo(id)
+	if info.destroy {
+		return nil
+	}
+	info.uses++
+	return builder.NewExistingSession(name, id, values, c).Session()
+}
+
+// PutSessionUse is a part of sessions.Cache interface.
+func (c *Cache) PutSessionUse(session sessions.SessionExt) {
+	id := session.ID()
+	if id == "" {
+		return
+	}
+	c.sessionsLock.Lock()
+	defer c.sessionsLock.Unlock()
+	info, ok := c.sessions[id]
+	if !ok {
+		return
+	}
+	if info.uses == 0 {
+		panic("mismatched GetSessionUse and PutSessionUse")
+	}
+	info.uses--
+	if info.uses == 0 {
+		delete(c.sessions, id)
+	}
+}
+
+// MarkOrDestroySessionByID is a part of sessions.Cache interface.
+func (c *Cache) MarkOrDestroySessionByID(id string) {
+	if id == "" {
+		return
+	}
+	c.sessionsLock.Lock()
+	if info, ok := c.sessions[id]; ok {
+		info.destroy = true
+	}
+	c.sessionsLock.Unlock()
+	c.destroy(id)
+}
+
+// MarkSession is a part of sessions.Cache interface.
+func (c *Cache) MarkSession(session sessions.SessionExt) {
+	c.MarkOrDestroySessionByID(session.ID())
+}
+
+// SaveSession is a part of sessions.Cache interface.
+func (c *Cache) SaveSession(session sessions.SessionExt) (bool, error) {
+	data, err := encodeValues(session.GetValues())
+	if err != nil {
+		return false, err
+	}
+	expiresTs := c.now().Add(sessionTTL)
+
+	if session.ID() == "" {
+		// first save ever
+		id := c.randomString(64)
+		if err := c.storage.AddSessionData(id, data, expiresTs); err != nil {
+			return false, err
+		}
+		session.SetID(id)
+		c.sessionsLock.Lock()
+		defer c.sessionsLock.Unlock()
+		c.getInfo(id).uses++
+		return false, nil
+	}
+
+	c.sessionsLock.Lock()
+	info, ok := c.sessions[session.ID()]
+	destroyed := ok && info.destroy
+	c.sessionsLock.Unlock()
+	if destroyed {
+		return true, nil
+	}
+	updated, err := c.storage.UpdateSessionData(session.ID(), data, expiresTs)
+	if err != nil {
+		return false, err
+	}
+	// the session was destroyed by another replica or expired
+	return !updated, nil
+}
+
+// getInfo returns the local information about the session, creating
+// it if needed. The sessions lock must be held.
+func (c *Cache) getInfo(id string) *sessionInfo {
+	info, ok := c.sessions[id]
+	if !ok {
+		info = &sessionInfo{}
+		c.sessions[id] = info
+	}
+	return info
+}
+
+func (c *Cache) destroy(id string) {
+	if err := c.storage.DeleteSessionData(id); err != nil {
+		l.Error().Err(err).Msg("destroying session")
+	}
+}
+
+func encodeValues(values sessions.ValuesType) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, fmt.Errorf("could not encode session values to gob: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeValues(data []byte) (sessions.ValuesType, error) {
+	var values sessions.ValuesType
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return nil, fmt.Errorf("could not decode session values from gob: %v", err)
+	}
+	return values, nil
+}
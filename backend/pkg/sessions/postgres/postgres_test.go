@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/sessions"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv("NEBRASKA_SKIP_TESTS") != "" {
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestStoreWithHarness(t *testing.T) {
+	h := &sessions.TestHarness{
+		T:           t,
+		NewCache:    func() sessions.Cache { return newTestCache(newMockStorage()) },
+		UseCountFor: useCountFor,
+	}
+	h.RunBasicSessionLifecycleTests()
+	h.RunDeadCookiesTests()
+}
+
+func TestSharedBetweenReplicas(t *testing.T) {
+	storage := newMockStorage()
+	codec := sessions.NewMockCodec()
+	codec.AddIDValueMapping("id1", "val1")
+	store1 := sessions.NewStore(newTestCache(storage), codec)
+	store2 := sessions.NewStore(newTestCache(storage), codec)
+
+	// session created on the first replica
+	session1 := store1.GetSessionUse(newRequestWithCookie("val1"), "test")
+	session1.Set("username", "user1")
+	session1.Set("rolesubjects", []string{"kinvolk", "kinvolk/ops"})
+	require.NoError(t, session1.Save(httptest.NewRecorder()))
+	store1.PutSessionUse(session1)
+
+	// and used on the second one
+	session2 := store2.GetSessionUse(newRequestWithCookie("val1"), "test")
+	assert.Equal(t, "id1", session2.ID())
+	assert.Equal(t, "user1", session2.Get("username"))
+	assert.Equal(t, []string{"kinvolk", "kinvolk/ops"}, session2.Get("rolesubjects"))
+
+	// destroyed by the first replica while in use by the second one
+	store1.MarkOrDestroySessionByID("id1")
+	recorder := httptest.NewRecorder()
+	require.NoError(t, session2.Save(recorder))
+	assert.Contains(t, recorder.Header().Get("Set-Cookie"), "Max-Age=0")
+	store2.PutSessionUse(session2)
+
+	session3 := store2.GetSessionUse(newRequestWithCookie("val1"), "test")
+	assert.Equal(t, "", session3.ID())
+}
+
+func TestCleanup(t *testing.T) {
+	storage := newMockStorage()
+	cache := newTestCache(storage)
+	codec := sessions.NewMockCodec()
+	codec.AddIDValueMapping("id1", "val1")
+	store := sessions.NewStore(cache, codec)
+
+	session := store.GetSessionUse(newRequestWithCookie("val1"), "test")
+	require.NoError(t, session.Save(httptest.NewRecorder()))
+	store.PutSessionUse(session)
+
+	require.NoError(t, cache.Cleanup())
+	assert.Equal(t, 0, useCountFor(cache, "id1"))
+
+	cache.now = func() time.Time { return time.Now().Add(sessionTTL + time.Hour) }
+	require.NoError(t, cache.Cleanup())
+	assert.Equal(t, -1, useCountFor(cache, "id1"))
+}
+
+func newTestCache(storage *mockStorage) *Cache {
+	cache := New(storage)
+	s := &testRandomStringer{}
+	cache.randomString = s.randomString
+	return cache
+}
+
+func useCountFor(cache sessions.Cache, id string) int {
+	pcache := cache.(*Cache)
+	pcache.sessionsLock.Lock()
+	defer pcache.sessionsLock.Unlock()
+	if info, ok := pcache.sessions[id]; ok {
+		return int(info.uses)
+	}
+	if _, err := pcache.storage.GetSessionData(id); err == nil {
+		return 0
+	}
+	return -1
+}
+
+func newRequestWithCookie(value string) *http.Request {
+	request := httptest.NewRequest("", "/", nil)
+	request.AddCookie(&http.Cookie{
+		Name:  "test",
+		Value: value,
+	})
+	return request
+}
+
+type mockSession struct {
+	data      []byte
+	expiresTs time.Time
+}
+
+type mockStorage struct {
+	lock     sync.Mutex
+	sessions map[string]mockSession
+}
+
+var _ Storage = &mockStorage{}
+
+func newMockStorage() *mockStorage {
+	return &mockStorage{
+		sessions: make(map[string]mockSession),
+	}
+}
+
+func (s *mockStorage) GetSessionData(id string) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	session, ok := s.sessions[id]
+	if !ok || !session.expiresTs.After(time.Now()) {
+		return nil, sql.ErrNoRows
+	}
+	return session.data, nil
+}
+
+func (s *mockStorage) AddSessionData(id string, data []byte, expiresTs time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions[id] = mockSession{data: data, expiresTs: expiresTs}
+	return nil
+}
+
+func (s *mockStorage) UpdateSessionData(id string, data []byte, expiresTs time.Time) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.sessions[id]; !ok {
+		return false, nil
+	}
+	s.sessions[id] = mockSession{data: data, expiresTs: expiresTs}
+	return true, nil
+}
+
+func (s *mockStorage) DeleteSessionData(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.sessions, id)
+	return nil
+}
+
+func (s *mockStorage) DeleteExpiredSessionsData(now time.Time) (int64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var count int64
+	for id, session := range s.sessions {
+		if !session.expiresTs.After(now) {
+			delete(s.sessions, id)
+			count++
+		}
+	}
+	return count, nil
+}
+
+type testRandomStringer struct {
+	idx int
+}
+
+func (s *testRandomStringer) randomString(_ int) string {
+	s.idx++
+	return "id" + strconv.Itoa(s.idx)
+}
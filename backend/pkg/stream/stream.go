@@ -0,0 +1,338 @@
+// Package stream broadcasts the new activity entries and the changes of the
+// instances status to the clients subscribed to them, as they are notified by
+// the database.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+)
+
+// Types of the events sent to the subscribers.
+const (
+	// EventActivity events hold a new activity entry.
+	EventActivity = "activity"
+
+	// EventInstanceStatus events hold the instances status stats of a group
+	// whose instances changed their status.
+	EventInstanceStatus = "instance_status"
+)
+
+const (
+	// DefaultDuration is the duration of the instances status stats sent when
+	// the subscriber doesn't ask for another one.
+	DefaultDuration = "1d"
+
+	// defaultFlushInterval is the interval between two instance status
+	// events of the same group, so that groups with many instances changing
+	// their status at once only send one event.
+	defaultFlushInterval = 2 * time.Second
+
+	// reconnectDelay is the time waited before listening again to the
+	// database notifications after the connection failed.
+	reconnectDelay = 5 * time.Second
+
+	// subscriberBuffer is the number of events buffered for each subscriber.
+	// Events are dropped for the subscribers not keeping up.
+	subscriberBuffer = 64
+)
+
+var (
+	l = logger.New("stream")
+
+	// ErrInvalidAPIInstance error indicates that no valid api instance was
+	// provided to the broker constructor.
+	ErrInvalidAPIInstance = errors.New("invalid api instance")
+)
+
+// Event represents an event sent to the subscribers, with its JSON encoded
+// data.
+type Event struct {
+	Type string
+	Data []byte
+}
+
+// InstanceStatus represents the data of the instance status events.
+type InstanceStatus struct {
+	ApplicationID string                    `json:"application_id"`
+	GroupID       string                    `json:"group_id"`
+	Duration      string                    `json:"duration"`
+	Stats         *api.InstancesStatusStats `json:"stats"`
+}
+
+// Filter represents the events a subscriber is interested in. Subscribers
+// only get the events of their team, optionally only the ones of an
+// application or group.
+type Filter struct {
+	TeamID  string
+	AppID   string
+	GroupID string
+
+	// Duration is the duration of the instances status stats sent, one of
+	// the durations accepted by the group instances stats.
+	Duration string
+}
+
+func (f Filter) matches(teamID, appID, groupID string) bool {
+	if f.TeamID != teamID {
+		return false
+	}
+	if f.AppID != "" && f.AppID != appID {
+		return false
+	}
+	if f.GroupID != "" && f.GroupID != groupID {
+		return false
+	}
+	return true
+}
+
+type subscriber struct {
+	filter Filter
+	events chan Event
+}
+
+// Broker represents a background job in charge of listening to the database
+// notifications and broadcasting them to the subscribers.
+type Broker struct {
+	api           *api.API
+	flushInterval time.Duration
+	stopCh        chan struct{}
+	stopOnce      sync.Once
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	dirtyGroups map[api.InstanceStatusNotification]struct{}
+}
+
+// New creates a new Broker instance.
+func New(db *api.API) (*Broker, error) {
+	if db == nil {
+		return nil, ErrInvalidAPIInstance
+	}
+
+	return &Broker{
+		api:           db,
+		flushInterval: defaultFlushInterval,
+		stopCh:        make(chan struct{}),
+		subscribers:   make(map[*subscriber]struct{}),
+		dirtyGroups:   make(map[api.InstanceStatusNotification]struct{}),
+	}, nil
+}
+
+// Start makes the broker start listening to the database notifications until
+// it's stopped. It reconnects to the database when the connection fails.
+func (b *Broker) Start() {
+	l.Debug().Msg("broker ready!")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-b.stopCh
+		cancel()
+	}()
+
+	go b.flushLoop()
+
+	channels := []string{api.NotificationChannelActivity, api.NotificationChannelInstanceStatus}
+	for {
+		err := b.api.ListenNotifications(ctx, channels, b.handleNotification)
+		if err != nil {
+			l.Error().Err(err).Msg("listening to database notifications")
+		}
+
+		select {
+		case <-time.After(reconnectDelay):
+		case <-b.stopCh:
+			return
+		}
+	}
+}
+
+// Stop stops the broker.
+func (b *Broker) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.stopCh)
+	})
+}
+
+// Subscribe registers a new subscriber for the events matching the filter
+// provided. It returns the channel the events are sent to, and a function to
+// call to unsubscribe.
+func (b *Broker) Subscribe(filter Filter) (<-chan Event, func()) {
+	if filter.Duration == "" {
+		filter.Duration = DefaultDuration
+	}
+	s := &subscriber{
+		filter: filter,
+		events: make(chan Event, subscriberBuffer),
+	}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	return s.events, func() {
+		b.mu.Lock()
+		delete(b.subscribers, s)
+		b.mu.Unlock()
+	}
+}
+
+func (b *Broker) hasSubscribers() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subscribers) > 0
+}
+
+func (b *Broker) handleNotification(channel, payload string) {
+	if !b.hasSubscribers() {
+		return
+	}
+
+	switch channel {
+	case api.NotificationChannelActivity:
+		var notification api.ActivityNotification
+		if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+			l.Error().Err(err).Str("payload", payload).Msg("decoding activity notification")
+			return
+		}
+		b.publishActivity(notification)
+	case api.NotificationChannelInstanceStatus:
+		var notification api.InstanceStatusNotification
+		if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+			l.Error().Err(err).Str("payload", payload).Msg("decoding instance status notification")
+			return
+		}
+		b.mu.Lock()
+		b.dirtyGroups[notification] = struct{}{}
+		b.mu.Unlock()
+	}
+}
+
+// publishActivity sends the activity entry notified to the subscribers
+// interested in it. The entry is only looked up when some subscribers are
+// interested in its team, application and group, as notified along with it.
+func (b *Broker) publishActivity(notification api.ActivityNotification) {
+	activityID := notification.ID
+	if notification.TeamID != "" && len(b.matchingSubscribers(notification.TeamID, notification.ApplicationID, notification.GroupID)) == 0 {
+		return
+	}
+
+	activityEntry, err := b.api.GetActivityEntry(activityID)
+	if err != nil {
+		l.Error().Err(err).Str("activityID", activityID).Msg("getting notified activity entry")
+		return
+	}
+	data, err := json.Marshal(activityEntry)
+	if err != nil {
+		l.Error().Err(err).Str("activityID", activityID).Msg("encoding activity entry")
+		return
+	}
+
+	event := Event{Type: EventActivity, Data: data}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subscribers {
+		if s.filter.matches(activityEntry.TeamID, activityEntry.AppID.String, activityEntry.GroupID.String) {
+			b.send(s, event)
+		}
+	}
+}
+
+func (b *Broker) flushLoop() {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.flush()
+		case <-b.stopCh:
+			return
+		}
+	}
+}
+
+// flush sends the instances status stats of the groups whose instances
+// changed their status since the last flush to the subscribers interested in
+// them. The stats of each group are computed once per duration.
+func (b *Broker) flush() {
+	b.mu.Lock()
+	groups := b.dirtyGroups
+	b.dirtyGroups = make(map[api.InstanceStatusNotification]struct{})
+	b.mu.Unlock()
+
+	for group := range groups {
+		teamID, err := b.api.GetAppTeamID(group.ApplicationID)
+		if err != nil {
+			l.Error().Err(err).Str("appID", group.ApplicationID).Msg("getting team of notified application")
+			continue
+		}
+
+		events := make(map[string]Event)
+		for _, s := range b.matchingSubscribers(teamID, group.ApplicationID, group.GroupID) {
+			event, ok := events[s.filter.Duration]
+			if !ok {
+				event, err = b.instanceStatusEvent(group, s.filter.Duration)
+				if err != nil {
+					l.Error().Err(err).Str("groupID", group.GroupID).Msg("getting instances status stats")
+					continue
+				}
+				events[s.filter.Duration] = event
+			}
+
+			b.mu.Lock()
+			if _, ok := b.subscribers[s]; ok {
+				b.send(s, event)
+			}
+			b.mu.Unlock()
+		}
+	}
+}
+
+func (b *Broker) matchingSubscribers(teamID, appID, groupID string) []*subscriber {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var subscribers []*subscriber
+	for s := range b.subscribers {
+		if s.filter.matches(teamID, appID, groupID) {
+			subscribers = append(subscribers, s)
+		}
+	}
+	return subscribers
+}
+
+func (b *Broker) instanceStatusEvent(group api.InstanceStatusNotification, duration string) (Event, error) {
+	stats, err := b.api.GetGroupInstancesStats(group.GroupID, duration)
+	if err != nil {
+		return Event{}, err
+	}
+	data, err := json.Marshal(InstanceStatus{
+		ApplicationID: group.ApplicationID,
+		GroupID:       group.GroupID,
+		Duration:      duration,
+		Stats:         stats,
+	})
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{Type: EventInstanceStatus, Data: data}, nil
+}
+
+// send sends the event provided to the subscriber without blocking, dropping
+// it if the subscriber isn't keeping up. It must be called with the lock held.
+func (b *Broker) send(s *subscriber, event Event) {
+	select {
+	case s.events <- event:
+	default:
+		l.Debug().Str("event", event.Type).Msg("dropping event for slow subscriber")
+	}
+}
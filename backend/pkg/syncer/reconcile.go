@@ -0,0 +1,266 @@
+package syncer
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/flatcar/go-omaha/omaha"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+const (
+	// reconcileProbeVersion is the version reported to the upstream when
+	// asking for the state of a channel. It's lower than any Flatcar release,
+	// so that the upstream lists all the floors of the channel.
+	reconcileProbeVersion = "0.0.0"
+
+	// maxReconcileRequests limits the number of requests sent to the upstream
+	// to collect the floors of a channel, as they are returned in batches.
+	maxReconcileRequests = 50
+
+	// upstreamStateMaxAge is how long the state of a channel upstream is
+	// reused while the channel keeps pointing to the same version, so that
+	// floors removed and packages pulled upstream are eventually noticed
+	// without probing the upstream on every check.
+	upstreamStateMaxAge = 6 * time.Hour
+)
+
+// upstreamManifest represents a manifest received from an upstream, along
+// with the update check it belongs to.
+type upstreamManifest struct {
+	manifest    *omaha.Manifest
+	update      *omaha.UpdateResponse
+	upstreamURL string
+}
+
+// upstreamChannelState represents the state of a channel upstream: the
+// package it points to and its complete set of floors.
+type upstreamChannelState struct {
+	target *upstreamManifest
+	floors []*upstreamManifest
+	// floorAware is set when the upstream supports floors, so that an empty
+	// set of floors can be trusted.
+	floorAware bool
+}
+
+// cachedChannelState represents the state of a channel upstream as fetched
+// when the channel was pointing to the version provided.
+type cachedChannelState struct {
+	state     *upstreamChannelState
+	version   string
+	fetchedAt time.Time
+}
+
+// getUpstreamChannelState returns the state of the channel provided upstream,
+// only asking the upstream for it again when the channel has been moved to
+// another version since it was last fetched, or when it's too old.
+func (s *Syncer) getUpstreamChannelState(descriptor channelDescriptor) (*upstreamChannelState, error) {
+	version := s.versions[descriptor]
+	if cached, ok := s.upstreamStates[descriptor]; ok && cached.version == version && time.Since(cached.fetchedAt) < upstreamStateMaxAge {
+		return cached.state, nil
+	}
+
+	state, err := s.fetchUpstreamChannelState(descriptor)
+	if err != nil {
+		return nil, err
+	}
+	s.upstreamStates[descriptor] = &cachedChannelState{state: state, version: version, fetchedAt: time.Now()}
+	return state, nil
+}
+
+// fetchUpstreamChannelState asks the upstream for the state of the channel
+// provided, pretending to be a client not running any version yet. Upstreams
+// return a limited number of floors per response, so requests are repeated
+// from the highest floor received until the target is reached. It returns nil
+// when the state of the channel could not be fully determined.
+func (s *Syncer) fetchUpstreamChannelState(descriptor channelDescriptor) (*upstreamChannelState, error) {
+	state := &upstreamChannelState{}
+	version := reconcileProbeVersion
+
+	for i := 0; i < maxReconcileRequests; i++ {
+		update, upstreamURL, err := s.doOmahaRequestWithFailover(descriptor, s.probeIDs[descriptor], version)
+		if err != nil {
+			return nil, err
+		}
+		if update.Status != "ok" || len(update.Manifests) == 0 {
+			return nil, nil
+		}
+
+		if state.target == nil {
+			target := findTargetManifest(update.Manifests)
+			if target == nil {
+				return nil, nil
+			}
+			state.target = &upstreamManifest{manifest: target, update: update, upstreamURL: upstreamURL}
+			state.floorAware = target.IsTarget
+		}
+
+		lastFloor := ""
+		for _, m := range update.Manifests {
+			if m.IsFloor {
+				state.floors = append(state.floors, &upstreamManifest{manifest: m, update: update, upstreamURL: upstreamURL})
+				lastFloor = m.Version
+			}
+		}
+		if lastFloor == "" || lastFloor == state.target.manifest.Version || lastFloor == version {
+			return state, nil
+		}
+		version = lastFloor
+	}
+
+	l.Warn().
+		Str("channel", descriptor.name).
+		Str("arch", descriptor.arch.String()).
+		Msg("fetchUpstreamChannelState - too many floors upstream, not reconciling channel")
+	return nil, nil
+}
+
+// getOrCreateUpstreamPackage gets or creates the package of the manifest
+// provided, recording the upstream it was received from.
+func (s *Syncer) getOrCreateUpstreamPackage(descriptor channelDescriptor, um *upstreamManifest) (*api.Package, error) {
+	s.activeUpstream = um.upstreamURL
+	return s.getOrCreatePackage(descriptor, um.manifest, um.update)
+}
+
+// reconcileChannel makes the synced channel provided match the state of the
+// channel upstream. Floors no longer listed upstream are removed, and when
+// the package the channel points to has been pulled upstream, the channel is
+// moved back to the upstream package and the pulled one is blacklisted for
+// the channel.
+func (s *Syncer) reconcileChannel(descriptor channelDescriptor) error {
+	state, err := s.getUpstreamChannelState(descriptor)
+	if err != nil || state == nil {
+		return err
+	}
+
+	if state.floorAware {
+		if err := s.reconcileFloors(descriptor, state); err != nil {
+			return err
+		}
+	}
+
+	return s.reconcilePulledPackage(descriptor, state)
+}
+
+// reconcileFloors marks as floors of the channel all the floors listed
+// upstream, and removes the ones it set that aren't listed anymore.
+func (s *Syncer) reconcileFloors(descriptor channelDescriptor, state *upstreamChannelState) error {
+	channelID := s.channelsIDs[descriptor]
+	upstreamFloors := make(map[string]struct{}, len(state.floors))
+
+	for _, floor := range state.floors {
+		pkg, err := s.getOrCreateUpstreamPackage(descriptor, floor)
+		if err != nil {
+			return fmt.Errorf("failed to process floor package %s: %w", floor.manifest.Version, err)
+		}
+		if err := s.markPackageAsFloor(descriptor, pkg, floor.manifest); err != nil {
+			return fmt.Errorf("failed to mark package %s as floor: %w", floor.manifest.Version, err)
+		}
+		upstreamFloors[floor.manifest.Version] = struct{}{}
+	}
+
+	// Floors set by users are left alone, even if upstream lists them too.
+	localFloors, err := s.api.GetChannelSyncedFloorPackages(channelID)
+	if err != nil {
+		return err
+	}
+	for _, floor := range localFloors {
+		if _, ok := upstreamFloors[floor.Version]; ok {
+			continue
+		}
+		if err := s.admin.RemoveChannelPackageFloor(channelID, floor.ID); err != nil {
+			return fmt.Errorf("failed to remove floor %s: %w", floor.Version, err)
+		}
+
+		l.Info().
+			Str("version", floor.Version).
+			Str("channel", descriptor.name).
+			Str("arch", descriptor.arch.String()).
+			Msg("reconcileFloors - removed floor not listed upstream anymore")
+
+		if err := s.admin.AddChannelFloorRemovedActivity(floor.Version, flatcarAppID, channelID); err != nil {
+			l.Error().Err(err).Msg("reconcileFloors - could not add channel activity")
+		}
+	}
+
+	return nil
+}
+
+// reconcilePulledPackage checks whether the package the channel points to is
+// newer than the one the channel points to upstream, which happens when a
+// package is pulled upstream. In that case, the channel is moved to the
+// upstream package and the pulled one is blacklisted for the channel. As this
+// can't be undone automatically, it's only done when the channel still points
+// to the package the syncer set, and when the upstream reporting the older
+// package is the one the current package was received from or the primary
+// one, so that a lagging mirror doesn't roll the channel back.
+func (s *Syncer) reconcilePulledPackage(descriptor channelDescriptor, state *upstreamChannelState) error {
+	upstreamSemver, err := semver.Make(state.target.manifest.Version)
+	if err != nil {
+		return nil
+	}
+	currentSemver, err := semver.Make(s.versions[descriptor])
+	if err != nil || !upstreamSemver.LT(currentSemver) {
+		return nil
+	}
+
+	channel, err := s.api.GetChannel(s.channelsIDs[descriptor])
+	if err != nil {
+		return fmt.Errorf("getting channel: %w", err)
+	}
+	if !channel.PackageID.Valid || channel.PackageID.String != s.packagesIDs[descriptor] {
+		l.Debug().
+			Str("channel", descriptor.name).
+			Str("arch", descriptor.arch.String()).
+			Msg("reconcilePulledPackage - channel not pointing to the package set by the syncer, skipping")
+		return nil
+	}
+	pulledPkg, err := s.api.GetPackage(channel.PackageID.String)
+	if err != nil {
+		return fmt.Errorf("getting channel package: %w", err)
+	}
+
+	reportedBy := state.target.upstreamURL
+	if reportedBy != pulledPkg.UpstreamURL.String && !s.isPrimaryUpstream(reportedBy) {
+		l.Info().
+			Str("version", pulledPkg.Version).
+			Str("channel", descriptor.name).
+			Str("arch", descriptor.arch.String()).
+			Str("upstream", reportedBy).
+			Str("upstreamVersion", state.target.manifest.Version).
+			Msg("reconcilePulledPackage - older package reported by a secondary upstream, ignoring it")
+		return nil
+	}
+
+	targetPkg, err := s.getOrCreateUpstreamPackage(descriptor, state.target)
+	if err != nil {
+		return fmt.Errorf("failed to process package %s: %w", state.target.manifest.Version, err)
+	}
+	if err := s.updateChannelToPackage(descriptor, targetPkg); err != nil {
+		return err
+	}
+
+	if slices.Contains(pulledPkg.ChannelsBlacklist, channel.ID) {
+		return nil
+	}
+	pulledPkg.ChannelsBlacklist = append(pulledPkg.ChannelsBlacklist, channel.ID)
+	if err := s.admin.UpdatePackage(pulledPkg); err != nil {
+		return fmt.Errorf("failed to blacklist package %s: %w", pulledPkg.Version, err)
+	}
+
+	l.Warn().
+		Str("version", pulledPkg.Version).
+		Str("channel", descriptor.name).
+		Str("arch", descriptor.arch.String()).
+		Str("newVersion", targetPkg.Version).
+		Msg("reconcilePulledPackage - package pulled upstream, blacklisted it for the channel")
+
+	if err := s.admin.AddChannelPackageBlacklistedActivity(pulledPkg.Version, flatcarAppID, channel.ID); err != nil {
+		l.Error().Err(err).Msg("reconcilePulledPackage - could not add channel activity")
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package syncer
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/flatcar/go-omaha/omaha"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+type testUpstreamPackage struct {
+	version string
+	size    uint64
+	isFloor bool
+}
+
+// newFloorsUpstream returns an upstream serving the packages provided, the
+// last one being the target. Like Nebraska, it only lists the floors newer
+// than the version reported by the client, one per response.
+func newFloorsUpstream(t *testing.T, requests *atomic.Int32, pkgs ...testUpstreamPackage) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		req := &omaha.Request{}
+		require.NoError(t, xml.NewDecoder(r.Body).Decode(req))
+		clientVersion := semver.MustParse(req.Apps[0].Version)
+
+		resp := omaha.NewResponse()
+		app := resp.AddApp(flatcarAppID, omaha.AppOK)
+		target := pkgs[len(pkgs)-1]
+		if !clientVersion.LT(semver.MustParse(target.version)) {
+			app.AddUpdateCheck(omaha.NoUpdate)
+			_ = xml.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		update := app.AddUpdateCheck(omaha.UpdateOK)
+		update.AddURL("https://example.com")
+		floorListed := false
+		for i, pkg := range pkgs {
+			isTarget := i == len(pkgs)-1
+			if !isTarget && (floorListed || !clientVersion.LT(semver.MustParse(pkg.version))) {
+				continue
+			}
+			m := update.AddManifest(pkg.version)
+			m.Packages = []*omaha.Package{{Name: "flatcar-" + pkg.version + ".gz", SHA1: "hash" + pkg.version[:4], Size: pkg.size}}
+			m.Actions = []*omaha.Action{{Event: "postinstall", SHA256: "dGVzdHNoYTI1Ng=="}}
+			m.IsFloor = pkg.isFloor
+			m.IsTarget = isTarget
+			floorListed = floorListed || pkg.isFloor
+		}
+		_ = xml.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSyncer_ReconcileChannel(t *testing.T) {
+	var requests atomic.Int32
+	upstream := newFloorsUpstream(t, &requests,
+		testUpstreamPackage{version: "1000.0.0", size: 1000, isFloor: true},
+		testUpstreamPackage{version: "1200.0.0", size: 1200, isFloor: true},
+		testUpstreamPackage{version: "2000.0.0", size: 2000},
+	)
+	syncer := newForTest(t, &Config{FlatcarUpdatesURL: upstream.URL})
+	a := syncer.api
+	t.Cleanup(func() { a.Close() })
+
+	tGroup := setupFlatcarAppStableGroup(t, a)
+	tChannel := tGroup.Channel
+	require.NoError(t, syncer.initialize())
+	desc := channelDescriptor{name: tChannel.Name, arch: tChannel.Arch}
+
+	// Local state: floors 1000.0.0 and 1500.0.0, channel pointing to
+	// 3000.0.0, which has been pulled upstream.
+	require.NoError(t, syncer.processMultiManifestUpdate(desc, createMultiManifestUpdate("1000.0.0", "1500.0.0", "3000.0.0")))
+	// A floor set by a user, which upstream doesn't know about.
+	manualPkg, err := syncer.admin.AddPackage(&api.Package{Type: api.PkgTypeFlatcar, URL: "https://example.com", Version: "1100.0.0", ApplicationID: flatcarAppID, Arch: tChannel.Arch})
+	require.NoError(t, err)
+	require.NoError(t, syncer.admin.AddChannelPackageFloor(tChannel.ID, manualPkg.ID, null.StringFrom("manual floor")))
+
+	require.NoError(t, syncer.reconcileChannel(desc))
+
+	floors, err := a.GetChannelFloorPackages(tChannel.ID)
+	require.NoError(t, err)
+	require.Len(t, floors, 3)
+	assert.Equal(t, "1000.0.0", floors[0].Version)
+	assert.Equal(t, "1100.0.0", floors[1].Version)
+	assert.Equal(t, "1200.0.0", floors[2].Version)
+
+	channel, err := a.GetChannel(tChannel.ID)
+	require.NoError(t, err)
+	require.NotNil(t, channel.Package)
+	assert.Equal(t, "2000.0.0", channel.Package.Version)
+	assert.Equal(t, "2000.0.0", syncer.versions[desc])
+
+	pulledPkg, err := a.GetPackageByVersionAndArch(flatcarAppID, "3000.0.0", tChannel.Arch)
+	require.NoError(t, err)
+	assert.Contains(t, pulledPkg.ChannelsBlacklist, tChannel.ID)
+
+	tApp, err := a.GetApp(flatcarAppID)
+	require.NoError(t, err)
+	activities, err := a.GetActivity(tApp.TeamID, api.ActivityQueryParams{AppID: flatcarAppID, Page: 1, PerPage: 50})
+	require.NoError(t, err)
+	classes := make(map[int]int)
+	for _, activity := range activities {
+		classes[activity.Class]++
+	}
+	assert.Equal(t, 3, classes[api.ActivityChannelFloorAdded])
+	assert.Equal(t, 1, classes[api.ActivityChannelFloorRemoved])
+	assert.Equal(t, 1, classes[api.ActivityChannelPackageBlacklisted])
+
+	// Reconciling again doesn't change anything.
+	require.NoError(t, syncer.reconcileChannel(desc))
+	floors, err = a.GetChannelFloorPackages(tChannel.ID)
+	require.NoError(t, err)
+	assert.Len(t, floors, 3)
+
+	// The upstream isn't asked again while the channel points to the same
+	// version.
+	probes := requests.Load()
+	require.NoError(t, syncer.reconcileChannel(desc))
+	assert.Equal(t, probes, requests.Load())
+}
+
+func TestSyncer_ReconcilePulledPackage_Skipped(t *testing.T) {
+	primaryDown := false
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if primaryDown {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(primary.Close)
+	var requests atomic.Int32
+	// A lagging mirror, still pointing to an older package.
+	mirror := newFloorsUpstream(t, &requests, testUpstreamPackage{version: "2000.0.0", size: 2000})
+
+	syncer := newForTest(t, &Config{FlatcarUpdatesURL: primary.URL + "," + mirror.URL})
+	syncer.retryAttempts = 1
+	syncer.retryBaseDelay = time.Minute
+	a := syncer.api
+	t.Cleanup(func() { a.Close() })
+
+	tGroup := setupFlatcarAppStableGroup(t, a)
+	tChannel := tGroup.Channel
+	require.NoError(t, syncer.initialize())
+	desc := channelDescriptor{name: tChannel.Name, arch: tChannel.Arch}
+
+	syncer.activeUpstream = primary.URL
+	require.NoError(t, syncer.processMultiManifestUpdate(desc, createMultiManifestUpdate("3000.0.0")))
+
+	// The package received from the primary isn't pulled because the mirror
+	// doesn't know about it yet.
+	primaryDown = true
+	require.NoError(t, syncer.reconcileChannel(desc))
+	assert.Positive(t, requests.Load())
+
+	channel, err := a.GetChannel(tChannel.ID)
+	require.NoError(t, err)
+	require.NotNil(t, channel.Package)
+	assert.Equal(t, "3000.0.0", channel.Package.Version)
+	assert.Empty(t, channel.Package.ChannelsBlacklist)
+
+	// A package set by a user isn't pulled either, even when the primary
+	// upstream reports an older one.
+	manualPkg, err := syncer.admin.AddPackage(&api.Package{Type: api.PkgTypeFlatcar, URL: "https://example.com", Version: "4000.0.0", ApplicationID: flatcarAppID, Arch: tChannel.Arch})
+	require.NoError(t, err)
+	channel.PackageID = null.StringFrom(manualPkg.ID)
+	require.NoError(t, syncer.admin.UpdateChannel(channel))
+	syncer.versions[desc] = manualPkg.Version
+	syncer.upstreams = parseUpstreams(mirror.URL)
+
+	require.NoError(t, syncer.reconcileChannel(desc))
+	channel, err = a.GetChannel(tChannel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, manualPkg.ID, channel.PackageID.String)
+	manualPkg, err = a.GetPackage(manualPkg.ID)
+	require.NoError(t, err)
+	assert.Empty(t, manualPkg.ChannelsBlacklist)
+}
@@ -0,0 +1,200 @@
+package syncer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/flatcar/go-omaha/omaha"
+)
+
+const (
+	defaultSignatureSuffix = ".sig"
+	maxSignatureSize       = 64 * 1024
+)
+
+// ErrInvalidSignature error indicates that a payload detached signature
+// could not be verified with any of the trusted keys.
+var ErrInvalidSignature = errors.New("invalid payload signature")
+
+// payloadDigests holds the digests of a package payload computed while
+// downloading it.
+type payloadDigests struct {
+	sha1   []byte
+	sha256 []byte
+	sha512 []byte
+}
+
+// LoadTrustedKeys reads the PEM encoded (PKIX) public keys found in the files
+// provided. Each file may contain several keys. RSA, ECDSA and ed25519 keys
+// are supported.
+func LoadTrustedKeys(paths []string) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("reading trusted key %s: %w", p, err)
+		}
+
+		found := 0
+		for {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				break
+			}
+			if block.Type != "PUBLIC KEY" {
+				continue
+			}
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing trusted key %s: %w", p, err)
+			}
+			switch key.(type) {
+			case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+			default:
+				return nil, fmt.Errorf("unsupported trusted key type %T in %s", key, p)
+			}
+			keys = append(keys, key)
+			found++
+		}
+		if found == 0 {
+			return nil, fmt.Errorf("no public keys found in %s", p)
+		}
+	}
+
+	return keys, nil
+}
+
+// verifyDigestSignature checks the signature provided against the payload
+// digests using the key provided. RSA (PKCS #1 v1.5) and ECDSA signatures are
+// expected over the payload SHA-256 digest, as produced by `openssl dgst
+// -sha256 -sign`. Ed25519 signatures are expected in the pre-hashed variant
+// (Ed25519ph) over the payload SHA-512 digest.
+func verifyDigestSignature(key crypto.PublicKey, digests *payloadDigests, signature []byte) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digests.sha256, signature) == nil
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digests.sha256, signature)
+	case ed25519.PublicKey:
+		return ed25519.VerifyWithOptions(k, digests.sha512, signature, &ed25519.Options{Hash: crypto.SHA512}) == nil
+	}
+	return false
+}
+
+// verifySignature checks whether the signature provided, either raw or base64
+// encoded, was made by any of the trusted keys.
+func (s *Syncer) verifySignature(digests *payloadDigests, signature []byte) bool {
+	candidates := [][]byte{signature}
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature))); err == nil {
+		candidates = append(candidates, decoded)
+	}
+
+	for _, key := range s.trustedKeys {
+		for _, sig := range candidates {
+			if verifyDigestSignature(key, digests, sig) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifyPayloadSignature fetches the detached signature of the payload
+// provided and verifies it against the trusted keys. Nothing is verified when
+// no trusted keys are configured.
+func (s *Syncer) verifyPayloadSignature(update *omaha.UpdateResponse, pkgName string, digests *payloadDigests) error {
+	if len(s.trustedKeys) == 0 {
+		return nil
+	}
+
+	signature, err := s.fetchSignature(update, pkgName)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidSignature, pkgName, err)
+	}
+	if !s.verifySignature(digests, signature) {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, pkgName)
+	}
+
+	l.Debug().Str("package", pkgName).Msg("verifyPayloadSignature - payload signature verified")
+	return nil
+}
+
+// fetchSignature downloads the detached signature of the payload provided,
+// which is expected next to the payload with the signature suffix appended.
+func (s *Syncer) fetchSignature(update *omaha.UpdateResponse, pkgName string) ([]byte, error) {
+	sigURL, err := url.Parse(update.URLs[0].CodeBase)
+	if err != nil {
+		return nil, err
+	}
+	sigURL.Path = path.Join(sigURL.Path, pkgName+s.signatureSuffix)
+
+	resp, err := s.httpClient.Get(sigURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received unexpected status code (%d) fetching signature", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxSignatureSize))
+}
+
+// verifyRemotePayloads downloads the payloads of the manifest provided without
+// storing them, verifying their hashes and signatures. It's used when
+// signatures must be verified but packages are not hosted by Nebraska.
+func (s *Syncer) verifyRemotePayloads(manifest *omaha.Manifest, update *omaha.UpdateResponse) error {
+	for _, omahaPkg := range manifest.Packages {
+		digests, err := s.fetchPayload(update, omahaPkg.Name, io.Discard)
+		if err != nil {
+			return err
+		}
+		if err := checkPayloadDigests(digests, omahaPkg.SHA1, omahaPkg.SHA256); err != nil {
+			return err
+		}
+		if err := s.verifyPayloadSignature(update, omahaPkg.Name, digests); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rejectPackage records an activity error entry when the error provided is a
+// signature verification failure, so that rejected packages are visible to
+// users. The error is returned as is.
+func (s *Syncer) rejectPackage(descriptor channelDescriptor, version string, err error) error {
+	if !errors.Is(err, ErrInvalidSignature) {
+		return err
+	}
+
+	l.Error().Err(err).
+		Str("channel", descriptor.name).
+		Str("arch", descriptor.arch.String()).
+		Str("version", version).
+		Msg("rejectPackage - package payload signature verification failed, not importing it")
+
+	if activityErr := s.admin.AddPackageSignatureInvalidActivity(version, flatcarAppID, s.channelsIDs[descriptor]); activityErr != nil {
+		l.Error().Err(activityErr).Msg("rejectPackage - could not add channel activity")
+	}
+
+	return err
+}
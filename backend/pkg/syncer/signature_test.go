@@ -0,0 +1,143 @@
+package syncer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flatcar/go-omaha/omaha"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func digestsFor(data []byte) *payloadDigests {
+	sum256 := sha256.Sum256(data)
+	sum512 := sha512.Sum512(data)
+	return &payloadDigests{sha256: sum256[:], sha512: sum512[:]}
+}
+
+func writePublicKeys(t *testing.T, keys ...crypto.PublicKey) string {
+	t.Helper()
+	var data []byte
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	keyPath := filepath.Join(t.TempDir(), "trusted.pem")
+	require.NoError(t, os.WriteFile(keyPath, data, 0o600))
+	return keyPath
+}
+
+func TestLoadTrustedKeys(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := LoadTrustedKeys([]string{writePublicKeys(t, edPub, &ecKey.PublicKey)})
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	emptyPath := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(emptyPath, []byte("no keys here"), 0o600))
+	_, err = LoadTrustedKeys([]string{emptyPath})
+	assert.Error(t, err)
+
+	_, err = LoadTrustedKeys([]string{filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
+
+func TestVerifyDigestSignature(t *testing.T) {
+	payload := []byte("flatcar update payload")
+	digests := digestsFor(payload)
+	otherDigests := digestsFor([]byte("tampered payload"))
+
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSig, err := edPriv.Sign(rand.Reader, digests.sha512, &ed25519.Options{Hash: crypto.SHA512})
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digests.sha256)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digests.sha256)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       crypto.PublicKey
+		signature []byte
+	}{
+		{name: "ed25519", key: edPub, signature: edSig},
+		{name: "ecdsa", key: &ecKey.PublicKey, signature: ecSig},
+		{name: "rsa", key: &rsaKey.PublicKey, signature: rsaSig},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, verifyDigestSignature(tc.key, digests, tc.signature))
+			assert.False(t, verifyDigestSignature(tc.key, otherDigests, tc.signature))
+		})
+	}
+}
+
+func TestDownloadPackage_Signature(t *testing.T) {
+	payload := []byte("flatcar update payload")
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signature, err := priv.Sign(rand.Reader, digestsFor(payload).sha512, &ed25519.Options{Hash: crypto.SHA512})
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/good.gz", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(payload) })
+	mux.HandleFunc("/good.gz.sig", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(signature)))
+	})
+	mux.HandleFunc("/bad.gz", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte("tampered")) })
+	mux.HandleFunc("/bad.gz.sig", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(signature) })
+	mux.HandleFunc("/unsigned.gz", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(payload) })
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	s := &Syncer{
+		packagesPath:    t.TempDir(),
+		httpClient:      server.Client(),
+		trustedKeys:     []crypto.PublicKey{pub},
+		signatureSuffix: defaultSignatureSuffix,
+	}
+	update := &omaha.UpdateResponse{URLs: []*omaha.URL{{CodeBase: server.URL}}}
+
+	err = s.downloadPackage(update, "good.gz", "", "", "good.gz")
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(s.packagesPath, "good.gz"))
+
+	for _, name := range []string{"bad.gz", "unsigned.gz"} {
+		err = s.downloadPackage(update, name, "", "", name)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.NoFileExists(t, filepath.Join(s.packagesPath, name))
+	}
+
+	// Remote payloads are verified the same way when packages aren't hosted.
+	manifest := &omaha.Manifest{Packages: []*omaha.Package{{Name: "good.gz"}}}
+	assert.NoError(t, s.verifyRemotePayloads(manifest, update))
+	manifest.Packages = append(manifest.Packages, &omaha.Package{Name: "bad.gz"})
+	assert.ErrorIs(t, s.verifyRemotePayloads(manifest, update), ErrInvalidSignature)
+}
@@ -0,0 +1,102 @@
+package syncer
+
+import (
+	"sort"
+	"time"
+)
+
+// ChannelStatus represents the state of the last sync attempt for a given
+// Flatcar channel/arch combination.
+type ChannelStatus struct {
+	Channel         string    `json:"channel"`
+	Arch            string    `json:"arch"`
+	LastCheck       time.Time `json:"last_check"`
+	LastSeenVersion string    `json:"last_seen_version"`
+	LastError       string    `json:"last_error"`
+}
+
+// Status represents a snapshot of the syncer state.
+type Status struct {
+	Running   bool             `json:"running"`
+	NextCheck time.Time        `json:"next_check"`
+	Channels  []ChannelStatus  `json:"channels"`
+	Upstreams []UpstreamStatus `json:"upstreams"`
+}
+
+// Status returns a snapshot of the syncer state, including the result of the
+// last check for every synced channel, the time of the next scheduled run and
+// the health of the upstreams.
+func (s *Syncer) Status() Status {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	status := Status{
+		Running:   s.running,
+		NextCheck: s.nextCheck,
+		Channels:  make([]ChannelStatus, 0, len(s.channelsStatus)),
+		Upstreams: s.upstreamsStatus(),
+	}
+	for _, cs := range s.channelsStatus {
+		status.Channels = append(status.Channels, *cs)
+	}
+	sort.Slice(status.Channels, func(i, j int) bool {
+		if status.Channels[i].Channel != status.Channels[j].Channel {
+			return status.Channels[i].Channel < status.Channels[j].Channel
+		}
+		return status.Channels[i].Arch < status.Channels[j].Arch
+	})
+
+	return status
+}
+
+// TriggerSync asks the syncer to check for updates right away instead of
+// waiting for the next tick. It returns false if a run was already queued.
+func (s *Syncer) TriggerSync() bool {
+	select {
+	case s.runCh <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// setRunning records whether a check for updates is in progress.
+func (s *Syncer) setRunning(running bool) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	s.running = running
+}
+
+// scheduleNextCheck records when the next periodic check is due.
+func (s *Syncer) scheduleNextCheck() {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	s.nextCheck = time.Now().UTC().Add(s.checkFrequency)
+}
+
+// recordCheck stores the outcome of a check for updates for the channel
+// provided. An empty upstreamVersion keeps the previously seen one.
+func (s *Syncer) recordCheck(descriptor channelDescriptor, upstreamVersion string, checkErr error) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	cs, ok := s.channelsStatus[descriptor]
+	if !ok {
+		cs = &ChannelStatus{
+			Channel: descriptor.name,
+			Arch:    descriptor.arch.String(),
+		}
+		s.channelsStatus[descriptor] = cs
+	}
+
+	cs.LastCheck = time.Now().UTC()
+	if upstreamVersion != "" {
+		cs.LastSeenVersion = upstreamVersion
+	}
+	cs.LastError = ""
+	if checkErr != nil {
+		cs.LastError = checkErr.Error()
+	}
+}
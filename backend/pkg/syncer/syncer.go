@@ -2,8 +2,10 @@ package syncer
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/sha1"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/xml"
@@ -15,8 +17,10 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/flatcar/go-omaha/omaha"
@@ -57,23 +61,39 @@ type channelDescriptor struct {
 // to them). When hostPackages is enabled, packages payloads will be downloaded
 // into packagesPath and package url/filename will be rewritten.
 type Syncer struct {
-	api               *api.API
-	admin             *admin.Service
-	hostPackages      bool
-	packagesPath      string
-	packagesURL       string
-	checkFrequency    time.Duration
-	flatcarUpdatesURL string
-	stopCh            chan struct{}
-	machinesIDs       map[channelDescriptor]string
-	bootIDs           map[channelDescriptor]string
-	versions          map[channelDescriptor]string
-	channelsIDs       map[channelDescriptor]string
-	httpClient        *http.Client
-	ticker            *time.Ticker
+	api             *api.API
+	admin           *admin.Service
+	hostPackages    bool
+	packagesPath    string
+	packagesURL     string
+	checkFrequency  time.Duration
+	upstreams       []*upstream
+	activeUpstream  string
+	retryAttempts   int
+	retryBaseDelay  time.Duration
+	stopCh          chan struct{}
+	machinesIDs     map[channelDescriptor]string
+	probeIDs        map[channelDescriptor]string
+	bootIDs         map[channelDescriptor]string
+	versions        map[channelDescriptor]string
+	packagesIDs     map[channelDescriptor]string
+	channelsIDs     map[channelDescriptor]string
+	upstreamStates  map[channelDescriptor]*cachedChannelState
+	httpClient      *http.Client
+	ticker          *time.Ticker
+	runCh           chan struct{}
+	trustedKeys     []crypto.PublicKey
+	signatureSuffix string
+
+	statusMu       sync.RWMutex
+	running        bool
+	nextCheck      time.Time
+	channelsStatus map[channelDescriptor]*ChannelStatus
 }
 
 // Config represents the configuration used to create a new Syncer instance.
+// FlatcarUpdatesURL may hold a comma-separated list of upstream URLs, which
+// are tried in order.
 type Config struct {
 	API               *api.API
 	Admin             *admin.Service
@@ -83,6 +103,10 @@ type Config struct {
 	FlatcarUpdatesURL string
 	CheckFrequency    time.Duration
 	HTTPClient        *http.Client
+	TrustedKeys       []crypto.PublicKey
+	SignatureSuffix   string
+	RetryAttempts     int
+	RetryBaseDelay    time.Duration
 }
 
 // Setup creates a new syncer from config and db connection, and returns it.
@@ -92,8 +116,21 @@ func Setup(conf *config.Config, db *api.API, adminSvc *admin.Service) (*Syncer,
 		return nil, fmt.Errorf("invalid Check Frequency value: %w", err)
 	}
 
+	retryBaseDelay, err := time.ParseDuration(conf.SyncerRetryDelayVal)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syncer retry delay value: %w", err)
+	}
+
 	httpClient := tlsutil.NewHTTPClient(conf.CACertPool)
 
+	var trustedKeys []crypto.PublicKey
+	if conf.SyncerTrustedKeys != "" {
+		trustedKeys, err = LoadTrustedKeys(strings.Split(conf.SyncerTrustedKeys, ","))
+		if err != nil {
+			return nil, fmt.Errorf("invalid syncer trusted keys: %w", err)
+		}
+	}
+
 	if conf.SyncerPkgsURL == "" && conf.HostFlatcarPackages {
 		conf.SyncerPkgsURL = conf.NebraskaURL + "/flatcar/"
 	}
@@ -106,7 +143,11 @@ func Setup(conf *config.Config, db *api.API, adminSvc *admin.Service) (*Syncer,
 		PackagesURL:       conf.SyncerPkgsURL,
 		FlatcarUpdatesURL: conf.FlatcarUpdatesURL,
 		CheckFrequency:    checkFrequency,
+		RetryAttempts:     conf.SyncerRetryAttempts,
+		RetryBaseDelay:    retryBaseDelay,
 		HTTPClient:        httpClient,
+		TrustedKeys:       trustedKeys,
+		SignatureSuffix:   conf.SyncerSignatureSuffix,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error setting up syncer: %w", err)
@@ -131,25 +172,46 @@ func New(conf *Config) (*Syncer, error) {
 	}
 
 	s := &Syncer{
-		api:               conf.API,
-		admin:             conf.Admin,
-		hostPackages:      conf.HostPackages,
-		packagesPath:      conf.PackagesPath,
-		packagesURL:       conf.PackagesURL,
-		flatcarUpdatesURL: conf.FlatcarUpdatesURL,
-		checkFrequency:    conf.CheckFrequency,
-		stopCh:            make(chan struct{}),
-		machinesIDs:       make(map[channelDescriptor]string, 8),
-		bootIDs:           make(map[channelDescriptor]string, 8),
-		channelsIDs:       make(map[channelDescriptor]string, 8),
-		versions:          make(map[channelDescriptor]string, 8),
-		httpClient:        conf.HTTPClient,
+		api:             conf.API,
+		admin:           conf.Admin,
+		hostPackages:    conf.HostPackages,
+		packagesPath:    conf.PackagesPath,
+		packagesURL:     conf.PackagesURL,
+		upstreams:       parseUpstreams(conf.FlatcarUpdatesURL),
+		retryAttempts:   conf.RetryAttempts,
+		retryBaseDelay:  conf.RetryBaseDelay,
+		checkFrequency:  conf.CheckFrequency,
+		stopCh:          make(chan struct{}),
+		machinesIDs:     make(map[channelDescriptor]string, 8),
+		probeIDs:        make(map[channelDescriptor]string, 8),
+		bootIDs:         make(map[channelDescriptor]string, 8),
+		channelsIDs:     make(map[channelDescriptor]string, 8),
+		versions:        make(map[channelDescriptor]string, 8),
+		packagesIDs:     make(map[channelDescriptor]string, 8),
+		upstreamStates:  make(map[channelDescriptor]*cachedChannelState, 8),
+		httpClient:      conf.HTTPClient,
+		runCh:           make(chan struct{}, 1),
+		trustedKeys:     conf.TrustedKeys,
+		signatureSuffix: conf.SignatureSuffix,
+		channelsStatus:  make(map[channelDescriptor]*ChannelStatus, 8),
 	}
 
 	if s.httpClient == nil {
 		s.httpClient = &http.Client{}
 	}
 
+	if s.signatureSuffix == "" {
+		s.signatureSuffix = defaultSignatureSuffix
+	}
+
+	if s.retryAttempts <= 0 {
+		s.retryAttempts = defaultRetryAttempts
+	}
+
+	if s.retryBaseDelay <= 0 {
+		s.retryBaseDelay = defaultRetryBaseDelay
+	}
+
 	if err := s.initialize(); err != nil {
 		return nil, err
 	}
@@ -162,6 +224,7 @@ func New(conf *Config) (*Syncer, error) {
 func (s *Syncer) Start() {
 	l.Debug().Msg("syncer ready!")
 	s.ticker = time.NewTicker(s.checkFrequency)
+	s.scheduleNextCheck()
 
 	_ = s.checkForUpdates()
 
@@ -169,6 +232,10 @@ L:
 	for {
 		select {
 		case <-s.ticker.C:
+			s.scheduleNextCheck()
+			_ = s.checkForUpdates()
+		case <-s.runCh:
+			l.Debug().Msg("manual sync requested")
 			_ = s.checkForUpdates()
 		case <-s.stopCh:
 			break L
@@ -182,7 +249,7 @@ L:
 func (s *Syncer) Stop() {
 	s.ticker.Stop()
 	l.Debug().Msg("stopping syncer..")
-	s.stopCh <- struct{}{}
+	close(s.stopCh)
 }
 
 // initialize does some initial setup to prepare the syncer, checking in
@@ -201,11 +268,17 @@ func (s *Syncer) initialize() error {
 				arch: c.Arch,
 			}
 			s.machinesIDs[descriptor] = "{" + uuid.New().String() + "}"
+			s.probeIDs[descriptor] = "{" + uuid.New().String() + "}"
 			s.bootIDs[descriptor] = "{" + uuid.New().String() + "}"
 			s.channelsIDs[descriptor] = c.ID
 
 			if c.Package != nil {
 				s.versions[descriptor] = c.Package.Version
+				// Only packages received from an upstream are considered
+				// to have been set by the syncer.
+				if c.Package.UpstreamURL.Valid {
+					s.packagesIDs[descriptor] = c.Package.ID
+				}
 			} else {
 				s.versions[descriptor] = "766.0.0"
 			}
@@ -218,37 +291,57 @@ func (s *Syncer) initialize() error {
 // checkForUpdates polls the public Flatcar servers looking for updates in the
 // official channels (stable, beta, alpha, edge) sending Omaha requests. When an
 // update is received we'll process it, creating packages and updating channels
-// in Nebraska as needed.
+// in Nebraska as needed. The errors of all the channels are returned together.
 func (s *Syncer) checkForUpdates() error {
+	s.setRunning(true)
+	defer s.setRunning(false)
+
+	// An error checking a channel doesn't prevent checking the other ones.
+	var errs []error
 	for descriptor, currentVersion := range s.versions {
 		l.Debug().Str("channel", descriptor.name).Str("arch", descriptor.arch.String()).Str("currentVersion", currentVersion).Msg("checking for updates")
 
-		update, err := s.doOmahaRequest(descriptor, currentVersion)
+		update, upstreamURL, err := s.doOmahaRequestWithFailover(descriptor, s.machinesIDs[descriptor], currentVersion)
+		if errors.Is(err, errStopped) {
+			return errors.Join(errs...)
+		}
 		if err != nil {
-			return err
+			s.recordCheck(descriptor, "", err)
+			errs = append(errs, fmt.Errorf("checking channel %s (%s): %w", descriptor.name, descriptor.arch, err))
+			continue
 		}
+		s.activeUpstream = upstreamURL
+		seenVersion := currentVersion
 		if update != nil && update.Status == "ok" && len(update.Manifests) > 0 {
 			// processUpdate handles version tracking internally when appropriate
-			if err := s.processUpdate(descriptor, update); err != nil {
-				return err
-			}
+			seenVersion = update.Manifests[len(update.Manifests)-1].Version
+			err = s.processUpdate(descriptor, update)
 		} else {
 			l.Debug().Str("channel", descriptor.name).Str("arch", descriptor.arch.String()).Str("currentVersion", currentVersion).Msgf("checkForUpdates, no update available updateStatus %v", update.Status)
 		}
+		if err == nil {
+			err = s.reconcileChannel(descriptor)
+			if errors.Is(err, errStopped) {
+				return errors.Join(errs...)
+			}
+		}
+		s.recordCheck(descriptor, seenVersion, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("checking channel %s (%s): %w", descriptor.name, descriptor.arch, err))
+		}
 
-		select {
-		case <-time.After(5 * time.Second):
-		case <-s.stopCh:
-			break
+		if err := s.wait(5 * time.Second); err != nil {
+			return errors.Join(errs...)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-// doOmahaRequest sends an Omaha request checking if there is an update for a
-// specific Flatcar channel, returning the update check to the caller.
-func (s *Syncer) doOmahaRequest(descriptor channelDescriptor, currentVersion string) (*omaha.UpdateResponse, error) {
+// doOmahaRequest sends an Omaha request to the upstream provided checking if
+// there is an update for a specific Flatcar channel, returning the update
+// check to the caller.
+func (s *Syncer) doOmahaRequest(upstreamURL string, descriptor channelDescriptor, machineID, currentVersion string) (*omaha.UpdateResponse, error) {
 	req := omaha.NewRequest()
 	req.OS.Version = "Chateau"
 	req.OS.Platform = "CoreOS"
@@ -260,7 +353,7 @@ func (s *Syncer) doOmahaRequest(descriptor channelDescriptor, currentVersion str
 	req.IsMachine = 1
 	app := req.AddApp(flatcarAppID, currentVersion)
 	app.AddUpdateCheck()
-	app.MachineID = s.machinesIDs[descriptor]
+	app.MachineID = machineID
 	app.BootID = s.bootIDs[descriptor]
 	app.Track = descriptor.name
 	app.MultiManifestOK = true
@@ -272,12 +365,15 @@ func (s *Syncer) doOmahaRequest(descriptor channelDescriptor, currentVersion str
 	}
 	l.Debug().Str("request", string(payload)).Msg("doOmahaRequest")
 
-	resp, err := s.httpClient.Post(s.flatcarUpdatesURL, "text/xml", bytes.NewReader(payload))
+	resp, err := s.httpClient.Post(upstreamURL, "text/xml", bytes.NewReader(payload))
 	if err != nil {
 		l.Error().Err(err).Msg("checkForUpdates, posting omaha response")
 		return nil, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received unexpected status code (%d)", resp.StatusCode)
+	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -293,6 +389,10 @@ func (s *Syncer) doOmahaRequest(descriptor channelDescriptor, currentVersion str
 		return nil, err
 	}
 
+	if len(oresp.Apps) == 0 || oresp.Apps[0].UpdateCheck == nil {
+		return nil, errors.New("omaha response without update check")
+	}
+
 	return oresp.Apps[0].UpdateCheck, nil
 }
 
@@ -419,10 +519,18 @@ func (s *Syncer) createPackage(
 	}
 	omahaPkg := manifest.Packages[0]
 
+	// Verify payloads signatures before creating anything. When hosting
+	// packages, this happens as part of the payloads download instead.
+	if len(s.trustedKeys) > 0 && !s.hostPackages {
+		if err := s.verifyRemotePayloads(manifest, update); err != nil {
+			return nil, s.rejectPackage(descriptor, version, err)
+		}
+	}
+
 	// Process extra files
 	extraFiles, err := s.processExtraFiles(manifest, update, descriptor, version)
 	if err != nil {
-		return nil, err
+		return nil, s.rejectPackage(descriptor, version, err)
 	}
 
 	// Determine URL and filename
@@ -447,7 +555,7 @@ func (s *Syncer) createPackage(
 				}
 			}
 			s.cleanupDownloadedFiles(extraFileNames)
-			return nil, err
+			return nil, s.rejectPackage(descriptor, version, err)
 		}
 	}
 
@@ -462,6 +570,7 @@ func (s *Syncer) createPackage(
 		ApplicationID: flatcarAppID,
 		Arch:          descriptor.arch,
 		ExtraFiles:    extraFiles,
+		UpstreamURL:   null.NewString(s.activeUpstream, s.activeUpstream != ""),
 	}
 
 	// Add FlatcarAction if present
@@ -542,6 +651,7 @@ func (s *Syncer) updateChannelToPackage(
 
 	// Update tracking
 	s.versions[descriptor] = pkg.Version
+	s.packagesIDs[descriptor] = pkg.ID
 	s.bootIDs[descriptor] = "{" + uuid.New().String() + "}"
 
 	l.Debug().
@@ -565,7 +675,13 @@ func (s *Syncer) markPackageAsFloor(descriptor channelDescriptor, pkg *api.Packa
 		floorReason = null.StringFrom("Synced from upstream Flatcar channel")
 	}
 
-	if err := s.admin.AddChannelPackageFloor(channelID, pkg.ID, floorReason); err != nil {
+	floors, err := s.api.GetChannelFloorPackages(channelID)
+	if err != nil {
+		return err
+	}
+	isNewFloor := !slices.ContainsFunc(floors, func(floor *api.Package) bool { return floor.ID == pkg.ID })
+
+	if err := s.admin.AddSyncedChannelPackageFloor(channelID, pkg.ID, floorReason); err != nil {
 		l.Error().Err(err).
 			Str("version", pkg.Version).
 			Str("channel", descriptor.name).
@@ -579,6 +695,36 @@ func (s *Syncer) markPackageAsFloor(descriptor channelDescriptor, pkg *api.Packa
 		Str("channel", descriptor.name).
 		Str("arch", descriptor.arch.String()).
 		Msg("markPackageAsFloor - marked package as floor")
+
+	if isNewFloor {
+		if err := s.admin.AddChannelFloorAddedActivity(pkg.Version, flatcarAppID, channelID); err != nil {
+			l.Error().Err(err).Msg("markPackageAsFloor - could not add channel activity")
+		}
+	}
+	return nil
+}
+
+// findTargetManifest returns the manifest the channel should point to, or nil
+// when all manifests are floors.
+func findTargetManifest(manifests []*omaha.Manifest) *omaha.Manifest {
+	// Priority 1: Check if any manifest is explicitly marked as target (is_target="true")
+	// This is the preferred way for upstreams to indicate the target version
+	for _, m := range manifests {
+		if m.IsTarget {
+			return m
+		}
+	}
+
+	// Priority 2: If no explicit target, assume last non-floor is target
+	// This maintains backward compatibility with older upstreams that don't set is_target
+	// We iterate backwards to find the last manifest that isn't marked as a floor
+	for i := len(manifests) - 1; i >= 0; i-- {
+		if !manifests[i].IsFloor {
+			return manifests[i]
+		}
+	}
+
+	// No target found, all manifests are floors
 	return nil
 }
 
@@ -613,34 +759,12 @@ func (s *Syncer) processMultiManifestUpdate(descriptor channelDescriptor, update
 
 	// Find the target manifest - this determines which package the channel will point to.
 	// Note: targetVersion may remain empty if all manifests are floors (valid scenario)
-	var targetManifest *omaha.Manifest
 	var targetVersion string
 	var targetPkg *api.Package
-
-	// Priority 1: Check if any manifest is explicitly marked as target (is_target="true")
-	// This is the preferred way for upstreams to indicate the target version
-	for _, m := range update.Manifests {
-		if m.IsTarget {
-			targetManifest = m
-			targetVersion = m.Version
-			break
-		}
+	if targetManifest := findTargetManifest(update.Manifests); targetManifest != nil {
+		targetVersion = targetManifest.Version
 	}
 
-	// Priority 2: If no explicit target, assume last non-floor is target
-	// This maintains backward compatibility with older upstreams that don't set is_target
-	// We iterate backwards to find the last manifest that isn't marked as a floor
-	if targetManifest == nil {
-		for i := len(update.Manifests) - 1; i >= 0; i-- {
-			if !update.Manifests[i].IsFloor {
-				targetManifest = update.Manifests[i]
-				targetVersion = targetManifest.Version
-				break
-			}
-		}
-	}
-	// If still no target found, all manifests are floors - targetVersion remains empty
-
 	// Process each manifest in the response
 	for _, manifest := range update.Manifests {
 		version := manifest.Version
@@ -750,43 +874,70 @@ func (s *Syncer) downloadPackage(update *omaha.UpdateResponse, pkgName, sha1Base
 	}
 	defer os.Remove(tmpFile.Name())
 
-	updateURL, err := url.Parse(update.URLs[0].CodeBase)
+	digests, err := s.fetchPayload(update, pkgName, tmpFile)
 	if err != nil {
 		return err
 	}
+	if err := checkPayloadDigests(digests, sha1Base64Checksum, sha256Base16Checksum); err != nil {
+		return err
+	}
+	if err := s.verifyPayloadSignature(update, pkgName, digests); err != nil {
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), filepath.Join(s.packagesPath, filename)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// fetchPayload downloads the package payload referenced in the update
+// provided into w, returning the payload digests.
+func (s *Syncer) fetchPayload(update *omaha.UpdateResponse, pkgName string, w io.Writer) (*payloadDigests, error) {
+	updateURL, err := url.Parse(update.URLs[0].CodeBase)
+	if err != nil {
+		return nil, err
+	}
 
 	updateURL.Path = path.Join(updateURL.Path, pkgName)
 
 	pkgURL := updateURL.String()
 	resp, err := s.httpClient.Get(pkgURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received unexpected status code (%d)", resp.StatusCode)
+		return nil, fmt.Errorf("received unexpected status code (%d)", resp.StatusCode)
 	}
-	defer resp.Body.Close()
 
 	hashSha1 := sha1.New()
 	hashSha256 := sha256.New()
-	l.Debug().Msgf("downloadPackage, downloading.. url %s", pkgURL)
-	if _, err := io.Copy(io.MultiWriter(tmpFile, hashSha256, hashSha1), resp.Body); err != nil {
-		return err
+	hashSha512 := sha512.New()
+	l.Debug().Msgf("fetchPayload, downloading.. url %s", pkgURL)
+	if _, err := io.Copy(io.MultiWriter(w, hashSha256, hashSha1, hashSha512), resp.Body); err != nil {
+		return nil, err
 	}
-	// Only check the checksums if provided
-	if sha1Base64Checksum != "" && base64.StdEncoding.EncodeToString(hashSha1.Sum(nil)) != sha1Base64Checksum {
+
+	return &payloadDigests{
+		sha1:   hashSha1.Sum(nil),
+		sha256: hashSha256.Sum(nil),
+		sha512: hashSha512.Sum(nil),
+	}, nil
+}
+
+// checkPayloadDigests verifies the payload digests against the checksums
+// provided. Only the checksums provided are checked.
+func checkPayloadDigests(digests *payloadDigests, sha1Base64Checksum, sha256Base16Checksum string) error {
+	if sha1Base64Checksum != "" && base64.StdEncoding.EncodeToString(digests.sha1) != sha1Base64Checksum {
 		return errors.New("downloaded file sha1 hash mismatch")
 	}
-	if sha256Base16Checksum != "" && hex.EncodeToString(hashSha256.Sum(nil)) != sha256Base16Checksum {
+	if sha256Base16Checksum != "" && hex.EncodeToString(digests.sha256) != sha256Base16Checksum {
 		return errors.New("downloaded file sha256 hash mismatch")
 	}
-
-	if err := tmpFile.Close(); err != nil {
-		return err
-	}
-	if err := os.Rename(tmpFile.Name(), filepath.Join(s.packagesPath, filename)); err != nil {
-		return err
-	}
-
 	return nil
 }
@@ -1,6 +1,7 @@
 package syncer
 
 import (
+	"errors"
 	"log"
 	"os"
 	"testing"
@@ -343,3 +344,34 @@ func TestSyncer_GetPackageWithGeneratedURL(t *testing.T) {
 	assert.Equal(t, baseURL+getArchString(tChannel.Arch)+"/"+tGroup.Channel.Package.Version, tGroup.Channel.Package.URL)
 	assert.Equal(t, update.Manifests[0].Packages[0].Name, tGroup.Channel.Package.Filename.String)
 }
+
+func TestSyncer_Status(t *testing.T) {
+	syncer := newForTest(t, &Config{})
+	t.Cleanup(func() {
+		syncer.api.Close()
+	})
+
+	desc := channelDescriptor{
+		name: "stable",
+		arch: api.ArchAMD64,
+	}
+
+	syncer.recordCheck(desc, "1.2.3", nil)
+	status := syncer.Status()
+	require.Len(t, status.Channels, 1)
+	assert.Equal(t, "stable", status.Channels[0].Channel)
+	assert.Equal(t, api.ArchAMD64.String(), status.Channels[0].Arch)
+	assert.Equal(t, "1.2.3", status.Channels[0].LastSeenVersion)
+	assert.Empty(t, status.Channels[0].LastError)
+	assert.False(t, status.Channels[0].LastCheck.IsZero())
+
+	// A failed check keeps the last seen version but records the error.
+	syncer.recordCheck(desc, "", errors.New("upstream unreachable"))
+	status = syncer.Status()
+	require.Len(t, status.Channels, 1)
+	assert.Equal(t, "1.2.3", status.Channels[0].LastSeenVersion)
+	assert.Equal(t, "upstream unreachable", status.Channels[0].LastError)
+
+	assert.True(t, syncer.TriggerSync())
+	assert.False(t, syncer.TriggerSync())
+}
@@ -0,0 +1,191 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flatcar/go-omaha/omaha"
+)
+
+const (
+	defaultRetryAttempts  = 3
+	defaultRetryBaseDelay = 2 * time.Second
+)
+
+// errStopped error indicates that the syncer was asked to stop while waiting.
+var errStopped = errors.New("syncer stopped")
+
+// upstream represents an update server the syncer gets updates from, along
+// with its health tracking information.
+type upstream struct {
+	url                 string
+	consecutiveFailures int
+	lastSuccess         time.Time
+	lastFailure         time.Time
+	lastError           string
+	unhealthyUntil      time.Time
+}
+
+// UpstreamStatus represents the health of an upstream update server.
+type UpstreamStatus struct {
+	URL                 string    `json:"url"`
+	Healthy             bool      `json:"healthy"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastSuccess         time.Time `json:"last_success"`
+	LastFailure         time.Time `json:"last_failure"`
+	LastError           string    `json:"last_error"`
+}
+
+// parseUpstreams returns the upstreams from a comma-separated list of URLs,
+// keeping their order.
+func parseUpstreams(urls string) []*upstream {
+	var upstreams []*upstream
+	for _, u := range strings.Split(urls, ",") {
+		u = strings.TrimSpace(u)
+		if u == "" {
+			continue
+		}
+		upstreams = append(upstreams, &upstream{url: u})
+	}
+	return upstreams
+}
+
+// isPrimaryUpstream returns whether the url provided is the one of the first
+// upstream configured.
+func (s *Syncer) isPrimaryUpstream(url string) bool {
+	return len(s.upstreams) > 0 && s.upstreams[0].url == url
+}
+
+// backoff returns the delay to wait after the given number of consecutive
+// failures, doubling the base delay each time without exceeding max.
+func backoff(base, maxDelay time.Duration, failures int) time.Duration {
+	delay := base
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// maxBackoff returns the longest delay the syncer waits between retries or
+// keeps an upstream marked as unhealthy, so that everything happens within
+// the check interval.
+func (s *Syncer) maxBackoff() time.Duration {
+	if s.checkFrequency <= 0 {
+		return s.retryBaseDelay
+	}
+	return s.checkFrequency / 2
+}
+
+// healthyUpstreams returns the upstreams to try, in order. Unhealthy
+// upstreams are skipped unless all of them are unhealthy, in which case all
+// are tried anyway.
+func (s *Syncer) healthyUpstreams() []*upstream {
+	s.statusMu.RLock()
+	defer s.statusMu.RUnlock()
+
+	now := time.Now()
+	var healthy []*upstream
+	for _, u := range s.upstreams {
+		if now.After(u.unhealthyUntil) {
+			healthy = append(healthy, u)
+		}
+	}
+	if len(healthy) == 0 {
+		return s.upstreams
+	}
+	return healthy
+}
+
+// recordUpstreamResult updates the health tracking of the upstream provided.
+// Failing upstreams are marked as unhealthy for an exponentially growing
+// period of time.
+func (s *Syncer) recordUpstreamResult(u *upstream, err error) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+
+	now := time.Now()
+	if err == nil {
+		u.consecutiveFailures = 0
+		u.lastSuccess = now.UTC()
+		u.unhealthyUntil = time.Time{}
+		return
+	}
+
+	u.consecutiveFailures++
+	u.lastFailure = now.UTC()
+	u.lastError = err.Error()
+	u.unhealthyUntil = now.Add(backoff(s.retryBaseDelay, s.maxBackoff(), u.consecutiveFailures))
+}
+
+// upstreamsStatus returns the health of the upstreams. It must be called with
+// statusMu held.
+func (s *Syncer) upstreamsStatus() []UpstreamStatus {
+	now := time.Now()
+	statuses := make([]UpstreamStatus, 0, len(s.upstreams))
+	for _, u := range s.upstreams {
+		statuses = append(statuses, UpstreamStatus{
+			URL:                 u.url,
+			Healthy:             now.After(u.unhealthyUntil),
+			ConsecutiveFailures: u.consecutiveFailures,
+			LastSuccess:         u.lastSuccess,
+			LastFailure:         u.lastFailure,
+			LastError:           u.lastError,
+		})
+	}
+	return statuses
+}
+
+// wait waits for the duration provided, returning errStopped if the syncer
+// is asked to stop in the meantime.
+func (s *Syncer) wait(d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-s.stopCh:
+		return errStopped
+	}
+}
+
+// doOmahaRequestWithFailover sends the Omaha request for the channel provided
+// to the configured upstreams in order, retrying each of them with an
+// exponential backoff before failing over to the next one. It returns the
+// update check along with the url of the upstream that served it.
+func (s *Syncer) doOmahaRequestWithFailover(descriptor channelDescriptor, machineID, currentVersion string) (*omaha.UpdateResponse, string, error) {
+	var errs []error
+
+	for _, u := range s.healthyUpstreams() {
+		for attempt := 1; attempt <= s.retryAttempts; attempt++ {
+			update, err := s.doOmahaRequest(u.url, descriptor, machineID, currentVersion)
+			if err == nil {
+				s.recordUpstreamResult(u, nil)
+				return update, u.url, nil
+			}
+
+			l.Warn().Err(err).
+				Str("upstream", u.url).
+				Str("channel", descriptor.name).
+				Str("arch", descriptor.arch.String()).
+				Int("attempt", attempt).
+				Msg("doOmahaRequestWithFailover - request to upstream failed")
+
+			if attempt < s.retryAttempts {
+				if err := s.wait(backoff(s.retryBaseDelay, s.maxBackoff(), attempt)); err != nil {
+					return nil, "", err
+				}
+			} else {
+				s.recordUpstreamResult(u, err)
+				errs = append(errs, fmt.Errorf("%s: %w", u.url, err))
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil, "", errors.New("no upstream configured")
+	}
+	return nil, "", fmt.Errorf("all upstreams failed: %w", errors.Join(errs...))
+}
@@ -0,0 +1,136 @@
+package syncer
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flatcar/go-omaha/omaha"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+)
+
+func newUpstreamTestSyncer(urls string) *Syncer {
+	return &Syncer{
+		httpClient:     &http.Client{},
+		upstreams:      parseUpstreams(urls),
+		retryAttempts:  2,
+		retryBaseDelay: time.Millisecond,
+		checkFrequency: time.Minute,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+func newOmahaUpstream(t *testing.T, version string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		resp := omaha.NewResponse()
+		update := resp.AddApp(flatcarAppID, omaha.AppOK).AddUpdateCheck(omaha.UpdateOK)
+		update.AddManifest(version)
+		_ = xml.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(time.Second, time.Minute, 1))
+	assert.Equal(t, 2*time.Second, backoff(time.Second, time.Minute, 2))
+	assert.Equal(t, 8*time.Second, backoff(time.Second, time.Minute, 4))
+	assert.Equal(t, time.Minute, backoff(time.Second, time.Minute, 20))
+}
+
+func TestParseUpstreams(t *testing.T) {
+	upstreams := parseUpstreams(" https://a.example/v1/update/ ,,https://b.example/v1/update/")
+	require.Len(t, upstreams, 2)
+	assert.Equal(t, "https://a.example/v1/update/", upstreams[0].url)
+	assert.Equal(t, "https://b.example/v1/update/", upstreams[1].url)
+}
+
+func TestDoOmahaRequestWithFailover(t *testing.T) {
+	failures := 0
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		failures++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+	healthy := newOmahaUpstream(t, "1.2.3")
+
+	s := newUpstreamTestSyncer(failing.URL + "," + healthy.URL)
+	// Keep the failing upstream backing off for the rest of the test, a
+	// single attempt per upstream means no waits between retries.
+	s.retryAttempts = 1
+	s.retryBaseDelay = time.Minute
+	desc := channelDescriptor{name: "stable", arch: api.ArchAMD64}
+
+	update, upstreamURL, err := s.doOmahaRequestWithFailover(desc, "{machine}", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, healthy.URL, upstreamURL)
+	require.Len(t, update.Manifests, 1)
+	assert.Equal(t, "1.2.3", update.Manifests[0].Version)
+	assert.Equal(t, 1, failures)
+
+	status := s.upstreamsStatus()
+	require.Len(t, status, 2)
+	assert.False(t, status[0].Healthy)
+	assert.Equal(t, 1, status[0].ConsecutiveFailures)
+	assert.NotEmpty(t, status[0].LastError)
+	assert.True(t, status[1].Healthy)
+	assert.False(t, status[1].LastSuccess.IsZero())
+
+	// The unhealthy upstream is skipped while it's backing off.
+	_, upstreamURL, err = s.doOmahaRequestWithFailover(desc, "{machine}", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, healthy.URL, upstreamURL)
+	assert.Equal(t, 1, failures)
+}
+
+func TestDoOmahaRequestWithFailover_AllFailing(t *testing.T) {
+	requests := 0
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(failing.Close)
+
+	s := newUpstreamTestSyncer(failing.URL)
+	desc := channelDescriptor{name: "stable", arch: api.ArchAMD64}
+
+	_, _, err := s.doOmahaRequestWithFailover(desc, "{machine}", "1.0.0")
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+
+	// Unhealthy upstreams are still tried when no healthy one is left.
+	_, _, err = s.doOmahaRequestWithFailover(desc, "{machine}", "1.0.0")
+	assert.Error(t, err)
+	assert.Equal(t, 2, s.upstreamsStatus()[0].ConsecutiveFailures)
+}
+
+func TestCheckForUpdates_ContinuesAfterChannelError(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+
+	s := newUpstreamTestSyncer(failing.URL)
+	s.retryAttempts = 1
+	s.channelsStatus = make(map[channelDescriptor]*ChannelStatus)
+	stable := channelDescriptor{name: "stable", arch: api.ArchAMD64}
+	beta := channelDescriptor{name: "beta", arch: api.ArchAMD64}
+	s.versions = map[channelDescriptor]string{stable: "1.0.0", beta: "1.0.0"}
+
+	// Every channel is checked and their errors are returned together.
+	err := s.checkForUpdates()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checking channel stable")
+	assert.Contains(t, err.Error(), "checking channel beta")
+	status := s.Status()
+	require.Len(t, status.Channels, 2)
+	for _, cs := range status.Channels {
+		assert.NotEmpty(t, cs.LastError)
+	}
+}
@@ -0,0 +1,292 @@
+// Package webhooks delivers the activity entries to the webhooks subscribed to
+// them.
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/config"
+	"github.com/flatcar/nebraska/backend/pkg/logger"
+	"github.com/flatcar/nebraska/backend/pkg/version"
+)
+
+const (
+	defaultInterval    = 10 * time.Second
+	defaultTimeout     = 10 * time.Second
+	defaultMaxAttempts = 8
+
+	// claimBatchSize is the maximum number of deliveries attempted at once.
+	claimBatchSize = 50
+
+	// maxErrorLength is the maximum length of the errors logged for the
+	// failed attempts.
+	maxErrorLength = 1024
+)
+
+// HTTP headers set on the requests posted to the webhooks.
+const (
+	SignatureHeader = "X-Nebraska-Signature-256"
+	DeliveryHeader  = "X-Nebraska-Delivery"
+	EventHeader     = "X-Nebraska-Event"
+)
+
+var (
+	l = logger.New("webhooks")
+
+	// minRetryDelay and maxRetryDelay bound the delay between two attempts of
+	// a delivery, which doubles after each failed attempt. They are variables
+	// so tests can shorten them.
+	minRetryDelay = 30 * time.Second
+	maxRetryDelay = time.Hour
+
+	// ErrInvalidAPIInstance error indicates that no valid api instance was
+	// provided to the dispatcher constructor.
+	ErrInvalidAPIInstance = errors.New("invalid api instance")
+)
+
+// Dispatcher represents a background job in charge of queueing the activity
+// entries matching the filters of the webhooks, and posting them to the
+// webhooks until they are delivered or run out of attempts.
+type Dispatcher struct {
+	api         *api.API
+	client      *http.Client
+	interval    time.Duration
+	timeout     time.Duration
+	maxAttempts int
+	stopCh      chan struct{}
+	stopOnce    sync.Once
+}
+
+// Config represents the configuration used to create a new Dispatcher
+// instance.
+type Config struct {
+	API         *api.API
+	Interval    time.Duration
+	Timeout     time.Duration
+	MaxAttempts int
+}
+
+// Setup creates a new dispatcher from config and db connection, and returns
+// it.
+func Setup(conf *config.Config, db *api.API) (*Dispatcher, error) {
+	var err error
+
+	interval := defaultInterval
+	if conf.WebhookInterval != "" {
+		interval, err = time.ParseDuration(conf.WebhookInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook interval: %w", err)
+		}
+	}
+
+	timeout := defaultTimeout
+	if conf.WebhookTimeout != "" {
+		timeout, err = time.ParseDuration(conf.WebhookTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook timeout: %w", err)
+		}
+	}
+
+	return New(&Config{
+		API:         db,
+		Interval:    interval,
+		Timeout:     timeout,
+		MaxAttempts: conf.WebhookMaxAttempts,
+	})
+}
+
+// New creates a new Dispatcher instance.
+func New(conf *Config) (*Dispatcher, error) {
+	if conf.API == nil {
+		return nil, ErrInvalidAPIInstance
+	}
+
+	d := &Dispatcher{
+		api:         conf.API,
+		interval:    conf.Interval,
+		timeout:     conf.Timeout,
+		maxAttempts: conf.MaxAttempts,
+		stopCh:      make(chan struct{}),
+	}
+
+	if d.interval <= 0 {
+		d.interval = defaultInterval
+	}
+
+	if d.timeout <= 0 {
+		d.timeout = defaultTimeout
+	}
+
+	if d.maxAttempts <= 0 {
+		d.maxAttempts = defaultMaxAttempts
+	}
+
+	d.client = &http.Client{Timeout: d.timeout}
+
+	return d, nil
+}
+
+// Start makes the dispatcher start working. It will queue and attempt the
+// deliveries every interval until it's stopped.
+func (d *Dispatcher) Start() {
+	l.Debug().Msg("dispatcher ready!")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(); err != nil {
+			l.Error().Err(err).Msg("dispatching webhook deliveries")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-d.stopCh:
+			return
+		}
+	}
+}
+
+// Stop stops the dispatcher.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stopCh)
+	})
+}
+
+// Dispatch queues the new activity entries matching the filters of the
+// webhooks, and attempts the pending deliveries that are due.
+func (d *Dispatcher) Dispatch() error {
+	if _, err := d.api.EnqueueWebhookDeliveries(); err != nil {
+		return err
+	}
+
+	for {
+		// Deliveries are leased for longer than an attempt can take, so that
+		// they are not attempted twice by other Nebraska instances.
+		deliveries, err := d.api.ClaimWebhookDeliveries(claimBatchSize, 2*d.timeout)
+		if err != nil {
+			return err
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery *api.WebhookDelivery) {
+				defer wg.Done()
+				d.attempt(delivery)
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(deliveries) < claimBatchSize {
+			return nil
+		}
+
+		select {
+		case <-d.stopCh:
+			return nil
+		default:
+		}
+	}
+}
+
+// attempt posts the delivery provided to its webhook and records the outcome.
+func (d *Dispatcher) attempt(delivery *api.WebhookDelivery) {
+	now := time.Now().UTC()
+	attempt := api.WebhookDeliveryAttempt{
+		Status:    api.WebhookDeliveryDelivered,
+		AttemptTs: now,
+		// The next attempt of delivered or failed deliveries is never made,
+		// but it's set to the last attempt for consistency.
+		NextAttemptTs: now,
+	}
+
+	responseStatus, err := d.post(delivery)
+	if responseStatus != 0 {
+		attempt.ResponseStatus = null.IntFrom(int64(responseStatus))
+	}
+	if err != nil {
+		msg := err.Error()
+		if len(msg) > maxErrorLength {
+			msg = msg[:maxErrorLength]
+		}
+		attempt.Error = null.StringFrom(msg)
+		attempt.Status = api.WebhookDeliveryFailed
+		if delivery.Attempts+1 < d.maxAttempts {
+			attempt.Status = api.WebhookDeliveryPending
+			attempt.NextAttemptTs = now.Add(RetryDelay(delivery.Attempts + 1))
+		}
+		l.Warn().Err(err).Str("webhook", delivery.WebhookID).Str("delivery", delivery.ID).Int("attempt", delivery.Attempts+1).Msg("delivering activity to webhook")
+	}
+
+	if err := d.api.RecordWebhookDeliveryAttempt(delivery.ID, attempt); err != nil {
+		l.Error().Err(err).Str("delivery", delivery.ID).Msg("recording webhook delivery attempt")
+	}
+}
+
+// post posts the payload of the delivery provided to its webhook, returning
+// the status of the response. Only 2xx responses are successful.
+func (d *Dispatcher) post(delivery *api.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Nebraska-Webhook/"+version.Version)
+	req.Header.Set(EventHeader, delivery.Payload.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature of the body provided sent in the
+// X-Nebraska-Signature-256 header: the hex encoded HMAC-SHA256 of the body
+// keyed with the secret of the webhook, prefixed by "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryDelay returns the delay before the next attempt of a delivery that
+// failed the number of attempts provided.
+func RetryDelay(attempts int) time.Duration {
+	delay := minRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,3 +46,61 @@ func TestListActivity(t *testing.T) {
 		}
 	})
 }
+
+func TestAcknowledgeActivity(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		url := fmt.Sprintf("%s/api/activity/acknowledge", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+		payload := strings.NewReader(`{"ids":["not-a-uuid"]}`)
+
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_activity_acknowledgement", errResp["error"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		teamID := getTeamID(t, db)
+
+		endTime := time.Now()
+		startTime := time.Now().Add(time.Duration(-1 * 24 * 7 * time.Hour))
+		activitiesDB, err := db.GetActivity(teamID, api.ActivityQueryParams{Start: startTime, End: endTime, Unacknowledged: true})
+		require.NoError(t, err)
+		require.NotEmpty(t, activitiesDB)
+		activityID := activitiesDB[0].ID
+		unacknowledgedCount, err := db.GetActivityCount(teamID, api.ActivityQueryParams{Start: startTime, End: endTime, Unacknowledged: true})
+		require.NoError(t, err)
+
+		url := fmt.Sprintf("%s/api/activity/acknowledge", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+		payload := strings.NewReader(fmt.Sprintf(`{"ids":["%s"],"comment":"on it"}`, activityID))
+
+		var ackResp codegen.ActivityAcknowledgementResult
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &ackResp)
+		assert.Equal(t, int64(1), ackResp.Updated)
+
+		// the acknowledged entry is no longer listed as unacknowledged
+		url = fmt.Sprintf("%s/api/activity?start=%s&end=%s&unacknowledged=true&perpage=1000", os.Getenv("NEBRASKA_TEST_SERVER_URL"), startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+
+		var activityResp codegen.ActivityPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &activityResp)
+		assert.Equal(t, unacknowledgedCount-1, activityResp.TotalCount)
+		for _, activity := range activityResp.Activities {
+			assert.NotEqual(t, activityID, activity.Id)
+		}
+
+		entry, err := db.GetActivityEntry(activityID)
+		require.NoError(t, err)
+		assert.True(t, entry.AcknowledgedTs.Valid)
+		assert.Equal(t, "on it", entry.AckComment.String)
+
+		// the acknowledgement is recorded in the audit log
+		url = fmt.Sprintf("%s/api/audit?action=update&targetType=activity", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+
+		var auditResp codegen.AuditPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &auditResp)
+		require.NotEmpty(t, auditResp.Entries)
+		require.NotNil(t, auditResp.Entries[0].After)
+		assert.Equal(t, []any{activityID}, (*auditResp.Entries[0].After)["ids"])
+		assert.Equal(t, false, (*auditResp.Entries[0].After)["resolve"])
+	})
+}
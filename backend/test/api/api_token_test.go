@@ -0,0 +1,68 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestAPITokens(t *testing.T) {
+	url := fmt.Sprintf("%s/api/tokens", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+	appsURL := fmt.Sprintf("%s/api/apps", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+
+	doWithToken := func(t *testing.T, method, url, token string) int {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(`{"name":"test_app_token"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("create_invalid", func(t *testing.T) {
+		payload := strings.NewReader(`{"name":"ci","role":"viewer","expires_ts":"2000-01-01T00:00:00Z"}`)
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_api_token", errResp["error"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		payload := strings.NewReader(`{"name":"ci","role":"viewer"}`)
+		var token codegen.ApiToken
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &token)
+		require.NotNil(t, token.Token)
+		secret := *token.Token
+		assert.True(t, strings.HasPrefix(secret, token.TokenPrefix))
+		assert.Equal(t, "viewer", token.Role)
+
+		var page codegen.ApiTokenPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &page)
+		require.NotEmpty(t, page.Tokens)
+		assert.Equal(t, token.Id, page.Tokens[0].Id)
+		assert.Nil(t, page.Tokens[0].Token)
+
+		// viewer tokens can read but not write, nor manage tokens
+		assert.Equal(t, http.StatusOK, doWithToken(t, "GET", appsURL, secret))
+		assert.Equal(t, http.StatusForbidden, doWithToken(t, "POST", appsURL, secret))
+		assert.Equal(t, http.StatusForbidden, doWithToken(t, "GET", url, secret))
+
+		tokenURL := fmt.Sprintf("%s/%s", url, token.Id)
+		httpDo(t, tokenURL, "DELETE", nil, http.StatusNoContent, "", nil)
+		httpDo(t, tokenURL, "DELETE", nil, http.StatusNotFound, "", nil)
+
+		var revokedToken codegen.ApiToken
+		httpDo(t, tokenURL, "GET", nil, http.StatusOK, "json", &revokedToken)
+		assert.NotNil(t, revokedToken.RevokedTs)
+		assert.Equal(t, http.StatusUnauthorized, doWithToken(t, "GET", appsURL, secret))
+	})
+}
@@ -0,0 +1,46 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestListAudit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		defer db.Close()
+
+		app := getRandomApp(t, db)
+
+		// update the app to record a change in the audit log
+		url := fmt.Sprintf("%s/api/apps/%s", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+		payload := strings.NewReader(fmt.Sprintf(`{"name":"%s","description":"%s","id":"%s"}`, "audited_name", app.Description, app.ID))
+
+		var application api.Application
+		httpDo(t, url, "PUT", payload, http.StatusOK, "json", &application)
+
+		// fetch the audit log of the app
+		url = fmt.Sprintf("%s/api/audit?appIDorProductID=%s&action=update&targetType=application", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+		var auditResp codegen.AuditPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &auditResp)
+
+		require.NotEmpty(t, auditResp.Entries)
+		entry := auditResp.Entries[0]
+		assert.Equal(t, app.ID, entry.TargetId)
+		require.NotNil(t, entry.Before)
+		require.NotNil(t, entry.After)
+		assert.Equal(t, app.Name, (*entry.Before)["name"])
+		assert.Equal(t, "audited_name", (*entry.After)["name"])
+	})
+}
@@ -0,0 +1,62 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestEmailNotifications(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	app := getRandomApp(t, db)
+	url := fmt.Sprintf("%s/api/apps/%s/email-notifications", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+	t.Run("create_invalid", func(t *testing.T) {
+		payload := strings.NewReader(`{"recipients":["not an address"]}`)
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_email_notification", errResp["error"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		payload := strings.NewReader(`{"recipients":["Ops <ops@example.com>"],"daily_digest":true}`)
+
+		var notification codegen.EmailNotification
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &notification)
+
+		assert.Equal(t, app.ID, notification.ApplicationId)
+		assert.Equal(t, []string{"ops@example.com"}, notification.Recipients)
+		assert.True(t, notification.RolloutFinished)
+		assert.True(t, notification.RolloutFailed)
+		assert.True(t, notification.DailyDigest)
+		assert.True(t, notification.Enabled)
+
+		var page codegen.EmailNotificationPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &page)
+		require.NotEmpty(t, page.EmailNotifications)
+		assert.Equal(t, notification.Id, page.EmailNotifications[0].Id)
+
+		notificationURL := fmt.Sprintf("%s/%s", url, notification.Id)
+		payload = strings.NewReader(`{"recipients":["ops@example.com","dev@example.com"],"rollout_finished":false}`)
+		httpDo(t, notificationURL, "PUT", payload, http.StatusOK, "json", &notification)
+		assert.Equal(t, []string{"ops@example.com", "dev@example.com"}, notification.Recipients)
+		assert.False(t, notification.RolloutFinished)
+		assert.False(t, notification.DailyDigest)
+
+		// the test server sends the emails to a local SMTP stand-in
+		httpDo(t, notificationURL+"/test", "POST", nil, http.StatusNoContent, "", nil)
+
+		httpDo(t, notificationURL, "DELETE", nil, http.StatusNoContent, "", nil)
+		httpDo(t, notificationURL, "GET", nil, http.StatusNotFound, "", nil)
+	})
+}
@@ -1,6 +1,8 @@
 package api_test
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -203,6 +205,74 @@ func TestGetInstanceStatusHistory(t *testing.T) {
 	})
 }
 
+func TestGetInstanceEvents(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		defer db.Close()
+
+		// get random app
+		app := getRandomApp(t, db)
+
+		// create instance for app
+		instanceID := uuid.New()
+		instanceDB, err := db.RegisterInstance(api.Instance{ID: instanceID.String(), Alias: "alias", IP: "0.0.0.0"}, api.NewInstanceApplication(app.ID, app.Groups[0].ID, "0.0.1"))
+		require.NoError(t, err)
+
+		// GetUpdatePackage
+		_, err = db.GetUpdatePackage(api.Instance{ID: instanceDB.ID, Alias: instanceDB.Alias, IP: instanceDB.IP}, api.NewInstanceApplication(app.ID, app.Groups[0].ID, instanceDB.Application.Version))
+		require.NoError(t, err)
+
+		// create event for instance
+		err = db.RegisterEvent(instanceDB.ID, app.ID, app.Groups[0].ID, api.EventUpdateComplete, api.ResultSuccessReboot, "0.0.0", "0")
+		require.NoError(t, err)
+
+		// fetch instance events
+		url := fmt.Sprintf("%s/api/apps/%s/groups/%s/instances/%s/events", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID, app.Groups[0].ID, instanceDB.ID)
+		method := "GET"
+
+		var timeline api.InstanceEventsTimeline
+
+		httpDo(t, url, method, nil, http.StatusOK, "json", &timeline)
+
+		require.Equal(t, 1, len(timeline.Events))
+		assert.Equal(t, uint64(1), timeline.TotalEvents)
+		assert.Equal(t, api.EventUpdateComplete, timeline.Events[0].Type)
+		assert.Equal(t, api.ResultSuccessReboot, timeline.Events[0].Result)
+		assert.Empty(t, timeline.Gaps)
+
+		// the instance doesn't belong to other groups
+		url = fmt.Sprintf("%s/api/apps/%s/groups/%s/instances/%s/events", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID, uuid.New().String(), instanceDB.ID)
+		httpDo(t, url, method, nil, http.StatusNotFound, "", nil)
+	})
+
+	t.Run("invalid_min_gap", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		defer db.Close()
+
+		// get random app
+		app := getRandomApp(t, db)
+
+		url := fmt.Sprintf("%s/api/apps/%s/groups/%s/instances/%s/events?minGap=soon", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID, app.Groups[0].ID, uuid.New().String())
+
+		httpDo(t, url, "GET", nil, http.StatusBadRequest, "", nil)
+	})
+
+	t.Run("instance_not_found", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		defer db.Close()
+
+		// get random app
+		app := getRandomApp(t, db)
+
+		url := fmt.Sprintf("%s/api/apps/%s/groups/%s/instances/%s/events", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID, app.Groups[0].ID, uuid.New().String())
+
+		httpDo(t, url, "GET", nil, http.StatusNotFound, "", nil)
+	})
+}
+
 func TestUpdateInstance(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// establish DB connection
@@ -239,3 +309,132 @@ func TestUpdateInstance(t *testing.T) {
 		assert.Equal(t, newAlias, updatedInstanceDB.Alias)
 	})
 }
+
+func TestDeleteInstance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// establish DB connection
+		db := newDBForTest(t)
+		defer db.Close()
+
+		// get random app
+		app := getRandomApp(t, db)
+
+		// create instance for app
+		instanceID := uuid.New()
+		instanceDB, err := db.RegisterInstance(api.Instance{ID: instanceID.String(), Alias: "alias", IP: "0.0.0.0"}, api.NewInstanceApplication(app.ID, app.Groups[0].ID, "0.0.1"))
+		require.NoError(t, err)
+
+		// delete instance using the API
+		url := fmt.Sprintf("%s/api/instances/%s", os.Getenv("NEBRASKA_TEST_SERVER_URL"), instanceDB.ID)
+		method := "DELETE"
+
+		httpDo(t, url, method, nil, http.StatusNoContent, "", nil)
+
+		// check instance in DB
+		_, err = db.GetInstance(instanceDB.ID, app.ID)
+		assert.Error(t, err)
+
+		// deleting it again fails
+		httpDo(t, url, method, nil, http.StatusNotFound, "", nil)
+	})
+}
+
+func TestDeleteInstances(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	// get random app
+	app := getRandomApp(t, db)
+
+	// create instances for app
+	oem := "oem-" + uuid.New().String()[:8]
+	for i := 0; i < 3; i++ {
+		_, err := db.RegisterInstance(api.Instance{ID: uuid.New().String(), IP: "10.1.0.1", OEM: oem}, api.NewInstanceApplication(app.ID, app.Groups[0].ID, "0.0.1"))
+		require.NoError(t, err)
+	}
+
+	baseURL := fmt.Sprintf("%s/api/apps/%s/instances", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+	t.Run("missing_filter", func(t *testing.T) {
+		httpDo(t, baseURL, "DELETE", nil, http.StatusBadRequest, "", nil)
+	})
+
+	t.Run("dry_run", func(t *testing.T) {
+		var result codegen.InstancesDeleted
+		httpDo(t, baseURL+"?oem="+oem+"&dryRun=true", "DELETE", nil, http.StatusOK, "json", &result)
+
+		assert.True(t, result.DryRun)
+		assert.Equal(t, int64(3), result.Deleted)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var result codegen.InstancesDeleted
+		httpDo(t, baseURL+"?oem="+oem, "DELETE", nil, http.StatusOK, "json", &result)
+
+		assert.False(t, result.DryRun)
+		assert.Equal(t, int64(3), result.Deleted)
+
+		count, err := db.GetInstancesCount(api.InstancesQueryParams{ApplicationID: app.ID, OEM: oem}, "1d")
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
+
+func TestExportInstances(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	// get random app
+	app := getRandomApp(t, db)
+
+	// create instance for app
+	instanceID := uuid.New()
+	_, err := db.RegisterInstance(api.Instance{ID: instanceID.String(), Alias: "export_alias", IP: "10.0.0.1"}, api.NewInstanceApplication(app.ID, app.Groups[0].ID, "0.0.1"))
+	require.NoError(t, err)
+
+	t.Run("csv", func(t *testing.T) {
+		url := fmt.Sprintf("%s/api/apps/%s/instances/export?format=csv&searchFilter=alias&searchValue=export_alias", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+		resp := httpMakeRequest(t, "GET", url, nil, nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/csv")
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "id", records[0][0])
+		assert.Equal(t, instanceID.String(), records[1][0])
+		assert.Equal(t, "export_alias", records[1][2])
+		assert.Equal(t, app.Groups[0].Name, records[1][8])
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		url := fmt.Sprintf("%s/api/apps/%s/instances/export?format=ndjson&groupID=%s&version=0.0.1", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID, app.Groups[0].ID)
+
+		resp := httpMakeRequest(t, "GET", url, nil, nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+		found := false
+		decoder := json.NewDecoder(resp.Body)
+		for decoder.More() {
+			var instance api.InstanceExport
+			require.NoError(t, decoder.Decode(&instance))
+			assert.Equal(t, "0.0.1", instance.Version)
+			found = found || instance.ID == instanceID.String()
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("invalid_format", func(t *testing.T) {
+		url := fmt.Sprintf("%s/api/apps/%s/instances/export?format=xml", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+		resp := httpMakeRequest(t, "GET", url, nil, nil)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,64 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestRoleBindings(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	app := getRandomApp(t, db)
+	url := fmt.Sprintf("%s/api/apps/%s/role-bindings", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+
+	t.Run("create_invalid", func(t *testing.T) {
+		payload := strings.NewReader(`{"subject_type":"oidc_role","subject":"ops","role":"owner"}`)
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_role_binding", errResp["error"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.NotEmpty(t, app.Groups)
+		groupID := app.Groups[0].ID
+		payload := strings.NewReader(fmt.Sprintf(`{"subject_type":"github_team","subject":"kinvolk/ops","role":"operator","group_id":%q}`, groupID))
+
+		var binding codegen.RoleBinding
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &binding)
+
+		assert.Equal(t, app.ID, binding.ApplicationId)
+		require.NotNil(t, binding.GroupId)
+		assert.Equal(t, groupID, *binding.GroupId)
+		assert.Nil(t, binding.ChannelId)
+		assert.Equal(t, "github_team", binding.SubjectType)
+		assert.Equal(t, "kinvolk/ops", binding.Subject)
+		assert.Equal(t, "operator", binding.Role)
+
+		// the same subject can't be bound twice on the same scope
+		payload = strings.NewReader(fmt.Sprintf(`{"subject_type":"github_team","subject":"kinvolk/ops","role":"admin","group_id":%q}`, groupID))
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "", nil)
+
+		var page codegen.RoleBindingPage
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &page)
+		require.NotEmpty(t, page.RoleBindings)
+		assert.Equal(t, binding.Id, page.RoleBindings[0].Id)
+
+		bindingURL := fmt.Sprintf("%s/%s", url, binding.Id)
+		var fetched codegen.RoleBinding
+		httpDo(t, bindingURL, "GET", nil, http.StatusOK, "json", &fetched)
+		assert.Equal(t, binding.Id, fetched.Id)
+
+		httpDo(t, bindingURL, "DELETE", nil, http.StatusNoContent, "", nil)
+		httpDo(t, bindingURL, "GET", nil, http.StatusNotFound, "", nil)
+	})
+}
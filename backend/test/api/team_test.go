@@ -0,0 +1,80 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestTeams(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	url := fmt.Sprintf("%s/api/teams", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+
+	t.Run("create_invalid", func(t *testing.T) {
+		payload := strings.NewReader(`{"name":"ops","github_orgs":[""]}`)
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_team", errResp["error"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		payload := strings.NewReader(`{"name":"ops","oidc_claims":["ops"],"github_orgs":["kinvolk"]}`)
+
+		var team codegen.Team
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &team)
+		assert.Equal(t, "ops", team.Name)
+		assert.Equal(t, []string{"ops"}, team.OidcClaims)
+		assert.Equal(t, []string{"kinvolk"}, team.GithubOrgs)
+
+		var teams []codegen.Team
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &teams)
+		teamIDs := []string{}
+		for _, listedTeam := range teams {
+			teamIDs = append(teamIDs, listedTeam.Id)
+		}
+		assert.Contains(t, teamIDs, team.Id)
+		assert.Contains(t, teamIDs, getTeamID(t, db))
+
+		teamURL := fmt.Sprintf("%s/%s", url, team.Id)
+		payload = strings.NewReader(`{"name":"ops","oidc_claims":["ops","sre"]}`)
+		httpDo(t, teamURL, "PUT", payload, http.StatusOK, "json", &team)
+		assert.Equal(t, []string{"ops", "sre"}, team.OidcClaims)
+		assert.Empty(t, team.GithubOrgs)
+
+		// the applications of the other teams are not visible
+		app, err := adminSvc(db).AddApp(&api.Application{Name: "ops_app", TeamID: team.Id})
+		require.NoError(t, err)
+		appURL := fmt.Sprintf("%s/api/apps/%s", os.Getenv("NEBRASKA_TEST_SERVER_URL"), app.ID)
+		httpDo(t, appURL, "GET", nil, http.StatusNotFound, "", nil)
+		httpDo(t, appURL+"/groups", "GET", nil, http.StatusNotFound, "", nil)
+		httpDo(t, appURL, "DELETE", nil, http.StatusNotFound, "", nil)
+
+		var appsResp codegen.AppsPage
+		httpDo(t, fmt.Sprintf("%s/api/apps", os.Getenv("NEBRASKA_TEST_SERVER_URL")), "GET", nil, http.StatusOK, "json", &appsResp)
+		for _, listedApp := range appsResp.Applications {
+			assert.NotEqual(t, app.ID, listedApp.Id)
+		}
+
+		// teams owning applications can't be deleted
+		httpDo(t, teamURL, "DELETE", nil, http.StatusBadRequest, "", nil)
+		require.NoError(t, adminSvc(db).DeleteApp(app.ID))
+		httpDo(t, teamURL, "DELETE", nil, http.StatusNoContent, "", nil)
+		httpDo(t, teamURL, "GET", nil, http.StatusNotFound, "", nil)
+	})
+
+	t.Run("delete_default_team", func(t *testing.T) {
+		teamURL := fmt.Sprintf("%s/%s", url, getTeamID(t, db))
+		httpDo(t, teamURL, "DELETE", nil, http.StatusBadRequest, "", nil)
+	})
+}
@@ -0,0 +1,92 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flatcar/nebraska/backend/pkg/api"
+	"github.com/flatcar/nebraska/backend/pkg/codegen"
+)
+
+func TestUsers(t *testing.T) {
+	// establish DB connection
+	db := newDBForTest(t)
+	defer db.Close()
+
+	url := fmt.Sprintf("%s/api/users", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+
+	t.Run("create_invalid", func(t *testing.T) {
+		payload := strings.NewReader(`{"username":"monica geller","password":"monica-password","role":"viewer"}`)
+		var errResp map[string]any
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_user", errResp["error"])
+
+		// too short passwords are refused by the request validation
+		payload = strings.NewReader(`{"username":"monica","password":"short","role":"viewer"}`)
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "", nil)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		payload := strings.NewReader(`{"username":"chandler","password":"chandler-password","role":"viewer"}`)
+
+		var user codegen.User
+		httpDo(t, url, "POST", payload, http.StatusOK, "json", &user)
+		assert.Equal(t, "chandler", user.Username)
+		assert.Equal(t, codegen.Viewer, user.Role)
+		assert.Equal(t, getTeamID(t, db), user.TeamId)
+
+		// the usernames are unique
+		payload = strings.NewReader(`{"username":"chandler","password":"chandler-password","role":"admin"}`)
+		httpDo(t, url, "POST", payload, http.StatusBadRequest, "", nil)
+
+		var users []codegen.User
+		httpDo(t, url, "GET", nil, http.StatusOK, "json", &users)
+		userIDs := []string{}
+		for _, listedUser := range users {
+			userIDs = append(userIDs, listedUser.Id)
+		}
+		assert.Contains(t, userIDs, user.Id)
+
+		userURL := fmt.Sprintf("%s/%s", url, user.Id)
+		payload = strings.NewReader(`{"role":"admin","password":"new-chandler-password"}`)
+		httpDo(t, userURL, "PUT", payload, http.StatusOK, "json", &user)
+		assert.Equal(t, codegen.Admin, user.Role)
+
+		authenticated, err := db.AuthenticateUser("chandler", "new-chandler-password")
+		require.NoError(t, err)
+		assert.Equal(t, user.Id, authenticated.ID)
+
+		httpDo(t, userURL, "DELETE", nil, http.StatusNoContent, "", nil)
+		httpDo(t, userURL, "GET", nil, http.StatusNotFound, "", nil)
+	})
+
+	t.Run("last_admin", func(t *testing.T) {
+		team, err := adminSvc(db).AddTeam(&api.Team{Name: "users_team"})
+		require.NoError(t, err)
+		secret, err := adminSvc(db).GenerateUserSecret("joey-password")
+		require.NoError(t, err)
+		joey, err := adminSvc(db).AddUser(&api.User{Username: "joey", Secret: secret, Role: api.UserRoleAdmin, TeamID: team.ID})
+		require.NoError(t, err)
+
+		userURL := fmt.Sprintf("%s/%s", url, joey.ID)
+		var errResp map[string]any
+		httpDo(t, userURL, "PUT", strings.NewReader(`{"role":"viewer"}`), http.StatusBadRequest, "json", &errResp)
+		assert.Equal(t, "invalid_user", errResp["error"])
+		httpDo(t, userURL, "DELETE", nil, http.StatusBadRequest, "", nil)
+
+		require.NoError(t, adminSvc(db).DeleteUser(joey.ID))
+		require.NoError(t, adminSvc(db).DeleteTeam(team.ID))
+	})
+
+	t.Run("login_not_supported", func(t *testing.T) {
+		loginURL := fmt.Sprintf("%s/login", os.Getenv("NEBRASKA_TEST_SERVER_URL"))
+		payload := strings.NewReader(`{"username":"admin","password":"admin-password"}`)
+		httpDo(t, loginURL, "POST", payload, http.StatusNotImplemented, "", nil)
+	})
+}
@@ -58,6 +58,7 @@ export interface Package {
   extra_files: File[];
   is_floor?: boolean;
   floor_reason?: string | null;
+  upstream_url?: null | string;
 }
 
 export interface FlatcarAction {
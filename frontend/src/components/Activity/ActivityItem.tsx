@@ -34,6 +34,15 @@ export default function ActivityItem(props: ActivityItemProps) {
   );
 }
 
+// Activity classes related to a channel rather than to a group.
+const channelActivityClasses = [
+  'activityChannelPackageUpdated',
+  'activityPackageSignatureInvalid',
+  'activityChannelFloorAdded',
+  'activityChannelFloorRemoved',
+  'activityChannelPackageBlacklisted',
+];
+
 export interface ActivityItemPureProps {
   appId: string;
   appName: string;
@@ -55,7 +64,7 @@ export function ActivityItemPure(props: ActivityItemPureProps) {
   let subtitle = '';
   let name: React.ReactNode = '';
 
-  if (props.classType !== 'activityChannelPackageUpdated') {
+  if (!channelActivityClasses.includes(props.classType)) {
     const groupPath = `/apps/${props.appId}/groups/${props.groupId}`;
     subtitle = t('activity|group');
     name = (
@@ -149,6 +149,67 @@ class ActivityStore extends Store {
         description:
           'Channel ' + entry.channel_name + ' is now pointing to version ' + entry.version,
       },
+      7: {
+        type: 'activityPackageSignatureInvalid',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Version ' +
+          entry.version +
+          ' was not imported into channel ' +
+          entry.channel_name +
+          ' because its payload signature could not be verified',
+      },
+      8: {
+        type: 'activityChannelFloorAdded',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Version ' + entry.version + ' is now a floor of channel ' + entry.channel_name,
+      },
+      9: {
+        type: 'activityChannelFloorRemoved',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Version ' + entry.version + ' is no longer a floor of channel ' + entry.channel_name,
+      },
+      10: {
+        type: 'activityChannelPackageBlacklisted',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Version ' + entry.version + ' has been blacklisted for channel ' + entry.channel_name,
+      },
+      11: {
+        type: 'activityInstanceDeleted',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Instance ' + entry.instance_id + ' running version ' + entry.version + ' was deleted',
+      },
+      12: {
+        type: 'activityInstanceDuplicateSuspected',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description:
+          'Instance ' +
+          entry.instance_id +
+          ' is suspected of sharing its machine id with other machines',
+      },
+      13: {
+        type: 'activityInstancesDeleted',
+        appName: entry.application_name,
+        groupName: entry.group_name,
+        channelName: entry.channel_name,
+        description: entry.version + ' instances were deleted',
+      },
     };
 
     const classDetails = classID ? classTypes[classID] : classTypes[1];